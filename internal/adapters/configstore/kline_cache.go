@@ -0,0 +1,223 @@
+package configstore
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+)
+
+const (
+	defaultKlineCacheDirName = "klines"
+	klineCacheFieldCount     = 7
+)
+
+// ErrKlineCacheKeyInvalid indicates a market or interval unusable as a cache file name.
+var ErrKlineCacheKeyInvalid = errors.New("invalid kline cache key")
+
+// FileKlineCache stores candles in append-only CSV files, one per market and interval.
+// Rows are `open_unix,open,high,low,close,volume,quote_volume`; duplicate open times keep the last row
+// and malformed rows (for example a torn final line) are skipped on load.
+type FileKlineCache struct {
+	dir string
+	mu  sync.Mutex
+}
+
+var _ ports.KlineCache = (*FileKlineCache)(nil)
+
+// NewDefaultKlineCache constructs kline cache at ~/.wbcli/klines.
+func NewDefaultKlineCache() (*FileKlineCache, error) {
+	configPath, err := defaultConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFileKlineCache(filepath.Join(filepath.Dir(configPath), defaultKlineCacheDirName)), nil
+}
+
+// NewFileKlineCache constructs kline cache in custom directory.
+func NewFileKlineCache(dir string) *FileKlineCache {
+	return &FileKlineCache{dir: dir}
+}
+
+// LoadKlines returns cached candles with open time in [start, end], ascending by open time.
+// Zero start or end leaves the window open on that side.
+func (cache *FileKlineCache) LoadKlines(
+	_ context.Context,
+	market string,
+	interval string,
+	start time.Time,
+	end time.Time,
+) ([]ports.Kline, error) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	path, err := cache.path(market, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("open kline cache: %w", err)
+	}
+	defer file.Close()
+
+	byOpenTime := map[int64]ports.Kline{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		kline, ok := parseKlineRow(scanner.Text())
+		if !ok {
+			continue
+		}
+		if !start.IsZero() && kline.OpenTime.Before(start) {
+			continue
+		}
+		if !end.IsZero() && kline.OpenTime.After(end) {
+			continue
+		}
+		byOpenTime[kline.OpenTime.Unix()] = kline
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read kline cache: %w", err)
+	}
+
+	klines := make([]ports.Kline, 0, len(byOpenTime))
+	for _, kline := range byOpenTime {
+		klines = append(klines, kline)
+	}
+	sort.Slice(klines, func(left int, right int) bool {
+		return klines[left].OpenTime.Before(klines[right].OpenTime)
+	})
+
+	return klines, nil
+}
+
+// AppendKlines appends candles to the market and interval cache file.
+func (cache *FileKlineCache) AppendKlines(_ context.Context, market string, interval string, klines []ports.Kline) error {
+	if len(klines) == 0 {
+		return nil
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	path, err := cache.path(market, interval)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(cache.dir, 0o700); err != nil {
+		return fmt.Errorf("create kline cache directory: %w", err)
+	}
+
+	var builder strings.Builder
+	for _, kline := range klines {
+		builder.WriteString(formatKlineRow(kline))
+		builder.WriteByte('\n')
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_RDWR, 0o600)
+	if err != nil {
+		return fmt.Errorf("open kline cache: %w", err)
+	}
+	rows := builder.String()
+	if endsMidRow(file) {
+		rows = "\n" + rows
+	}
+	if _, err := file.WriteString(rows); err != nil {
+		file.Close()
+		return fmt.Errorf("append kline cache: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("close kline cache: %w", err)
+	}
+
+	return nil
+}
+
+// endsMidRow reports whether an interrupted append left the file without a trailing newline.
+func endsMidRow(file *os.File) bool {
+	info, err := file.Stat()
+	if err != nil || info.Size() == 0 {
+		return false
+	}
+
+	last := make([]byte, 1)
+	if _, err := file.ReadAt(last, info.Size()-1); err != nil {
+		return false
+	}
+
+	return last[0] != '\n'
+}
+
+// path maps market and interval to a cache file; interval case is kept because 1m and 1M differ.
+func (cache *FileKlineCache) path(market string, interval string) (string, error) {
+	market = strings.ToUpper(strings.TrimSpace(market))
+	interval = strings.TrimSpace(interval)
+	if !isCacheKeySafe(market) || !isCacheKeySafe(interval) {
+		return "", fmt.Errorf("%w: %q %q", ErrKlineCacheKeyInvalid, market, interval)
+	}
+
+	return filepath.Join(cache.dir, market+"_"+interval+".csv"), nil
+}
+
+func isCacheKeySafe(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, symbol := range value {
+		switch {
+		case symbol >= 'A' && symbol <= 'Z', symbol >= 'a' && symbol <= 'z', symbol >= '0' && symbol <= '9', symbol == '_', symbol == '-':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func formatKlineRow(kline ports.Kline) string {
+	return strings.Join([]string{
+		strconv.FormatInt(kline.OpenTime.Unix(), 10),
+		kline.Open,
+		kline.High,
+		kline.Low,
+		kline.Close,
+		kline.Volume,
+		kline.QuoteVolume,
+	}, ",")
+}
+
+func parseKlineRow(row string) (ports.Kline, bool) {
+	fields := strings.Split(strings.TrimSpace(row), ",")
+	if len(fields) != klineCacheFieldCount {
+		return ports.Kline{}, false
+	}
+	openTime, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return ports.Kline{}, false
+	}
+
+	return ports.Kline{
+		OpenTime:    time.Unix(openTime, 0).UTC(),
+		Open:        fields[1],
+		High:        fields[2],
+		Low:         fields[3],
+		Close:       fields[4],
+		Volume:      fields[5],
+		QuoteVolume: fields[6],
+	}, true
+}
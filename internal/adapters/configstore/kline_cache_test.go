@@ -0,0 +1,63 @@
+package configstore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+)
+
+func TestFileKlineCacheAppendsAndLoadsWindow(t *testing.T) {
+	dir := t.TempDir()
+	cache := NewFileKlineCache(dir)
+	base := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	klineAt := func(offset int, closePrice string) ports.Kline {
+		return ports.Kline{OpenTime: base.Add(time.Duration(offset) * time.Hour), Open: "1", High: "2", Low: "0.5", Close: closePrice, Volume: "3", QuoteVolume: "4"}
+	}
+	if err := cache.AppendKlines(context.Background(), "btc_perp", "1h", []ports.Kline{klineAt(2, "1.2"), klineAt(0, "1.0")}); err != nil {
+		t.Fatalf("append failed: %v", err)
+	}
+
+	// simulate an interrupted append before the next one
+	path := filepath.Join(dir, "BTC_PERP_1h.csv")
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		t.Fatalf("open cache file: %v", err)
+	}
+	_, _ = file.WriteString("1772330400,1,2")
+	_ = file.Close()
+
+	if err := cache.AppendKlines(context.Background(), "BTC_PERP", "1h", []ports.Kline{klineAt(1, "1.1"), klineAt(2, "1.25")}); err != nil {
+		t.Fatalf("append failed: %v", err)
+	}
+
+	klines, err := cache.LoadKlines(context.Background(), "BTC_PERP", "1h", base, base.Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if len(klines) != 3 {
+		t.Fatalf("expected 3 candles, got %+v", klines)
+	}
+	if klines[0].Close != "1.0" || klines[1].Close != "1.1" || klines[2].Close != "1.25" {
+		t.Fatalf("expected ascending candles with last duplicate winning, got %+v", klines)
+	}
+
+	window, err := cache.LoadKlines(context.Background(), "BTC_PERP", "1h", base.Add(time.Hour), time.Time{})
+	if err != nil || len(window) != 2 {
+		t.Fatalf("expected 2 candles in open-ended window, got %d (%v)", len(window), err)
+	}
+
+	empty, err := cache.LoadKlines(context.Background(), "ETH_PERP", "1h", time.Time{}, time.Time{})
+	if err != nil || len(empty) != 0 {
+		t.Fatalf("expected empty cache for unknown key, got %d (%v)", len(empty), err)
+	}
+
+	if err := cache.AppendKlines(context.Background(), "../etc", "1h", []ports.Kline{klineAt(0, "1")}); !errors.Is(err, ErrKlineCacheKeyInvalid) {
+		t.Fatalf("expected ErrKlineCacheKeyInvalid, got %v", err)
+	}
+}
@@ -0,0 +1,188 @@
+package configstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
+)
+
+const (
+	defaultMarketCacheFileName = "markets-cache.json"
+
+	// DefaultMarketCacheTTL is how long cached market rules are used before refetching.
+	DefaultMarketCacheTTL = time.Hour
+)
+
+type storedMarketCache struct {
+	FetchedAt string         `json:"fetched_at"`
+	Markets   []storedMarket `json:"markets"`
+}
+
+type storedMarket struct {
+	Name           string `json:"name"`
+	StockPrecision int    `json:"stock_precision"`
+	MoneyPrecision int    `json:"money_precision"`
+	TickSize       string `json:"tick_size"`
+	MinAmount      string `json:"min_amount"`
+	MinTotal       string `json:"min_total"`
+	MaxTotal       string `json:"max_total"`
+	TradesEnabled  bool   `json:"trades_enabled"`
+	IsCollateral   bool   `json:"is_collateral"`
+}
+
+// FileMarketInfoCache caches market rules from source in a local JSON file for ttl.
+// A stale cache is still served when source is unreachable.
+type FileMarketInfoCache struct {
+	path   string
+	source ports.MarketInfoProvider
+	clock  ports.Clock
+	ttl    time.Duration
+	mu     sync.Mutex
+}
+
+var _ ports.MarketInfoProvider = (*FileMarketInfoCache)(nil)
+
+// NewDefaultMarketInfoCache constructs market cache at ~/.wbcli/markets-cache.json.
+func NewDefaultMarketInfoCache(source ports.MarketInfoProvider, clock ports.Clock) (*FileMarketInfoCache, error) {
+	configPath, err := defaultConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFileMarketInfoCache(
+		filepath.Join(filepath.Dir(configPath), defaultMarketCacheFileName),
+		source,
+		clock,
+		DefaultMarketCacheTTL,
+	), nil
+}
+
+// NewFileMarketInfoCache constructs market cache at custom path.
+func NewFileMarketInfoCache(
+	path string,
+	source ports.MarketInfoProvider,
+	clock ports.Clock,
+	ttl time.Duration,
+) *FileMarketInfoCache {
+	return &FileMarketInfoCache{
+		path:   path,
+		source: source,
+		clock:  clock,
+		ttl:    ttl,
+	}
+}
+
+// ListMarkets returns cached market rules, refreshing them from source when expired.
+func (cache *FileMarketInfoCache) ListMarkets(ctx context.Context) ([]ports.MarketInfo, error) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cached, fetchedAt, found := cache.load()
+	if found && cache.clock.Now().Sub(fetchedAt) < cache.ttl {
+		return cached, nil
+	}
+
+	markets, err := cache.source.ListMarkets(ctx)
+	if err != nil {
+		if found {
+			return cached, nil
+		}
+
+		return nil, err
+	}
+
+	if err := cache.save(markets); err != nil {
+		return nil, err
+	}
+
+	return markets, nil
+}
+
+// load returns cached markets; unreadable or corrupt cache files are treated as missing.
+func (cache *FileMarketInfoCache) load() ([]ports.MarketInfo, time.Time, bool) {
+	fileData, err := os.ReadFile(cache.path)
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+
+	var stored storedMarketCache
+	if err := json.Unmarshal(fileData, &stored); err != nil {
+		return nil, time.Time{}, false
+	}
+	fetchedAt, err := time.Parse(timestampLayoutRFC3339, stored.FetchedAt)
+	if err != nil {
+		return nil, time.Time{}, false
+	}
+
+	markets := make([]ports.MarketInfo, 0, len(stored.Markets))
+	for _, market := range stored.Markets {
+		info, err := storedToMarketInfo(market)
+		if err != nil {
+			return nil, time.Time{}, false
+		}
+		markets = append(markets, info)
+	}
+
+	return markets, fetchedAt, true
+}
+
+func (cache *FileMarketInfoCache) save(markets []ports.MarketInfo) error {
+	stored := storedMarketCache{
+		FetchedAt: cache.clock.Now().UTC().Format(timestampLayoutRFC3339),
+		Markets:   make([]storedMarket, 0, len(markets)),
+	}
+	for _, market := range markets {
+		stored.Markets = append(stored.Markets, storedMarket{
+			Name:           market.Name,
+			StockPrecision: market.StockPrecision,
+			MoneyPrecision: market.MoneyPrecision,
+			TickSize:       market.TickSize.String(),
+			MinAmount:      market.MinAmount.String(),
+			MinTotal:       market.MinTotal.String(),
+			MaxTotal:       market.MaxTotal.String(),
+			TradesEnabled:  market.TradesEnabled,
+			IsCollateral:   market.IsCollateral,
+		})
+	}
+
+	encoded, err := json.Marshal(stored)
+	if err != nil {
+		return fmt.Errorf("encode market cache: %w", err)
+	}
+
+	return writeFileAtomic(cache.path, "markets-*.tmp", encoded)
+}
+
+func storedToMarketInfo(market storedMarket) (ports.MarketInfo, error) {
+	values := make([]decimal.Decimal, 0, 4)
+	for _, raw := range []string{market.TickSize, market.MinAmount, market.MinTotal, market.MaxTotal} {
+		parsed, err := decimal.Parse(raw)
+		if err != nil {
+			return ports.MarketInfo{}, err
+		}
+		values = append(values, parsed)
+	}
+	if market.Name == "" {
+		return ports.MarketInfo{}, errors.New("market name is empty")
+	}
+
+	return ports.MarketInfo{
+		Name:           market.Name,
+		StockPrecision: market.StockPrecision,
+		MoneyPrecision: market.MoneyPrecision,
+		TickSize:       values[0],
+		MinAmount:      values[1],
+		MinTotal:       values[2],
+		MaxTotal:       values[3],
+		TradesEnabled:  market.TradesEnabled,
+		IsCollateral:   market.IsCollateral,
+	}, nil
+}
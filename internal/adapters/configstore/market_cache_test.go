@@ -0,0 +1,119 @@
+package configstore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
+)
+
+type fakeMarketSource struct {
+	markets []ports.MarketInfo
+	err     error
+	calls   int
+}
+
+func (source *fakeMarketSource) ListMarkets(context.Context) ([]ports.MarketInfo, error) {
+	source.calls++
+	return source.markets, source.err
+}
+
+type mutableClock struct {
+	now time.Time
+}
+
+func (clock *mutableClock) Now() time.Time {
+	return clock.now
+}
+
+func testMarketInfo() ports.MarketInfo {
+	return ports.MarketInfo{
+		Name:           "BTC_PERP",
+		StockPrecision: 4,
+		MoneyPrecision: 1,
+		TickSize:       decimal.MustParse("0.1"),
+		MinAmount:      decimal.MustParse("0.0001"),
+		MinTotal:       decimal.MustParse("5"),
+		TradesEnabled:  true,
+		IsCollateral:   true,
+	}
+}
+
+func TestFileMarketInfoCacheServesFreshCacheAndRefetchesAfterTTL(t *testing.T) {
+	cachePath := filepath.Join(t.TempDir(), "markets-cache.json")
+	source := &fakeMarketSource{markets: []ports.MarketInfo{testMarketInfo()}}
+	clock := &mutableClock{now: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	cache := NewFileMarketInfoCache(cachePath, source, clock, time.Hour)
+
+	if _, err := cache.ListMarkets(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	clock.now = clock.now.Add(30 * time.Minute)
+	markets, err := NewFileMarketInfoCache(cachePath, source, clock, time.Hour).ListMarkets(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if source.calls != 1 {
+		t.Fatalf("expected cached read without refetch, got %d source calls", source.calls)
+	}
+	if len(markets) != 1 || markets[0].TickSize.String() != "0.1" || markets[0].MinTotal.String() != "5" {
+		t.Fatalf("unexpected cached markets: %+v", markets)
+	}
+
+	clock.now = clock.now.Add(time.Hour)
+	if _, err := cache.ListMarkets(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if source.calls != 2 {
+		t.Fatalf("expected refetch after ttl, got %d source calls", source.calls)
+	}
+
+	fileInfo, err := os.Stat(cachePath)
+	if err != nil {
+		t.Fatalf("expected cache file, got %v", err)
+	}
+	if fileInfo.Mode().Perm() != 0o600 {
+		t.Fatalf("expected cache mode 0600, got %o", fileInfo.Mode().Perm())
+	}
+}
+
+func TestFileMarketInfoCacheFallsBackToStaleCacheOnSourceError(t *testing.T) {
+	cachePath := filepath.Join(t.TempDir(), "markets-cache.json")
+	source := &fakeMarketSource{markets: []ports.MarketInfo{testMarketInfo()}}
+	clock := &mutableClock{now: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	cache := NewFileMarketInfoCache(cachePath, source, clock, time.Hour)
+
+	if _, err := cache.ListMarkets(context.Background()); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	source.err = errors.New("exchange unavailable")
+	clock.now = clock.now.Add(2 * time.Hour)
+	markets, err := cache.ListMarkets(context.Background())
+	if err != nil {
+		t.Fatalf("expected stale cache fallback, got %v", err)
+	}
+	if len(markets) != 1 || markets[0].Name != "BTC_PERP" {
+		t.Fatalf("unexpected stale markets: %+v", markets)
+	}
+}
+
+func TestFileMarketInfoCacheReturnsSourceErrorWithoutCache(t *testing.T) {
+	sourceErr := errors.New("exchange unavailable")
+	cache := NewFileMarketInfoCache(
+		filepath.Join(t.TempDir(), "markets-cache.json"),
+		&fakeMarketSource{err: sourceErr},
+		&mutableClock{now: time.Now()},
+		time.Hour,
+	)
+
+	if _, err := cache.ListMarkets(context.Background()); !errors.Is(err, sourceErr) {
+		t.Fatalf("expected source error, got %v", err)
+	}
+}
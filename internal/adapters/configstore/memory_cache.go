@@ -0,0 +1,112 @@
+package configstore
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+)
+
+// MemoryMarketInfoCache keeps market rules from source for the process lifetime only.
+// It backs runs with external credentials, which must not leave state in ~/.wbcli.
+type MemoryMarketInfoCache struct {
+	source  ports.MarketInfoProvider
+	mu      sync.Mutex
+	markets []ports.MarketInfo
+}
+
+var _ ports.MarketInfoProvider = (*MemoryMarketInfoCache)(nil)
+
+// NewMemoryMarketInfoCache constructs an empty in-memory market cache.
+func NewMemoryMarketInfoCache(source ports.MarketInfoProvider) *MemoryMarketInfoCache {
+	return &MemoryMarketInfoCache{source: source}
+}
+
+// ListMarkets fetches market rules from source once and serves them afterwards.
+func (cache *MemoryMarketInfoCache) ListMarkets(ctx context.Context) ([]ports.MarketInfo, error) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	if cache.markets == nil {
+		markets, err := cache.source.ListMarkets(ctx)
+		if err != nil {
+			return nil, err
+		}
+		cache.markets = markets
+	}
+
+	return append([]ports.MarketInfo(nil), cache.markets...), nil
+}
+
+// MemoryKlineCache keeps candles for the process lifetime only, one set per market and interval.
+// It backs runs with external credentials, which must not leave state in ~/.wbcli.
+type MemoryKlineCache struct {
+	mu     sync.Mutex
+	klines map[string]map[int64]ports.Kline
+}
+
+var _ ports.KlineCache = (*MemoryKlineCache)(nil)
+
+// NewMemoryKlineCache constructs an empty in-memory kline cache.
+func NewMemoryKlineCache() *MemoryKlineCache {
+	return &MemoryKlineCache{klines: map[string]map[int64]ports.Kline{}}
+}
+
+// LoadKlines returns cached candles with open time in [start, end], ascending by open time.
+// Zero start or end leaves the window open on that side.
+func (cache *MemoryKlineCache) LoadKlines(
+	_ context.Context,
+	market string,
+	interval string,
+	start time.Time,
+	end time.Time,
+) ([]ports.Kline, error) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	klines := make([]ports.Kline, 0)
+	for _, kline := range cache.klines[memoryKlineKey(market, interval)] {
+		if !start.IsZero() && kline.OpenTime.Before(start) {
+			continue
+		}
+		if !end.IsZero() && kline.OpenTime.After(end) {
+			continue
+		}
+		klines = append(klines, kline)
+	}
+	sort.Slice(klines, func(left int, right int) bool {
+		return klines[left].OpenTime.Before(klines[right].OpenTime)
+	})
+
+	return klines, nil
+}
+
+// AppendKlines stores candles; a candle with an already cached open time replaces the earlier one.
+func (cache *MemoryKlineCache) AppendKlines(_ context.Context, market string, interval string, klines []ports.Kline) error {
+	if len(klines) == 0 {
+		return nil
+	}
+
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	key := memoryKlineKey(market, interval)
+	byOpenTime := cache.klines[key]
+	if byOpenTime == nil {
+		byOpenTime = map[int64]ports.Kline{}
+		cache.klines[key] = byOpenTime
+	}
+	for _, kline := range klines {
+		byOpenTime[kline.OpenTime.Unix()] = kline
+	}
+
+	return nil
+}
+
+// memoryKlineKey normalizes market like the file cache; interval case is kept because 1m and 1M differ.
+func memoryKlineKey(market string, interval string) string {
+	return strings.ToUpper(strings.TrimSpace(market)) + "_" + strings.TrimSpace(interval)
+}
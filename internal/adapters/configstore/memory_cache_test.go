@@ -0,0 +1,57 @@
+package configstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+)
+
+func TestMemoryMarketInfoCacheFetchesOnce(t *testing.T) {
+	source := &fakeMarketSource{markets: []ports.MarketInfo{testMarketInfo()}}
+	cache := NewMemoryMarketInfoCache(source)
+
+	for range 2 {
+		markets, err := cache.ListMarkets(context.Background())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(markets) != 1 || markets[0].Name != "BTC_PERP" {
+			t.Fatalf("unexpected markets: %+v", markets)
+		}
+	}
+	if source.calls != 1 {
+		t.Fatalf("expected one fetch, got %d", source.calls)
+	}
+}
+
+func TestMemoryKlineCacheMergesAndFiltersByWindow(t *testing.T) {
+	cache := NewMemoryKlineCache()
+	base := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	if err := cache.AppendKlines(ctx, "btc_perp", "1m", []ports.Kline{
+		{OpenTime: base.Add(time.Minute), Close: "2"},
+		{OpenTime: base, Close: "1"},
+	}); err != nil {
+		t.Fatalf("append failed: %v", err)
+	}
+	if err := cache.AppendKlines(ctx, "BTC_PERP", "1m", []ports.Kline{
+		{OpenTime: base.Add(time.Minute), Close: "2.5"},
+		{OpenTime: base.Add(2 * time.Minute), Close: "3"},
+	}); err != nil {
+		t.Fatalf("append failed: %v", err)
+	}
+
+	klines, err := cache.LoadKlines(ctx, "BTC_PERP", "1m", base.Add(time.Minute), time.Time{})
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if len(klines) != 2 || klines[0].Close != "2.5" || klines[1].Close != "3" {
+		t.Fatalf("unexpected klines: %+v", klines)
+	}
+	if other, _ := cache.LoadKlines(ctx, "BTC_PERP", "1M", time.Time{}, time.Time{}); len(other) != 0 {
+		t.Fatalf("expected intervals to be cached separately, got %+v", other)
+	}
+}
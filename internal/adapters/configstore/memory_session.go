@@ -0,0 +1,55 @@
+package configstore
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+)
+
+// MemorySessionStore keeps auth session metadata for the process lifetime only.
+// It backs runs with external credentials, which must not leave state in ~/.wbcli.
+type MemorySessionStore struct {
+	mu      sync.Mutex
+	session *ports.SessionMetadata
+}
+
+// NewMemorySessionStore constructs an empty in-memory session store.
+func NewMemorySessionStore() *MemorySessionStore {
+	return &MemorySessionStore{}
+}
+
+// SaveSession replaces session metadata.
+func (store *MemorySessionStore) SaveSession(_ context.Context, session ports.SessionMetadata) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	session.HedgeMode = copyBoolPtr(session.HedgeMode)
+	store.session = &session
+
+	return nil
+}
+
+// GetSession returns session metadata saved by this process.
+func (store *MemorySessionStore) GetSession(_ context.Context) (ports.SessionMetadata, bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if store.session == nil {
+		return ports.SessionMetadata{}, false, nil
+	}
+	session := *store.session
+	session.HedgeMode = copyBoolPtr(session.HedgeMode)
+
+	return session, true, nil
+}
+
+// ClearSession drops session metadata.
+func (store *MemorySessionStore) ClearSession(_ context.Context) error {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.session = nil
+
+	return nil
+}
@@ -8,12 +8,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
 )
 
 const (
@@ -23,9 +25,13 @@ const (
 	timestampLayoutRFC3339 = time.RFC3339Nano
 )
 
+// storedConfig keeps the default account in session, so single-account configs keep their layout;
+// other named accounts live in accounts.
 type storedConfig struct {
-	SchemaVersion int            `json:"schema_version"`
-	Session       *storedSession `json:"session,omitempty"`
+	SchemaVersion int                       `json:"schema_version"`
+	ActiveAccount string                    `json:"active_account,omitempty"`
+	Session       *storedSession            `json:"session,omitempty"`
+	Accounts      map[string]*storedSession `json:"accounts,omitempty"`
 }
 
 type storedSession struct {
@@ -36,12 +42,18 @@ type storedSession struct {
 	UpdatedAt  string `json:"updated_at,omitempty"`
 }
 
-// FileSessionStore stores auth session metadata in local config file.
+// FileSessionStore stores auth session metadata of one account slot in local config file.
 type FileSessionStore struct {
-	path string
-	mu   sync.Mutex
+	path    string
+	account string
+	mu      *sync.Mutex
 }
 
+var (
+	_ ports.SessionStore    = (*FileSessionStore)(nil)
+	_ ports.AccountRegistry = (*FileSessionStore)(nil)
+)
+
 // NewDefaultSessionStore constructs session store at ~/.wbcli/config.yaml.
 func NewDefaultSessionStore() (*FileSessionStore, error) {
 	configPath, err := defaultConfigPath()
@@ -52,9 +64,14 @@ func NewDefaultSessionStore() (*FileSessionStore, error) {
 	return NewFileSessionStore(configPath), nil
 }
 
-// NewFileSessionStore constructs session store at custom path.
+// NewFileSessionStore constructs session store at custom path for the default account.
 func NewFileSessionStore(path string) *FileSessionStore {
-	return &FileSessionStore{path: path}
+	return &FileSessionStore{path: path, account: domainauth.DefaultAccount, mu: &sync.Mutex{}}
+}
+
+// ForAccount returns a store on the same file for the named account slot.
+func (store *FileSessionStore) ForAccount(name string) *FileSessionStore {
+	return &FileSessionStore{path: store.path, account: name, mu: store.mu}
 }
 
 func defaultConfigPath() (string, error) {
@@ -76,40 +93,54 @@ func (store *FileSessionStore) SaveSession(_ context.Context, session ports.Sess
 		return err
 	}
 
-	config.Session = &storedSession{
+	config.setSlot(store.account, &storedSession{
 		Backend:    session.Backend,
 		APIKeyHint: session.APIKeyHint,
 		HedgeMode:  copyBoolPtr(session.HedgeMode),
 		CreatedAt:  session.CreatedAt.UTC().Format(timestampLayoutRFC3339),
 		UpdatedAt:  session.UpdatedAt.UTC().Format(timestampLayoutRFC3339),
-	}
+	})
 
 	return store.saveConfig(config)
 }
 
-// GetSession returns current auth session metadata.
-func (store *FileSessionStore) GetSession(_ context.Context) (ports.SessionMetadata, bool, error) {
+// GetSession returns auth session metadata of the store account.
+func (store *FileSessionStore) GetSession(ctx context.Context) (ports.SessionMetadata, bool, error) {
+	return store.AccountSession(ctx, store.account)
+}
+
+// ClearSession clears auth session metadata.
+func (store *FileSessionStore) ClearSession(_ context.Context) error {
 	store.mu.Lock()
 	defer store.mu.Unlock()
 
 	config, err := store.loadConfig()
 	if err != nil {
-		return ports.SessionMetadata{}, false, err
-	}
-	if config.Session == nil {
-		return ports.SessionMetadata{}, false, nil
+		return err
 	}
+	config.setSlot(store.account, nil)
 
-	metadata, err := storedToSession(*config.Session)
+	return store.saveConfig(config)
+}
+
+// ActiveAccount returns the account selected with auth use, or the default account.
+func (store *FileSessionStore) ActiveAccount(_ context.Context) (string, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	config, err := store.loadConfig()
 	if err != nil {
-		return ports.SessionMetadata{}, false, err
+		return "", err
+	}
+	if config.ActiveAccount == "" {
+		return domainauth.DefaultAccount, nil
 	}
 
-	return metadata, true, nil
+	return config.ActiveAccount, nil
 }
 
-// ClearSession clears auth session metadata.
-func (store *FileSessionStore) ClearSession(_ context.Context) error {
+// SetActiveAccount selects the account used when commands do not name one.
+func (store *FileSessionStore) SetActiveAccount(_ context.Context, name string) error {
 	store.mu.Lock()
 	defer store.mu.Unlock()
 
@@ -117,11 +148,60 @@ func (store *FileSessionStore) ClearSession(_ context.Context) error {
 	if err != nil {
 		return err
 	}
-	config.Session = nil
+	config.ActiveAccount = name
+	if name == domainauth.DefaultAccount {
+		config.ActiveAccount = ""
+	}
 
 	return store.saveConfig(config)
 }
 
+// AccountSession returns auth session metadata of the named account.
+func (store *FileSessionStore) AccountSession(_ context.Context, name string) (ports.SessionMetadata, bool, error) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	config, err := store.loadConfig()
+	if err != nil {
+		return ports.SessionMetadata{}, false, err
+	}
+	slot := config.slot(name)
+	if slot == nil {
+		return ports.SessionMetadata{}, false, nil
+	}
+
+	metadata, err := storedToSession(*slot)
+	if err != nil {
+		return ports.SessionMetadata{}, false, err
+	}
+
+	return metadata, true, nil
+}
+
+func (config *storedConfig) slot(account string) *storedSession {
+	if account == domainauth.DefaultAccount {
+		return config.Session
+	}
+
+	return config.Accounts[account]
+}
+
+func (config *storedConfig) setSlot(account string, session *storedSession) {
+	if account == domainauth.DefaultAccount {
+		config.Session = session
+		return
+	}
+
+	if session == nil {
+		delete(config.Accounts, account)
+		return
+	}
+	if config.Accounts == nil {
+		config.Accounts = map[string]*storedSession{}
+	}
+	config.Accounts[account] = session
+}
+
 func (store *FileSessionStore) loadConfig() (storedConfig, error) {
 	fileData, err := os.ReadFile(store.path)
 	if err != nil {
@@ -152,12 +232,22 @@ func (store *FileSessionStore) saveConfig(config storedConfig) error {
 		config.SchemaVersion = configSchemaVersionV2
 	}
 
-	configDir := filepath.Dir(store.path)
+	encoded, err := encodeConfigYAML(config)
+	if err != nil {
+		return fmt.Errorf("encode session config: %w", err)
+	}
+
+	return writeFileAtomic(store.path, "config-*.tmp", encoded)
+}
+
+// writeFileAtomic replaces path with data through a 0600 temp file in the same directory.
+func writeFileAtomic(path string, tempPattern string, data []byte) error {
+	configDir := filepath.Dir(path)
 	if err := os.MkdirAll(configDir, 0o700); err != nil {
 		return fmt.Errorf("create config directory: %w", err)
 	}
 
-	tempFile, err := os.CreateTemp(configDir, "config-*.tmp")
+	tempFile, err := os.CreateTemp(configDir, tempPattern)
 	if err != nil {
 		return fmt.Errorf("create temp config file: %w", err)
 	}
@@ -169,13 +259,7 @@ func (store *FileSessionStore) saveConfig(config storedConfig) error {
 		return fmt.Errorf("set temp config mode: %w", err)
 	}
 
-	encoded, err := encodeConfigYAML(config)
-	if err != nil {
-		tempFile.Close()
-		return fmt.Errorf("encode session config: %w", err)
-	}
-
-	if _, err := tempFile.Write(encoded); err != nil {
+	if _, err := tempFile.Write(data); err != nil {
 		tempFile.Close()
 		return fmt.Errorf("write temp config: %w", err)
 	}
@@ -183,10 +267,10 @@ func (store *FileSessionStore) saveConfig(config storedConfig) error {
 		return fmt.Errorf("close temp config: %w", err)
 	}
 
-	if err := os.Rename(tempFilePath, store.path); err != nil {
+	if err := os.Rename(tempFilePath, path); err != nil {
 		return fmt.Errorf("replace config file: %w", err)
 	}
-	if err := os.Chmod(store.path, 0o600); err != nil {
+	if err := os.Chmod(path, 0o600); err != nil {
 		return fmt.Errorf("set config mode: %w", err)
 	}
 
@@ -237,8 +321,8 @@ func decodeConfig(fileData []byte) (storedConfig, error) {
 func decodeYAMLConfig(fileData []byte) (storedConfig, error) {
 	config := storedConfig{}
 	var (
-		inSession bool
-		session   *storedSession
+		section string
+		session *storedSession
 	)
 
 	scanner := bufio.NewScanner(strings.NewReader(string(fileData)))
@@ -252,13 +336,17 @@ func decodeYAMLConfig(fileData []byte) (storedConfig, error) {
 		}
 
 		indent := len(line) - len(strings.TrimLeft(line, " "))
-		if indent == 0 {
-			inSession = false
+		if indent > 0 && section == "" {
+			continue
+		}
+		key, value, hasValue, err := splitYAMLKeyValue(trimmed)
+		if err != nil {
+			return storedConfig{}, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
 
-			key, value, hasValue, err := splitYAMLKeyValue(trimmed)
-			if err != nil {
-				return storedConfig{}, fmt.Errorf("line %d: %w", lineNumber, err)
-			}
+		if indent == 0 {
+			section = ""
+			session = nil
 
 			switch key {
 			case "schema_version":
@@ -270,13 +358,21 @@ func decodeYAMLConfig(fileData []byte) (storedConfig, error) {
 					return storedConfig{}, fmt.Errorf("line %d: parse schema_version: %w", lineNumber, err)
 				}
 				config.SchemaVersion = parsed
+			case "active_account":
+				config.ActiveAccount = value
 			case "session":
 				if hasValue && strings.TrimSpace(value) != "" {
 					return storedConfig{}, fmt.Errorf("line %d: session must be a map", lineNumber)
 				}
 				session = &storedSession{}
 				config.Session = session
-				inSession = true
+				section = key
+			case "accounts":
+				if hasValue && strings.TrimSpace(value) != "" {
+					return storedConfig{}, fmt.Errorf("line %d: accounts must be a map", lineNumber)
+				}
+				config.Accounts = map[string]*storedSession{}
+				section = key
 			default:
 				continue
 			}
@@ -284,38 +380,25 @@ func decodeYAMLConfig(fileData []byte) (storedConfig, error) {
 			continue
 		}
 
-		if !inSession || session == nil {
-			continue
-		}
 		if indent < 2 {
 			return storedConfig{}, fmt.Errorf("line %d: invalid indentation", lineNumber)
 		}
-
-		key, value, hasValue, err := splitYAMLKeyValue(strings.TrimSpace(line))
-		if err != nil {
-			return storedConfig{}, fmt.Errorf("line %d: %w", lineNumber, err)
+		if section == "accounts" && indent < 4 {
+			if hasValue {
+				return storedConfig{}, fmt.Errorf("line %d: account %q must be a map", lineNumber, key)
+			}
+			session = &storedSession{}
+			config.Accounts[key] = session
+			continue
+		}
+		if session == nil {
+			return storedConfig{}, fmt.Errorf("line %d: invalid indentation", lineNumber)
 		}
 		if !hasValue {
 			return storedConfig{}, fmt.Errorf("line %d: key %q requires a value", lineNumber, key)
 		}
-
-		switch key {
-		case "backend":
-			session.Backend = value
-		case "api_key_hint":
-			session.APIKeyHint = value
-		case "created_at":
-			session.CreatedAt = value
-		case "updated_at":
-			session.UpdatedAt = value
-		case "hedge_mode":
-			parsed, err := strconv.ParseBool(value)
-			if err != nil {
-				return storedConfig{}, fmt.Errorf("line %d: parse hedge_mode: %w", lineNumber, err)
-			}
-			session.HedgeMode = copyBoolPtr(&parsed)
-		default:
-			continue
+		if err := setSessionField(session, key, value); err != nil {
+			return storedConfig{}, fmt.Errorf("line %d: %w", lineNumber, err)
 		}
 	}
 	if err := scanner.Err(); err != nil {
@@ -325,6 +408,27 @@ func decodeYAMLConfig(fileData []byte) (storedConfig, error) {
 	return config, nil
 }
 
+func setSessionField(session *storedSession, key string, value string) error {
+	switch key {
+	case "backend":
+		session.Backend = value
+	case "api_key_hint":
+		session.APIKeyHint = value
+	case "created_at":
+		session.CreatedAt = value
+	case "updated_at":
+		session.UpdatedAt = value
+	case "hedge_mode":
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("parse hedge_mode: %w", err)
+		}
+		session.HedgeMode = copyBoolPtr(&parsed)
+	}
+
+	return nil
+}
+
 func splitYAMLKeyValue(line string) (string, string, bool, error) {
 	index := strings.IndexRune(line, ':')
 	if index <= 0 {
@@ -372,32 +476,58 @@ func encodeConfigYAML(config storedConfig) ([]byte, error) {
 	builder.WriteString("schema_version: ")
 	builder.WriteString(strconv.Itoa(config.SchemaVersion))
 	builder.WriteString("\n")
+	if config.ActiveAccount != "" {
+		builder.WriteString("active_account: ")
+		builder.WriteString(strconv.Quote(config.ActiveAccount))
+		builder.WriteString("\n")
+	}
 
 	if config.Session != nil {
 		builder.WriteString("session:\n")
-		writeSessionString(&builder, "backend", config.Session.Backend)
-		writeSessionString(&builder, "api_key_hint", config.Session.APIKeyHint)
-		if config.Session.HedgeMode != nil {
-			builder.WriteString("  hedge_mode: ")
-			if *config.Session.HedgeMode {
-				builder.WriteString("true\n")
-			} else {
-				builder.WriteString("false\n")
-			}
+		writeSession(&builder, "  ", config.Session)
+	}
+
+	if len(config.Accounts) > 0 {
+		names := make([]string, 0, len(config.Accounts))
+		for name := range config.Accounts {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		builder.WriteString("accounts:\n")
+		for _, name := range names {
+			builder.WriteString("  ")
+			builder.WriteString(name)
+			builder.WriteString(":\n")
+			writeSession(&builder, "    ", config.Accounts[name])
 		}
-		writeSessionString(&builder, "created_at", config.Session.CreatedAt)
-		writeSessionString(&builder, "updated_at", config.Session.UpdatedAt)
 	}
 
 	return []byte(builder.String()), nil
 }
 
-func writeSessionString(builder *strings.Builder, key string, value string) {
+func writeSession(builder *strings.Builder, indent string, session *storedSession) {
+	writeSessionString(builder, indent, "backend", session.Backend)
+	writeSessionString(builder, indent, "api_key_hint", session.APIKeyHint)
+	if session.HedgeMode != nil {
+		builder.WriteString(indent)
+		builder.WriteString("hedge_mode: ")
+		if *session.HedgeMode {
+			builder.WriteString("true\n")
+		} else {
+			builder.WriteString("false\n")
+		}
+	}
+	writeSessionString(builder, indent, "created_at", session.CreatedAt)
+	writeSessionString(builder, indent, "updated_at", session.UpdatedAt)
+}
+
+func writeSessionString(builder *strings.Builder, indent string, key string, value string) {
 	if value == "" {
 		return
 	}
 
-	builder.WriteString("  ")
+	builder.WriteString(indent)
 	builder.WriteString(key)
 	builder.WriteString(": ")
 	builder.WriteString(strconv.Quote(value))
@@ -90,3 +90,67 @@ func TestFileSessionStoreReadsLegacyJSONConfig(t *testing.T) {
 		t.Fatalf("expected hedge_mode=true from legacy json config, got %#v", session.HedgeMode)
 	}
 }
+
+func TestFileSessionStoreKeepsSeparateAccountSlots(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	store := NewFileSessionStore(configPath)
+	grid := store.ForAccount("grid")
+	ctx := context.Background()
+
+	now := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	hedgeOn, hedgeOff := true, false
+	if err := store.SaveSession(ctx, ports.SessionMetadata{Backend: "os-keychain", APIKeyHint: "ma***in", HedgeMode: &hedgeOn, CreatedAt: now, UpdatedAt: now}); err != nil {
+		t.Fatalf("save default session: %v", err)
+	}
+	if err := grid.SaveSession(ctx, ports.SessionMetadata{Backend: "encrypted-file", APIKeyHint: "gr***id", HedgeMode: &hedgeOff, CreatedAt: now, UpdatedAt: now}); err != nil {
+		t.Fatalf("save grid session: %v", err)
+	}
+	if err := store.SetActiveAccount(ctx, "grid"); err != nil {
+		t.Fatalf("set active account: %v", err)
+	}
+
+	fileData, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("read config: %v", err)
+	}
+	for _, expected := range []string{"active_account: \"grid\"", "session:\n  backend: \"os-keychain\"", "accounts:\n  grid:\n    backend: \"encrypted-file\""} {
+		if !strings.Contains(string(fileData), expected) {
+			t.Fatalf("expected %q in config, got:\n%s", expected, fileData)
+		}
+	}
+
+	reopened := NewFileSessionStore(configPath)
+	active, err := reopened.ActiveAccount(ctx)
+	if err != nil || active != "grid" {
+		t.Fatalf("expected active account grid, got %q, %v", active, err)
+	}
+	defaultSession, found, err := reopened.GetSession(ctx)
+	if err != nil || !found || defaultSession.HedgeMode == nil || !*defaultSession.HedgeMode {
+		t.Fatalf("unexpected default session: %+v, %t, %v", defaultSession, found, err)
+	}
+	gridSession, found, err := reopened.ForAccount("grid").GetSession(ctx)
+	if err != nil || !found || gridSession.Backend != "encrypted-file" || gridSession.HedgeMode == nil || *gridSession.HedgeMode {
+		t.Fatalf("unexpected grid session: %+v, %t, %v", gridSession, found, err)
+	}
+
+	if err := reopened.ForAccount("grid").ClearSession(ctx); err != nil {
+		t.Fatalf("clear grid session: %v", err)
+	}
+	if _, found, _ := reopened.AccountSession(ctx, "grid"); found {
+		t.Fatal("expected grid session cleared")
+	}
+	if _, found, _ := reopened.GetSession(ctx); !found {
+		t.Fatal("expected default session kept")
+	}
+
+	if err := reopened.SetActiveAccount(ctx, "default"); err != nil {
+		t.Fatalf("set active account: %v", err)
+	}
+	fileData, err = os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("read config: %v", err)
+	}
+	if strings.Contains(string(fileData), "active_account") || strings.Contains(string(fileData), "accounts:") {
+		t.Fatalf("expected single-account layout, got:\n%s", fileData)
+	}
+}
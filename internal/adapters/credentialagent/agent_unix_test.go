@@ -0,0 +1,190 @@
+//go:build unix
+
+package credentialagent
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/adapters/clock"
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// inProcessStart serves the agent in a goroutine instead of a detached process.
+func inProcessStart(t *testing.T) StartFunc {
+	t.Helper()
+
+	return func(ctx context.Context, socketPath string, credential domainauth.Credential, ttl time.Duration) error {
+		held := domainauth.Credential{APIKey: credential.APIKey, APISecret: append([]byte(nil), credential.APISecret...)}
+		ready := make(chan struct{})
+		done := make(chan error, 1)
+		go func() {
+			done <- Serve(context.Background(), socketPath, held, ttl, clock.Real{}, func() error {
+				close(ready)
+				return nil
+			})
+		}()
+		t.Cleanup(func() {
+			NewClient(socketPath, nil).Lock(context.Background())
+			<-done
+		})
+
+		select {
+		case <-ready:
+			return nil
+		case err := <-done:
+			return err
+		}
+	}
+}
+
+func newTestClient(t *testing.T) (*Client, string) {
+	t.Helper()
+
+	socketPath := filepath.Join(t.TempDir(), "agent", "agent.sock")
+	return NewClient(socketPath, inProcessStart(t)), socketPath
+}
+
+func TestClientUnlockLoadAndLock(t *testing.T) {
+	client, socketPath := newTestClient(t)
+	ctx := context.Background()
+
+	expiresAt, err := client.Unlock(ctx, domainauth.Credential{APIKey: "key-1234", APISecret: []byte("secret")}, time.Minute)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if until := time.Until(expiresAt); until <= 0 || until > time.Minute {
+		t.Fatalf("unexpected expiry %s", expiresAt)
+	}
+
+	info, err := os.Stat(socketPath)
+	if err != nil {
+		t.Fatalf("stat socket: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected socket mode 0600, got %o", info.Mode().Perm())
+	}
+
+	credential, err := client.Load(ctx)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if credential.APIKey != "key-1234" || string(credential.APISecret) != "secret" {
+		t.Fatalf("unexpected credential %q", credential.APIKey)
+	}
+
+	wasUnlocked, err := client.Lock(ctx)
+	if err != nil || !wasUnlocked {
+		t.Fatalf("expected running agent locked, got %t, %v", wasUnlocked, err)
+	}
+	waitForSocketRemoval(t, socketPath)
+
+	if _, err := client.Load(ctx); !errors.Is(err, errAgentNotRunning) {
+		t.Fatalf("expected errAgentNotRunning, got %v", err)
+	}
+	wasUnlocked, err = client.Lock(ctx)
+	if err != nil || wasUnlocked {
+		t.Fatalf("expected nothing to lock, got %t, %v", wasUnlocked, err)
+	}
+}
+
+func TestServeStopsWhenTTLElapses(t *testing.T) {
+	client, socketPath := newTestClient(t)
+
+	if _, err := client.Unlock(context.Background(), domainauth.Credential{APIKey: "key-1234", APISecret: []byte("secret")}, 50*time.Millisecond); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	waitForSocketRemoval(t, socketPath)
+
+	if _, err := client.Load(context.Background()); !errors.Is(err, errAgentNotRunning) {
+		t.Fatalf("expected errAgentNotRunning after ttl, got %v", err)
+	}
+}
+
+func TestAgentCredentialStoreIgnoresStaleAgent(t *testing.T) {
+	client, socketPath := newTestClient(t)
+	ctx := context.Background()
+	if _, err := client.Unlock(ctx, domainauth.Credential{APIKey: "agent-key-1234", APISecret: []byte("agent-secret")}, time.Minute); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	sessionStore := &staticSessionStore{session: ports.SessionMetadata{APIKeyHint: domainauth.APIKeyHint("agent-key-1234")}}
+	wrapped := &staticCredentialStore{credential: domainauth.Credential{APIKey: "stored-key-5678", APISecret: []byte("stored-secret")}}
+	store := NewAgentCredentialStore(wrapped, sessionStore, client)
+
+	credential, err := store.Load(ctx)
+	if err != nil || credential.APIKey != "agent-key-1234" {
+		t.Fatalf("expected agent credential, got %q, %v", credential.APIKey, err)
+	}
+	if wrapped.loads != 0 {
+		t.Fatalf("expected wrapped store untouched, got %d loads", wrapped.loads)
+	}
+
+	sessionStore.session.APIKeyHint = domainauth.APIKeyHint("stored-key-5678")
+	credential, err = store.Load(ctx)
+	if err != nil || credential.APIKey != "stored-key-5678" {
+		t.Fatalf("expected stored credential, got %q, %v", credential.APIKey, err)
+	}
+	waitForSocketRemoval(t, socketPath)
+}
+
+func waitForSocketRemoval(t *testing.T, socketPath string) {
+	t.Helper()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		if _, err := os.Stat(socketPath); errors.Is(err, os.ErrNotExist) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("agent socket %s was not removed", socketPath)
+}
+
+type staticSessionStore struct {
+	session ports.SessionMetadata
+}
+
+func (store *staticSessionStore) SaveSession(_ context.Context, session ports.SessionMetadata) error {
+	store.session = session
+	return nil
+}
+
+func (store *staticSessionStore) GetSession(context.Context) (ports.SessionMetadata, bool, error) {
+	return store.session, true, nil
+}
+
+func (store *staticSessionStore) ClearSession(context.Context) error {
+	return nil
+}
+
+type staticCredentialStore struct {
+	credential domainauth.Credential
+	loads      int
+}
+
+func (store *staticCredentialStore) BackendName() string {
+	return "os-keychain"
+}
+
+func (store *staticCredentialStore) Save(context.Context, domainauth.Credential) error {
+	return nil
+}
+
+func (store *staticCredentialStore) Load(context.Context) (domainauth.Credential, error) {
+	store.loads++
+	return store.credential, nil
+}
+
+func (store *staticCredentialStore) Exists(context.Context) (bool, error) {
+	return true, nil
+}
+
+func (store *staticCredentialStore) Delete(context.Context) error {
+	return nil
+}
@@ -0,0 +1,161 @@
+package credentialagent
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+const (
+	agentDirName      = "agent"
+	agentSocketPrefix = "agent"
+	configDirName     = ".wbcli"
+)
+
+// errAgentNotRunning indicates that no agent listens on the socket.
+var errAgentNotRunning = errors.New("credential agent is not running")
+
+// StartFunc starts an agent serving credential on socketPath for ttl and returns once it accepts connections.
+type StartFunc func(ctx context.Context, socketPath string, credential domainauth.Credential, ttl time.Duration) error
+
+// Client talks to the per-user credential agent.
+type Client struct {
+	socketPath string
+	start      StartFunc
+}
+
+var _ ports.CredentialAgent = (*Client)(nil)
+
+// NewDefaultClient constructs Client for account on DefaultSocketPath that starts agents by re-running the current executable.
+func NewDefaultClient(account string) (*Client, error) {
+	socketPath, err := DefaultSocketPath(account)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClient(socketPath, SpawnAgent), nil
+}
+
+// NewClient constructs Client on socketPath.
+func NewClient(socketPath string, start StartFunc) *Client {
+	return &Client{socketPath: socketPath, start: start}
+}
+
+// DefaultSocketPath returns $XDG_RUNTIME_DIR/wbcli/agent.sock, or ~/.wbcli/agent/agent.sock without a runtime directory.
+// Accounts other than the default one get their own agent on agent-<name>.sock.
+func DefaultSocketPath(account string) (string, error) {
+	socketName := agentSocketPrefix + ".sock"
+	if account != domainauth.DefaultAccount {
+		socketName = agentSocketPrefix + "-" + account + ".sock"
+	}
+
+	if runtimeDir := os.Getenv("XDG_RUNTIME_DIR"); runtimeDir != "" {
+		return filepath.Join(runtimeDir, "wbcli", socketName), nil
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("resolve user home directory: %w", err)
+	}
+
+	return filepath.Join(homeDir, configDirName, agentDirName, socketName), nil
+}
+
+// Unlock stops a running agent and starts a new one holding credential for ttl.
+func (client *Client) Unlock(ctx context.Context, credential domainauth.Credential, ttl time.Duration) (time.Time, error) {
+	if _, err := client.Lock(ctx); err != nil {
+		return time.Time{}, err
+	}
+	if err := client.start(ctx, client.socketPath, credential, ttl); err != nil {
+		return time.Time{}, fmt.Errorf("start credential agent: %w", err)
+	}
+
+	response, err := client.call(ctx, opStatus)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("query credential agent: %w", err)
+	}
+
+	return parseExpiry(response)
+}
+
+// Lock stops the running agent, which wipes its credential; it reports whether one was running.
+func (client *Client) Lock(ctx context.Context) (bool, error) {
+	_, err := client.call(ctx, opLock)
+	if errors.Is(err, errAgentNotRunning) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("lock credential agent: %w", err)
+	}
+
+	return true, nil
+}
+
+// Load returns the credential held by the agent.
+func (client *Client) Load(ctx context.Context) (domainauth.Credential, error) {
+	response, err := client.call(ctx, opLoad)
+	if err != nil {
+		return domainauth.Credential{}, err
+	}
+
+	return domainauth.Credential{APIKey: response.APIKey, APISecret: response.APISecret}, nil
+}
+
+func (client *Client) call(ctx context.Context, op string) (agentResponse, error) {
+	dialer := net.Dialer{Timeout: connectionTimeout}
+	connection, err := dialer.DialContext(ctx, "unix", client.socketPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) || errors.Is(err, syscall.ECONNREFUSED) {
+			return agentResponse{}, errAgentNotRunning
+		}
+
+		return agentResponse{}, fmt.Errorf("connect to credential agent: %w", err)
+	}
+	defer connection.Close()
+	connection.SetDeadline(time.Now().Add(connectionTimeout))
+
+	request, err := json.Marshal(agentRequest{Op: op})
+	if err != nil {
+		return agentResponse{}, fmt.Errorf("encode agent request: %w", err)
+	}
+	if _, err := connection.Write(append(request, '\n')); err != nil {
+		return agentResponse{}, fmt.Errorf("send agent request: %w", err)
+	}
+
+	reader := bufio.NewReaderSize(connection, maxMessageSize)
+	line, err := reader.ReadSlice('\n')
+	defer domainauth.WipeBytes(line)
+	if err != nil {
+		return agentResponse{}, fmt.Errorf("read agent response: %w", err)
+	}
+
+	var response agentResponse
+	if err := json.Unmarshal(line, &response); err != nil {
+		return agentResponse{}, fmt.Errorf("decode agent response: %w", err)
+	}
+	if !response.OK {
+		domainauth.WipeBytes(response.APISecret)
+		return agentResponse{}, fmt.Errorf("credential agent: %s", response.Error)
+	}
+
+	return response, nil
+}
+
+func parseExpiry(response agentResponse) (time.Time, error) {
+	expiresAt, err := time.Parse(time.RFC3339Nano, response.ExpiresAt)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("parse agent expiry: %w", err)
+	}
+
+	return expiresAt, nil
+}
@@ -0,0 +1,13 @@
+//go:build !unix
+
+package credentialagent
+
+import (
+	"os/exec"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+)
+
+func detach(*exec.Cmd) error {
+	return ports.ErrCredentialAgentUnsupported
+}
@@ -0,0 +1,15 @@
+//go:build unix
+
+package credentialagent
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// detach runs command in its own session so it outlives the terminal that started it.
+func detach(command *exec.Cmd) error {
+	command.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package credentialagent
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+const (
+	opLoad   = "load"
+	opStatus = "status"
+	opLock   = "lock"
+
+	connectionTimeout = 2 * time.Second
+	maxMessageSize    = 16 * 1024
+)
+
+type agentRequest struct {
+	Op string `json:"op"`
+}
+
+type agentResponse struct {
+	OK        bool   `json:"ok"`
+	Error     string `json:"error,omitempty"`
+	APIKey    string `json:"api_key,omitempty"`
+	APISecret []byte `json:"api_secret,omitempty"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+// Serve holds credential in memory and answers requests on a 0600 Unix socket at socketPath
+// until ttl elapses, a lock request arrives or ctx is cancelled. ready is called once the socket accepts connections.
+// The secret is wiped and the socket removed before Serve returns.
+func Serve(
+	ctx context.Context,
+	socketPath string,
+	credential domainauth.Credential,
+	ttl time.Duration,
+	clock ports.Clock,
+	ready func() error,
+) error {
+	defer domainauth.WipeBytes(credential.APISecret)
+
+	expiresAt := clock.Now().Add(ttl)
+	ctx, cancel := context.WithDeadline(ctx, expiresAt)
+	defer cancel()
+
+	listener, socketInfo, err := listen(socketPath)
+	if err != nil {
+		return err
+	}
+	defer removeOwnSocket(socketPath, socketInfo)
+	defer listener.Close()
+
+	go func() {
+		<-ctx.Done()
+		listener.Close()
+	}()
+
+	if ready != nil {
+		if err := ready(); err != nil {
+			return err
+		}
+	}
+	slog.Debug("credential agent unlocked", "socket", socketPath, "expires_at", expiresAt.UTC())
+
+	for {
+		connection, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				slog.Debug("credential agent locked", "reason", context.Cause(ctx))
+				return nil
+			}
+
+			return fmt.Errorf("accept agent connection: %w", err)
+		}
+
+		if handle(connection, credential, expiresAt) {
+			cancel()
+		}
+	}
+}
+
+// listen creates the socket directory as 0700 and the socket as 0600, replacing a stale socket file.
+func listen(socketPath string) (*net.UnixListener, os.FileInfo, error) {
+	dir := filepath.Dir(socketPath)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, nil, fmt.Errorf("create agent directory: %w", err)
+	}
+	if err := os.Chmod(dir, 0o700); err != nil {
+		return nil, nil, fmt.Errorf("restrict agent directory: %w", err)
+	}
+	if err := os.Remove(socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, nil, fmt.Errorf("remove stale agent socket: %w", err)
+	}
+
+	listener, err := net.ListenUnix("unix", &net.UnixAddr{Name: socketPath, Net: "unix"})
+	if err != nil {
+		return nil, nil, fmt.Errorf("listen on agent socket: %w", err)
+	}
+	// a replacing agent may already listen on the same path when this one stops
+	listener.SetUnlinkOnClose(false)
+	if err := os.Chmod(socketPath, 0o600); err != nil {
+		listener.Close()
+		return nil, nil, fmt.Errorf("restrict agent socket: %w", err)
+	}
+	socketInfo, err := os.Stat(socketPath)
+	if err != nil {
+		listener.Close()
+		return nil, nil, fmt.Errorf("stat agent socket: %w", err)
+	}
+
+	return listener, socketInfo, nil
+}
+
+// removeOwnSocket removes socketPath unless another agent has replaced it.
+func removeOwnSocket(socketPath string, socketInfo os.FileInfo) {
+	current, err := os.Stat(socketPath)
+	if err == nil && os.SameFile(current, socketInfo) {
+		os.Remove(socketPath)
+	}
+}
+
+// handle answers one request and reports whether the agent should stop.
+func handle(connection net.Conn, credential domainauth.Credential, expiresAt time.Time) bool {
+	defer connection.Close()
+	connection.SetDeadline(time.Now().Add(connectionTimeout))
+
+	var request agentRequest
+	line, err := bufio.NewReader(connection).ReadSlice('\n')
+	if err == nil {
+		err = json.Unmarshal(line, &request)
+	}
+	if err != nil {
+		writeResponse(connection, agentResponse{Error: "invalid request"})
+		return false
+	}
+
+	response := agentResponse{OK: true, ExpiresAt: expiresAt.UTC().Format(time.RFC3339Nano)}
+	switch request.Op {
+	case opLoad:
+		response.APIKey = credential.APIKey
+		response.APISecret = credential.APISecret
+	case opStatus:
+	case opLock:
+		writeResponse(connection, response)
+		return true
+	default:
+		response = agentResponse{Error: fmt.Sprintf("unknown op %q", request.Op)}
+	}
+	writeResponse(connection, response)
+
+	return false
+}
+
+func writeResponse(connection net.Conn, response agentResponse) {
+	encoded, err := json.Marshal(response)
+	if err != nil {
+		return
+	}
+	defer domainauth.WipeBytes(encoded)
+
+	connection.Write(encoded)
+	connection.Write([]byte{'\n'})
+}
@@ -0,0 +1,87 @@
+package credentialagent
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+const (
+	// ServeCommand is the hidden wbcli subcommand that runs the agent; it reads "key\nsecret\n" on stdin.
+	ServeCommand = "agent-serve"
+	// ReadyLine is written to stdout by the agent once its socket accepts connections.
+	ReadyLine = "ready"
+
+	startTimeout = 5 * time.Second
+)
+
+// SpawnAgent starts `wbcli auth agent-serve` detached from the terminal session and hands it the credential
+// over a stdin pipe, so the secret never touches the filesystem or the process arguments.
+func SpawnAgent(ctx context.Context, socketPath string, credential domainauth.Credential, ttl time.Duration) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("resolve wbcli executable: %w", err)
+	}
+
+	command := exec.Command(executable, "auth", ServeCommand, "--socket", socketPath, "--ttl", ttl.String())
+	if err := detach(command); err != nil {
+		return err
+	}
+	stdin, err := command.StdinPipe()
+	if err != nil {
+		return fmt.Errorf("open agent stdin: %w", err)
+	}
+	stdout, err := command.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("open agent stdout: %w", err)
+	}
+	if err := command.Start(); err != nil {
+		return fmt.Errorf("start agent process: %w", err)
+	}
+
+	payload := make([]byte, 0, len(credential.APIKey)+len(credential.APISecret)+2)
+	payload = append(payload, credential.APIKey...)
+	payload = append(payload, '\n')
+	payload = append(payload, credential.APISecret...)
+	payload = append(payload, '\n')
+	_, writeErr := stdin.Write(payload)
+	domainauth.WipeBytes(payload)
+	stdin.Close()
+	if writeErr != nil {
+		command.Process.Kill()
+		return fmt.Errorf("send credential to agent: %w", writeErr)
+	}
+
+	ready := make(chan error, 1)
+	go func() {
+		line, err := bufio.NewReader(stdout).ReadString('\n')
+		if err == nil && strings.TrimSpace(line) != ReadyLine {
+			err = fmt.Errorf("unexpected agent output %q", strings.TrimSpace(line))
+		}
+		ready <- err
+	}()
+
+	timer := time.NewTimer(startTimeout)
+	defer timer.Stop()
+	select {
+	case err = <-ready:
+	case <-timer.C:
+		err = errors.New("agent did not become ready in time")
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	if err != nil {
+		command.Process.Kill()
+		command.Wait()
+		return err
+	}
+
+	return command.Process.Release()
+}
@@ -0,0 +1,78 @@
+package credentialagent
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// AgentCredentialStore serves Load from an unlocked agent and falls back to the wrapped store.
+// An agent holding a key other than the one recorded in session metadata is stale
+// (after login, rotate or logout) and is locked instead of used.
+type AgentCredentialStore struct {
+	store        ports.CredentialStore
+	sessionStore ports.SessionStore
+	agent        *Client
+}
+
+var _ ports.CredentialStore = (*AgentCredentialStore)(nil)
+
+// NewAgentCredentialStore constructs AgentCredentialStore over store.
+func NewAgentCredentialStore(store ports.CredentialStore, sessionStore ports.SessionStore, agent *Client) *AgentCredentialStore {
+	return &AgentCredentialStore{store: store, sessionStore: sessionStore, agent: agent}
+}
+
+// BackendName returns the wrapped backend name.
+func (store *AgentCredentialStore) BackendName() string {
+	return store.store.BackendName()
+}
+
+// Save writes to the wrapped store and locks the agent, which now holds an outdated credential.
+func (store *AgentCredentialStore) Save(ctx context.Context, credential domainauth.Credential) error {
+	if err := store.store.Save(ctx, credential); err != nil {
+		return err
+	}
+	store.lock(ctx)
+
+	return nil
+}
+
+// Load returns the agent credential when it matches the session, otherwise reads the wrapped store.
+func (store *AgentCredentialStore) Load(ctx context.Context) (domainauth.Credential, error) {
+	credential, err := store.agent.Load(ctx)
+	if err == nil {
+		session, found, sessionErr := store.sessionStore.GetSession(ctx)
+		if sessionErr == nil && found && session.APIKeyHint == domainauth.APIKeyHint(credential.APIKey) {
+			return credential, nil
+		}
+
+		domainauth.WipeBytes(credential.APISecret)
+		slog.Debug("credential agent holds a stale credential")
+		store.lock(ctx)
+	} else if !errors.Is(err, errAgentNotRunning) {
+		slog.Debug("credential agent unavailable", "error", err)
+	}
+
+	return store.store.Load(ctx)
+}
+
+// Exists checks the wrapped store.
+func (store *AgentCredentialStore) Exists(ctx context.Context) (bool, error) {
+	return store.store.Exists(ctx)
+}
+
+// Delete removes the credential from the wrapped store and locks the agent.
+func (store *AgentCredentialStore) Delete(ctx context.Context) error {
+	store.lock(ctx)
+
+	return store.store.Delete(ctx)
+}
+
+func (store *AgentCredentialStore) lock(ctx context.Context) {
+	if _, err := store.agent.Lock(ctx); err != nil {
+		slog.Warn("credential agent was not locked", "error", err)
+	}
+}
@@ -0,0 +1,352 @@
+package secretstore
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	// EncryptedFileBackendName is the backend name of EncryptedFileStore.
+	EncryptedFileBackendName = "encrypted-file"
+
+	encryptedFileSchemaVersion = 1
+	encryptedFileDirName       = ".wbcli"
+	encryptedFileName          = "credentials.enc"
+	encryptedFileCipher        = "aes-256-gcm"
+	encryptedFileKDF           = "argon2id"
+	maxEncryptedFileSize       = 64 * 1024
+
+	// Argon2id parameters follow the second recommended option of RFC 9106 section 4.
+	argon2idTime      = 3
+	argon2idMemoryKiB = 64 * 1024
+	argon2idThreads   = 4
+	argon2idSaltSize  = 16
+	encryptionKeySize = 32
+
+	// bounds for parameters read back from disk, so a modified file cannot demand unbounded work
+	maxArgon2idTime      = 16
+	maxArgon2idMemoryKiB = 1024 * 1024
+)
+
+// encryptedFileHeader is authenticated as GCM additional data, so schema, KDF parameters
+// and metadata cannot be changed without failing decryption.
+type encryptedFileHeader struct {
+	SchemaVersion int                    `json:"schema_version"`
+	Cipher        string                 `json:"cipher"`
+	KDF           encryptedFileKDFParams `json:"kdf"`
+	Metadata      encryptedFileMetadata  `json:"metadata"`
+}
+
+type encryptedFileKDFParams struct {
+	Name      string `json:"name"`
+	Time      uint32 `json:"time"`
+	MemoryKiB uint32 `json:"memory_kib"`
+	Threads   uint8  `json:"threads"`
+	Salt      []byte `json:"salt"`
+}
+
+type encryptedFileMetadata struct {
+	Backend    string `json:"backend"`
+	APIKeyHint string `json:"api_key_hint"`
+	SavedAt    string `json:"saved_at"`
+}
+
+type encryptedFileRecord struct {
+	Header     encryptedFileHeader `json:"header"`
+	Nonce      []byte              `json:"nonce"`
+	Ciphertext []byte              `json:"ciphertext"`
+}
+
+// EncryptedFileStore stores a single credential in an owner-only file encrypted with AES-256-GCM
+// under an Argon2id key derived from a passphrase and a random per-record salt.
+// It is the explicit fallback for systems without a usable OS keychain.
+type EncryptedFileStore struct {
+	path       string
+	passphrase PassphraseSource
+	clock      ports.Clock
+	kdf        encryptedFileKDFParams
+}
+
+var _ ports.CredentialStore = (*EncryptedFileStore)(nil)
+
+// NewDefaultEncryptedFileStore constructs encrypted-file store at ~/.wbcli/credentials.enc.
+func NewDefaultEncryptedFileStore(passphrase PassphraseSource, clock ports.Clock) (*EncryptedFileStore, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("resolve user home directory: %w", err)
+	}
+
+	return NewEncryptedFileStore(filepath.Join(homeDir, encryptedFileDirName, encryptedFileName), passphrase, clock), nil
+}
+
+// NewEncryptedFileStore constructs encrypted-file store at custom path.
+func NewEncryptedFileStore(path string, passphrase PassphraseSource, clock ports.Clock) *EncryptedFileStore {
+	return &EncryptedFileStore{
+		path:       path,
+		passphrase: passphrase,
+		clock:      clock,
+		kdf: encryptedFileKDFParams{
+			Name:      encryptedFileKDF,
+			Time:      argon2idTime,
+			MemoryKiB: argon2idMemoryKiB,
+			Threads:   argon2idThreads,
+		},
+	}
+}
+
+// ForAccount returns a store for the named account slot next to the default file;
+// the default account keeps credentials.enc, others use credentials-<name>.enc.
+func (store *EncryptedFileStore) ForAccount(name string) *EncryptedFileStore {
+	copied := *store
+	copied.path = accountFilePath(store.path, name)
+
+	return &copied
+}
+
+// WithPassphrase returns a store on the same file that uses passphrase instead of the configured source.
+func (store *EncryptedFileStore) WithPassphrase(passphrase PassphraseSource) *EncryptedFileStore {
+	copied := *store
+	copied.passphrase = passphrase
+
+	return &copied
+}
+
+func accountFilePath(defaultPath string, account string) string {
+	if account == domainauth.DefaultAccount {
+		return defaultPath
+	}
+
+	extension := filepath.Ext(defaultPath)
+	return strings.TrimSuffix(defaultPath, extension) + "-" + account + extension
+}
+
+// BackendName returns stable backend identifier.
+func (store *EncryptedFileStore) BackendName() string {
+	return EncryptedFileBackendName
+}
+
+// Save encrypts credential with a fresh salt and nonce and atomically replaces the file.
+func (store *EncryptedFileStore) Save(ctx context.Context, credential domainauth.Credential) error {
+	header := encryptedFileHeader{
+		SchemaVersion: encryptedFileSchemaVersion,
+		Cipher:        encryptedFileCipher,
+		KDF:           store.kdf,
+		Metadata: encryptedFileMetadata{
+			Backend:    EncryptedFileBackendName,
+			APIKeyHint: domainauth.APIKeyHint(credential.APIKey),
+			SavedAt:    store.clock.Now().UTC().Format(time.RFC3339Nano),
+		},
+	}
+	header.KDF.Salt = make([]byte, argon2idSaltSize)
+	if _, err := io.ReadFull(rand.Reader, header.KDF.Salt); err != nil {
+		return fmt.Errorf("generate salt: %w", err)
+	}
+
+	aead, err := store.aead(ctx, header.KDF)
+	if err != nil {
+		return err
+	}
+
+	additionalData, err := json.Marshal(header)
+	if err != nil {
+		return fmt.Errorf("encode credential header: %w", err)
+	}
+	plaintext, err := json.Marshal(credentialPayload{
+		APIKey:    credential.APIKey,
+		APISecret: string(credential.APISecret),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal credential payload: %w", err)
+	}
+	defer domainauth.WipeBytes(plaintext)
+
+	record := encryptedFileRecord{Header: header, Nonce: make([]byte, aead.NonceSize())}
+	if _, err := io.ReadFull(rand.Reader, record.Nonce); err != nil {
+		return fmt.Errorf("generate nonce: %w", err)
+	}
+	record.Ciphertext = aead.Seal(nil, record.Nonce, plaintext, additionalData)
+
+	encoded, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode credential file: %w", err)
+	}
+
+	return writeFileAtomic(store.path, encoded)
+}
+
+// Load decrypts the stored credential. A wrong passphrase or any modification reports ports.ErrPassphraseMismatch.
+func (store *EncryptedFileStore) Load(ctx context.Context) (domainauth.Credential, error) {
+	record, err := store.read()
+	if err != nil {
+		return domainauth.Credential{}, err
+	}
+
+	header := record.Header
+	if header.SchemaVersion != encryptedFileSchemaVersion || header.Cipher != encryptedFileCipher || header.KDF.Name != encryptedFileKDF {
+		return domainauth.Credential{}, fmt.Errorf("unsupported credential file format (schema %d)", header.SchemaVersion)
+	}
+	if header.KDF.Time == 0 || header.KDF.Time > maxArgon2idTime ||
+		header.KDF.MemoryKiB == 0 || header.KDF.MemoryKiB > maxArgon2idMemoryKiB || header.KDF.Threads == 0 {
+		return domainauth.Credential{}, fmt.Errorf("%w: key derivation parameters out of range", ports.ErrPassphraseMismatch)
+	}
+
+	aead, err := store.aead(ctx, header.KDF)
+	if err != nil {
+		return domainauth.Credential{}, err
+	}
+	if len(record.Nonce) != aead.NonceSize() {
+		return domainauth.Credential{}, fmt.Errorf("%w: invalid nonce", ports.ErrPassphraseMismatch)
+	}
+
+	additionalData, err := json.Marshal(header)
+	if err != nil {
+		return domainauth.Credential{}, fmt.Errorf("encode credential header: %w", err)
+	}
+	plaintext, err := aead.Open(nil, record.Nonce, record.Ciphertext, additionalData)
+	if err != nil {
+		return domainauth.Credential{}, ports.ErrPassphraseMismatch
+	}
+	defer domainauth.WipeBytes(plaintext)
+
+	var payload credentialPayload
+	if err := json.Unmarshal(plaintext, &payload); err != nil {
+		return domainauth.Credential{}, fmt.Errorf("unmarshal credential payload: %w", err)
+	}
+
+	return domainauth.Credential{
+		APIKey:    payload.APIKey,
+		APISecret: []byte(payload.APISecret),
+	}, nil
+}
+
+// Exists reports whether the credential file is present; it does not need the passphrase.
+func (store *EncryptedFileStore) Exists(context.Context) (bool, error) {
+	_, err := os.Stat(store.path)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+
+	return false, mapFileError(err)
+}
+
+// Delete removes the credential file.
+func (store *EncryptedFileStore) Delete(context.Context) error {
+	if err := os.Remove(store.path); err != nil {
+		return mapFileError(err)
+	}
+
+	return nil
+}
+
+func (store *EncryptedFileStore) read() (encryptedFileRecord, error) {
+	file, err := os.Open(store.path)
+	if err != nil {
+		return encryptedFileRecord{}, mapFileError(err)
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(io.LimitReader(file, maxEncryptedFileSize+1))
+	if err != nil {
+		return encryptedFileRecord{}, fmt.Errorf("read credential file: %w", err)
+	}
+	if len(content) > maxEncryptedFileSize {
+		return encryptedFileRecord{}, fmt.Errorf("credential file exceeds %d bytes", maxEncryptedFileSize)
+	}
+
+	var record encryptedFileRecord
+	if err := json.Unmarshal(content, &record); err != nil {
+		return encryptedFileRecord{}, fmt.Errorf("decode credential file: %w", err)
+	}
+
+	return record, nil
+}
+
+// aead derives the file key from the passphrase; the passphrase and key are wiped before returning.
+func (store *EncryptedFileStore) aead(ctx context.Context, params encryptedFileKDFParams) (cipher.AEAD, error) {
+	if store.passphrase == nil {
+		return nil, ports.ErrPassphraseRequired
+	}
+	passphrase, err := store.passphrase.Passphrase(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer domainauth.WipeBytes(passphrase)
+	if len(passphrase) == 0 {
+		return nil, ports.ErrPassphraseRequired
+	}
+
+	key := argon2.IDKey(passphrase, params.Salt, params.Time, params.MemoryKiB, params.Threads, encryptionKeySize)
+	defer domainauth.WipeBytes(key)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("init cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func mapFileError(err error) error {
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return ports.ErrCredentialNotFound
+	case errors.Is(err, os.ErrPermission):
+		return fmt.Errorf("%w: %w", ports.ErrSecretStorePermissionDenied, err)
+	default:
+		return err
+	}
+}
+
+// writeFileAtomic replaces path with data through a 0600 temp file in the same directory.
+func writeFileAtomic(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("create credential directory: %w", err)
+	}
+
+	tempFile, err := os.CreateTemp(dir, "credentials-*.tmp")
+	if err != nil {
+		return fmt.Errorf("create temp credential file: %w", err)
+	}
+	tempFilePath := tempFile.Name()
+	defer os.Remove(tempFilePath)
+
+	if err := tempFile.Chmod(0o600); err != nil {
+		tempFile.Close()
+		return fmt.Errorf("set temp credential file mode: %w", err)
+	}
+	if _, err := tempFile.Write(data); err != nil {
+		tempFile.Close()
+		return fmt.Errorf("write temp credential file: %w", err)
+	}
+	if err := tempFile.Sync(); err != nil {
+		tempFile.Close()
+		return fmt.Errorf("sync temp credential file: %w", err)
+	}
+	if err := tempFile.Close(); err != nil {
+		return fmt.Errorf("close temp credential file: %w", err)
+	}
+
+	if err := os.Rename(tempFilePath, path); err != nil {
+		return fmt.Errorf("replace credential file: %w", err)
+	}
+
+	return nil
+}
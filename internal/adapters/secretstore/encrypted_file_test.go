@@ -0,0 +1,153 @@
+package secretstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (clock fixedClock) Now() time.Time {
+	return clock.now
+}
+
+func TestEncryptedFileStoreRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".wbcli", "credentials.enc")
+	store := NewEncryptedFileStore(path, StaticPassphrase([]byte("correct horse")), fixedClock{now: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)})
+	// keep tests fast; production parameters are covered by the constants
+	store.kdf.Time, store.kdf.MemoryKiB, store.kdf.Threads = 1, 64, 1
+	ctx := context.Background()
+
+	if err := store.Save(ctx, domainauth.Credential{APIKey: "public-key-1234", APISecret: []byte("secret-value")}); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected 0600 credential file, got %v (%v)", info, err)
+	}
+	content, _ := os.ReadFile(path)
+	if bytes.Contains(content, []byte("secret-value")) || bytes.Contains(content, []byte("public-key-1234")) {
+		t.Fatalf("credential file contains plaintext: %s", content)
+	}
+
+	credential, err := store.Load(ctx)
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if credential.APIKey != "public-key-1234" || string(credential.APISecret) != "secret-value" {
+		t.Fatalf("unexpected credential: %+v", credential)
+	}
+
+	if exists, err := store.Exists(ctx); err != nil || !exists {
+		t.Fatalf("expected credential to exist, got %v %v", exists, err)
+	}
+	if err := store.Delete(ctx); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if _, err := store.Load(ctx); !errors.Is(err, ports.ErrCredentialNotFound) {
+		t.Fatalf("expected %v after delete, got %v", ports.ErrCredentialNotFound, err)
+	}
+}
+
+func TestEncryptedFileStoreRejectsWrongPassphraseAndTampering(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.enc")
+	ctx := context.Background()
+	store := NewEncryptedFileStore(path, StaticPassphrase([]byte("correct horse")), fixedClock{now: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)})
+	store.kdf.Time, store.kdf.MemoryKiB, store.kdf.Threads = 1, 64, 1
+	if err := store.Save(ctx, domainauth.Credential{APIKey: "key", APISecret: []byte("secret")}); err != nil {
+		t.Fatalf("save failed: %v", err)
+	}
+
+	wrongStore := NewEncryptedFileStore(path, StaticPassphrase([]byte("wrong horse")), fixedClock{now: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)})
+	if _, err := wrongStore.Load(ctx); !errors.Is(err, ports.ErrPassphraseMismatch) {
+		t.Fatalf("expected %v for wrong passphrase, got %v", ports.ErrPassphraseMismatch, err)
+	}
+
+	content, _ := os.ReadFile(path)
+	var record map[string]any
+	if err := json.Unmarshal(content, &record); err != nil {
+		t.Fatalf("decode record: %v", err)
+	}
+	record["header"].(map[string]any)["metadata"].(map[string]any)["api_key_hint"] = "spoofed"
+	tampered, _ := json.Marshal(record)
+	if err := os.WriteFile(path, tampered, 0o600); err != nil {
+		t.Fatalf("write tampered record: %v", err)
+	}
+
+	if _, err := store.Load(ctx); !errors.Is(err, ports.ErrPassphraseMismatch) {
+		t.Fatalf("expected %v for modified metadata, got %v", ports.ErrPassphraseMismatch, err)
+	}
+}
+
+func TestEncryptedFileStoreKeepsAccountsInSeparateFiles(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), ".wbcli")
+	store := NewEncryptedFileStore(filepath.Join(dir, "credentials.enc"), StaticPassphrase([]byte("correct horse")), fixedClock{now: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)})
+	store.kdf.Time, store.kdf.MemoryKiB, store.kdf.Threads = 1, 64, 1
+	grid := store.ForAccount("grid")
+	ctx := context.Background()
+
+	if err := store.Save(ctx, domainauth.Credential{APIKey: "main-key-1234", APISecret: []byte("main-secret")}); err != nil {
+		t.Fatalf("save default failed: %v", err)
+	}
+	if err := grid.Save(ctx, domainauth.Credential{APIKey: "grid-key-5678", APISecret: []byte("grid-secret")}); err != nil {
+		t.Fatalf("save grid failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "credentials-grid.enc")); err != nil {
+		t.Fatalf("expected grid credential file: %v", err)
+	}
+
+	loaded, err := store.Load(ctx)
+	if err != nil || loaded.APIKey != "main-key-1234" {
+		t.Fatalf("expected default credential, got %q, %v", loaded.APIKey, err)
+	}
+	if err := grid.Delete(ctx); err != nil {
+		t.Fatalf("delete grid failed: %v", err)
+	}
+	if exists, err := store.Exists(ctx); err != nil || !exists {
+		t.Fatalf("expected default credential kept, got %t, %v", exists, err)
+	}
+}
+
+func TestEncryptedFileStoreRequiresPassphrase(t *testing.T) {
+	store := NewEncryptedFileStore(filepath.Join(t.TempDir(), "credentials.enc"), StaticPassphrase(nil), fixedClock{})
+
+	err := store.Save(context.Background(), domainauth.Credential{APIKey: "key", APISecret: []byte("secret")})
+	if !errors.Is(err, ports.ErrPassphraseRequired) {
+		t.Fatalf("expected %v, got %v", ports.ErrPassphraseRequired, err)
+	}
+}
+
+func TestPassphraseFromFileReadsFirstLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "passphrase")
+	if err := os.WriteFile(path, []byte("pass phrase\r\nignored\n"), 0o600); err != nil {
+		t.Fatalf("write passphrase: %v", err)
+	}
+
+	passphrase, err := PassphraseFromFile(path).Passphrase(context.Background())
+	if err != nil || string(passphrase) != "pass phrase" {
+		t.Fatalf("unexpected passphrase %q (%v)", passphrase, err)
+	}
+
+	t.Setenv(EnvPassphraseFD, "")
+	t.Setenv(EnvPassphraseFile, "")
+	if _, err := PassphraseFromEnv().Passphrase(context.Background()); !errors.Is(err, ports.ErrPassphraseRequired) {
+		t.Fatalf("expected %v without env, got %v", ports.ErrPassphraseRequired, err)
+	}
+	t.Setenv(EnvPassphraseFile, path)
+	if passphrase, err := PassphraseFromEnv().Passphrase(context.Background()); err != nil || !strings.HasPrefix(string(passphrase), "pass") {
+		t.Fatalf("expected passphrase from %s, got %q (%v)", EnvPassphraseFile, passphrase, err)
+	}
+}
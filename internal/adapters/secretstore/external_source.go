@@ -0,0 +1,168 @@
+package secretstore
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+const (
+	// EnvAPIKey holds the API key read by the env credential source.
+	EnvAPIKey = "WBCLI_API_KEY"
+	// EnvAPISecret holds the API secret read by the env credential source.
+	EnvAPISecret = "WBCLI_API_SECRET"
+
+	// EnvCredentialBackendName is the backend name of the env credential source.
+	EnvCredentialBackendName = "env"
+	// FDCredentialBackendName is the backend name of the file-descriptor credential source.
+	FDCredentialBackendName = "fd"
+
+	maxCredentialFDSize = 16 * 1024
+)
+
+var (
+	errCredentialSourceEmpty  = errors.New("credential source is empty")
+	errCredentialSourceFormat = errors.New("credential source must contain exactly two non-empty lines: API key, API secret")
+)
+
+// ExternalCredentialStore is a read-only credential store fed by the process environment
+// or an inherited file descriptor. It never reads or writes files under ~/.wbcli,
+// so CI jobs and containers can run commands without auth login.
+type ExternalCredentialStore struct {
+	name string
+	load func() (domainauth.Credential, error)
+}
+
+var _ ports.CredentialStore = (*ExternalCredentialStore)(nil)
+
+// NewEnvCredentialStore constructs a store reading EnvAPIKey and EnvAPISecret on every Load.
+func NewEnvCredentialStore() *ExternalCredentialStore {
+	return &ExternalCredentialStore{
+		name: EnvCredentialBackendName,
+		load: func() (domainauth.Credential, error) {
+			apiKey, hasKey := os.LookupEnv(EnvAPIKey)
+			apiSecret, hasSecret := os.LookupEnv(EnvAPISecret)
+			if !hasKey && !hasSecret {
+				return domainauth.Credential{}, fmt.Errorf("%w: %s and %s are not set", errCredentialSourceEmpty, EnvAPIKey, EnvAPISecret)
+			}
+
+			credential := domainauth.Credential{
+				APIKey:    strings.TrimSpace(apiKey),
+				APISecret: []byte(strings.TrimSpace(apiSecret)),
+			}
+			if err := credential.Validate(); err != nil {
+				domainauth.WipeBytes(credential.APISecret)
+				return domainauth.Credential{}, fmt.Errorf("%s/%s: %w", EnvAPIKey, EnvAPISecret, err)
+			}
+
+			return credential, nil
+		},
+	}
+}
+
+// NewFDCredentialStore constructs a store reading "key\nsecret\n" from an inherited file descriptor.
+// The descriptor is read and closed on first Load, since a pipe can only be read once;
+// later loads return copies of that credential.
+func NewFDCredentialStore(fd int) *ExternalCredentialStore {
+	read := sync.OnceValues(func() (domainauth.Credential, error) {
+		file := os.NewFile(uintptr(fd), "credentials-fd-"+strconv.Itoa(fd))
+		if file == nil {
+			return domainauth.Credential{}, fmt.Errorf("%w: credentials fd %d is not open", errCredentialSourceEmpty, fd)
+		}
+		defer file.Close()
+
+		credential, err := readCredentialPair(file)
+		if err != nil {
+			return domainauth.Credential{}, fmt.Errorf("credentials fd %d: %w", fd, err)
+		}
+
+		return credential, nil
+	})
+
+	return &ExternalCredentialStore{
+		name: FDCredentialBackendName,
+		load: func() (domainauth.Credential, error) {
+			credential, err := read()
+			if err != nil {
+				return domainauth.Credential{}, err
+			}
+
+			return domainauth.Credential{
+				APIKey:    credential.APIKey,
+				APISecret: append([]byte(nil), credential.APISecret...),
+			}, nil
+		},
+	}
+}
+
+// BackendName returns stable backend identifier.
+func (store *ExternalCredentialStore) BackendName() string {
+	return store.name
+}
+
+// Load returns a fresh credential copy; callers wipe APISecret after signing.
+func (store *ExternalCredentialStore) Load(context.Context) (domainauth.Credential, error) {
+	return store.load()
+}
+
+// Exists reports whether the source yields a valid credential.
+func (store *ExternalCredentialStore) Exists(ctx context.Context) (bool, error) {
+	credential, err := store.Load(ctx)
+	if errors.Is(err, errCredentialSourceEmpty) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	domainauth.WipeBytes(credential.APISecret)
+
+	return true, nil
+}
+
+// Save is rejected: external sources are managed outside wbcli.
+func (store *ExternalCredentialStore) Save(context.Context, domainauth.Credential) error {
+	return fmt.Errorf("%w: %s", ports.ErrCredentialStoreReadOnly, store.name)
+}
+
+// Delete is rejected: external sources are managed outside wbcli.
+func (store *ExternalCredentialStore) Delete(context.Context) error {
+	return fmt.Errorf("%w: %s", ports.ErrCredentialStoreReadOnly, store.name)
+}
+
+// readCredentialPair parses the auth login stdin contract: exactly two non-empty lines.
+func readCredentialPair(reader io.Reader) (domainauth.Credential, error) {
+	content, err := io.ReadAll(io.LimitReader(reader, maxCredentialFDSize+1))
+	if err != nil {
+		return domainauth.Credential{}, fmt.Errorf("read credentials: %w", err)
+	}
+	defer domainauth.WipeBytes(content)
+	if len(content) > maxCredentialFDSize {
+		return domainauth.Credential{}, fmt.Errorf("credentials exceed %d bytes", maxCredentialFDSize)
+	}
+
+	payload := bytes.TrimSuffix(bytes.TrimSuffix(content, []byte("\n")), []byte("\r"))
+	lines := bytes.Split(payload, []byte("\n"))
+	if len(lines) != 2 {
+		return domainauth.Credential{}, errCredentialSourceFormat
+	}
+	for index := range lines {
+		lines[index] = bytes.TrimSuffix(lines[index], []byte("\r"))
+		if len(lines[index]) == 0 {
+			return domainauth.Credential{}, errCredentialSourceFormat
+		}
+	}
+
+	return domainauth.Credential{
+		APIKey:    string(lines[0]),
+		APISecret: append([]byte(nil), lines[1]...),
+	}, nil
+}
@@ -0,0 +1,61 @@
+package secretstore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+func TestEnvCredentialStoreLoadsFromEnvironment(t *testing.T) {
+	t.Setenv(EnvAPIKey, "env-key-1234")
+	t.Setenv(EnvAPISecret, "env-secret\n")
+	store := NewEnvCredentialStore()
+	ctx := context.Background()
+
+	credential, err := store.Load(ctx)
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if credential.APIKey != "env-key-1234" || string(credential.APISecret) != "env-secret" {
+		t.Fatalf("unexpected credential: %q/%q", credential.APIKey, credential.APISecret)
+	}
+
+	// a wiped secret must not affect later loads
+	domainauth.WipeBytes(credential.APISecret)
+	again, err := store.Load(ctx)
+	if err != nil || string(again.APISecret) != "env-secret" {
+		t.Fatalf("expected fresh secret on reload, got %q, %v", again.APISecret, err)
+	}
+
+	if err := store.Save(ctx, credential); !errors.Is(err, ports.ErrCredentialStoreReadOnly) {
+		t.Fatalf("expected read-only save error, got %v", err)
+	}
+	if err := store.Delete(ctx); !errors.Is(err, ports.ErrCredentialStoreReadOnly) {
+		t.Fatalf("expected read-only delete error, got %v", err)
+	}
+}
+
+func TestEnvCredentialStoreReportsMissingVariables(t *testing.T) {
+	t.Setenv(EnvAPIKey, "")
+	t.Setenv(EnvAPISecret, "")
+	os.Unsetenv(EnvAPIKey)
+	os.Unsetenv(EnvAPISecret)
+	store := NewEnvCredentialStore()
+
+	exists, err := store.Exists(context.Background())
+	if err != nil || exists {
+		t.Fatalf("expected missing credentials, got exists=%v err=%v", exists, err)
+	}
+	if _, err := store.Load(context.Background()); err == nil {
+		t.Fatal("expected load error")
+	}
+
+	t.Setenv(EnvAPIKey, "env-key-1234")
+	if _, err := store.Load(context.Background()); !errors.Is(err, domainauth.ErrAPISecretRequired) {
+		t.Fatalf("expected missing secret error, got %v", err)
+	}
+}
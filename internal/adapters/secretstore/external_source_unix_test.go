@@ -0,0 +1,65 @@
+//go:build unix
+
+package secretstore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"syscall"
+	"testing"
+
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// detachFD returns a duplicate of file's descriptor and closes file, so the store owns the descriptor alone.
+func detachFD(t *testing.T, file *os.File) int {
+	t.Helper()
+
+	fd, err := syscall.Dup(int(file.Fd()))
+	if err != nil {
+		t.Fatalf("dup: %v", err)
+	}
+	file.Close()
+
+	return fd
+}
+
+func TestFDCredentialStoreReadsDescriptorOnce(t *testing.T) {
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("pipe: %v", err)
+	}
+	if _, err := writer.WriteString("fd-key-1234\r\nfd-secret\r\n"); err != nil {
+		t.Fatalf("write pipe: %v", err)
+	}
+	writer.Close()
+
+	store := NewFDCredentialStore(detachFD(t, reader))
+	for range 2 {
+		credential, err := store.Load(context.Background())
+		if err != nil {
+			t.Fatalf("load failed: %v", err)
+		}
+		if credential.APIKey != "fd-key-1234" || string(credential.APISecret) != "fd-secret" {
+			t.Fatalf("unexpected credential: %q/%q", credential.APIKey, credential.APISecret)
+		}
+		domainauth.WipeBytes(credential.APISecret)
+	}
+}
+
+func TestFDCredentialStoreRejectsMalformedPayload(t *testing.T) {
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("pipe: %v", err)
+	}
+	if _, err := writer.WriteString("only-key\n"); err != nil {
+		t.Fatalf("write pipe: %v", err)
+	}
+	writer.Close()
+
+	_, err = NewFDCredentialStore(detachFD(t, reader)).Load(context.Background())
+	if !errors.Is(err, errCredentialSourceFormat) {
+		t.Fatalf("expected format error, got %v", err)
+	}
+}
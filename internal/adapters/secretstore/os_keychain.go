@@ -13,17 +13,21 @@ import (
 	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
 )
 
-const (
-	serviceName   = "wbcli"
-	credentialKey = "default"
-)
+const serviceName = "wbcli"
 
-// OSKeychainStore stores credentials in platform keychain tools.
-type OSKeychainStore struct{}
+// OSKeychainStore stores credentials in platform keychain tools, one entry per account slot.
+type OSKeychainStore struct {
+	account string
+}
 
-// NewOSKeychainStore constructs OS keychain-backed credential store.
+// NewOSKeychainStore constructs OS keychain-backed credential store for the default account.
 func NewOSKeychainStore() *OSKeychainStore {
-	return &OSKeychainStore{}
+	return &OSKeychainStore{account: domainauth.DefaultAccount}
+}
+
+// ForAccount returns a store on the keychain entry of the named account slot.
+func (store *OSKeychainStore) ForAccount(name string) *OSKeychainStore {
+	return &OSKeychainStore{account: name}
 }
 
 // BackendName returns backend identifier.
@@ -31,7 +35,7 @@ func (store *OSKeychainStore) BackendName() string {
 	return "os-keychain"
 }
 
-// Save writes single credential to OS keychain.
+// Save writes the account credential to OS keychain.
 func (store *OSKeychainStore) Save(ctx context.Context, credential domainauth.Credential) error {
 	payload, err := marshalCredential(credential)
 	if err != nil {
@@ -40,15 +44,15 @@ func (store *OSKeychainStore) Save(ctx context.Context, credential domainauth.Cr
 
 	switch runtime.GOOS {
 	case "darwin":
-		return saveDarwin(ctx, payload)
+		return saveDarwin(ctx, store.account, payload)
 	case "linux":
-		return saveLinux(ctx, payload)
+		return saveLinux(ctx, store.account, payload)
 	default:
 		return ports.ErrSecretStoreUnavailable
 	}
 }
 
-// Load reads single credential from OS keychain.
+// Load reads the account credential from OS keychain.
 func (store *OSKeychainStore) Load(ctx context.Context) (domainauth.Credential, error) {
 	var (
 		payload string
@@ -57,9 +61,9 @@ func (store *OSKeychainStore) Load(ctx context.Context) (domainauth.Credential,
 
 	switch runtime.GOOS {
 	case "darwin":
-		payload, err = loadDarwin(ctx)
+		payload, err = loadDarwin(ctx, store.account)
 	case "linux":
-		payload, err = loadLinux(ctx)
+		payload, err = loadLinux(ctx, store.account)
 	default:
 		return domainauth.Credential{}, ports.ErrSecretStoreUnavailable
 	}
@@ -88,13 +92,13 @@ func (store *OSKeychainStore) Exists(ctx context.Context) (bool, error) {
 	return false, err
 }
 
-// Delete removes single credential from OS keychain.
+// Delete removes the account credential from OS keychain.
 func (store *OSKeychainStore) Delete(ctx context.Context) error {
 	switch runtime.GOOS {
 	case "darwin":
-		return deleteDarwin(ctx)
+		return deleteDarwin(ctx, store.account)
 	case "linux":
-		return deleteLinux(ctx)
+		return deleteLinux(ctx, store.account)
 	default:
 		return ports.ErrSecretStoreUnavailable
 	}
@@ -129,14 +133,14 @@ func unmarshalCredential(payload string) (domainauth.Credential, error) {
 	}, nil
 }
 
-func saveDarwin(ctx context.Context, payload string) error {
+func saveDarwin(ctx context.Context, account string, payload string) error {
 	if _, err := exec.LookPath("security"); err != nil {
 		return ports.ErrSecretStoreUnavailable
 	}
 
 	output, err := runCommand(ctx, "", "security",
 		"add-generic-password",
-		"-a", credentialKey,
+		"-a", account,
 		"-s", serviceName,
 		"-w", payload,
 		"-U",
@@ -148,14 +152,14 @@ func saveDarwin(ctx context.Context, payload string) error {
 	return nil
 }
 
-func loadDarwin(ctx context.Context) (string, error) {
+func loadDarwin(ctx context.Context, account string) (string, error) {
 	if _, err := exec.LookPath("security"); err != nil {
 		return "", ports.ErrSecretStoreUnavailable
 	}
 
 	output, err := runCommand(ctx, "", "security",
 		"find-generic-password",
-		"-a", credentialKey,
+		"-a", account,
 		"-s", serviceName,
 		"-w",
 	)
@@ -166,14 +170,14 @@ func loadDarwin(ctx context.Context) (string, error) {
 	return strings.TrimSpace(string(output)), nil
 }
 
-func deleteDarwin(ctx context.Context) error {
+func deleteDarwin(ctx context.Context, account string) error {
 	if _, err := exec.LookPath("security"); err != nil {
 		return ports.ErrSecretStoreUnavailable
 	}
 
 	output, err := runCommand(ctx, "", "security",
 		"delete-generic-password",
-		"-a", credentialKey,
+		"-a", account,
 		"-s", serviceName,
 	)
 	if err != nil {
@@ -188,7 +192,7 @@ func deleteDarwin(ctx context.Context) error {
 	return nil
 }
 
-func saveLinux(ctx context.Context, payload string) error {
+func saveLinux(ctx context.Context, account string, payload string) error {
 	if _, err := exec.LookPath("secret-tool"); err != nil {
 		return ports.ErrSecretStoreUnavailable
 	}
@@ -197,7 +201,7 @@ func saveLinux(ctx context.Context, payload string) error {
 		"store",
 		"--label=wbcli",
 		"service", serviceName,
-		"account", credentialKey,
+		"account", account,
 	)
 	if err != nil {
 		return mapCommandError(err, output)
@@ -206,7 +210,7 @@ func saveLinux(ctx context.Context, payload string) error {
 	return nil
 }
 
-func loadLinux(ctx context.Context) (string, error) {
+func loadLinux(ctx context.Context, account string) (string, error) {
 	if _, err := exec.LookPath("secret-tool"); err != nil {
 		return "", ports.ErrSecretStoreUnavailable
 	}
@@ -214,7 +218,7 @@ func loadLinux(ctx context.Context) (string, error) {
 	output, err := runCommand(ctx, "", "secret-tool",
 		"lookup",
 		"service", serviceName,
-		"account", credentialKey,
+		"account", account,
 	)
 	if err != nil {
 		return "", mapCommandError(err, output)
@@ -228,7 +232,7 @@ func loadLinux(ctx context.Context) (string, error) {
 	return trimmed, nil
 }
 
-func deleteLinux(ctx context.Context) error {
+func deleteLinux(ctx context.Context, account string) error {
 	if _, err := exec.LookPath("secret-tool"); err != nil {
 		return ports.ErrSecretStoreUnavailable
 	}
@@ -236,7 +240,7 @@ func deleteLinux(ctx context.Context) error {
 	output, err := runCommand(ctx, "", "secret-tool",
 		"clear",
 		"service", serviceName,
-		"account", credentialKey,
+		"account", account,
 	)
 	if err != nil {
 		mappedErr := mapCommandError(err, output)
@@ -0,0 +1,138 @@
+package secretstore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+const (
+	// EnvPassphraseFile names a file whose first line is the encrypted-file passphrase.
+	EnvPassphraseFile = "WBCLI_PASSPHRASE_FILE"
+	// EnvPassphraseFD names an inherited file descriptor to read the encrypted-file passphrase from.
+	EnvPassphraseFD = "WBCLI_PASSPHRASE_FD"
+
+	maxPassphraseSize = 4 * 1024
+)
+
+// PassphraseSource supplies the encrypted-file passphrase. Callers wipe the returned bytes.
+type PassphraseSource interface {
+	Passphrase(ctx context.Context) ([]byte, error)
+}
+
+// PassphraseFunc adapts a function to PassphraseSource.
+type PassphraseFunc func(ctx context.Context) ([]byte, error)
+
+// Passphrase calls source.
+func (source PassphraseFunc) Passphrase(ctx context.Context) ([]byte, error) {
+	return source(ctx)
+}
+
+// StaticPassphrase returns a copy of passphrase on every call.
+func StaticPassphrase(passphrase []byte) PassphraseSource {
+	stored := append([]byte(nil), passphrase...)
+
+	return PassphraseFunc(func(context.Context) ([]byte, error) {
+		if len(stored) == 0 {
+			return nil, ports.ErrPassphraseRequired
+		}
+
+		return append([]byte(nil), stored...), nil
+	})
+}
+
+// PassphraseFromFile reads the first line of path on every call.
+func PassphraseFromFile(path string) PassphraseSource {
+	return PassphraseFunc(func(context.Context) ([]byte, error) {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("open passphrase file: %w", err)
+		}
+		defer file.Close()
+
+		return readPassphrase(file)
+	})
+}
+
+// PassphraseFromFD reads the first line of an inherited file descriptor once and serves copies afterwards,
+// since a pipe can only be read once.
+func PassphraseFromFD(fd int) PassphraseSource {
+	read := sync.OnceValues(func() ([]byte, error) {
+		file := os.NewFile(uintptr(fd), "passphrase-fd-"+strconv.Itoa(fd))
+		if file == nil {
+			return nil, fmt.Errorf("passphrase fd %d is not open", fd)
+		}
+		defer file.Close()
+
+		return readPassphrase(file)
+	})
+
+	return PassphraseFunc(func(context.Context) ([]byte, error) {
+		passphrase, err := read()
+		if err != nil {
+			return nil, err
+		}
+
+		return append([]byte(nil), passphrase...), nil
+	})
+}
+
+// PassphraseFromEnv resolves EnvPassphraseFD, then EnvPassphraseFile, when first used.
+// Without either it reports ports.ErrPassphraseRequired.
+func PassphraseFromEnv() PassphraseSource {
+	resolve := sync.OnceValues(func() (PassphraseSource, error) {
+		if value := strings.TrimSpace(os.Getenv(EnvPassphraseFD)); value != "" {
+			fd, err := strconv.Atoi(value)
+			if err != nil || fd < 0 {
+				return nil, fmt.Errorf("%s must be a file descriptor number", EnvPassphraseFD)
+			}
+
+			return PassphraseFromFD(fd), nil
+		}
+		if path := strings.TrimSpace(os.Getenv(EnvPassphraseFile)); path != "" {
+			return PassphraseFromFile(path), nil
+		}
+
+		return nil, ports.ErrPassphraseRequired
+	})
+
+	return PassphraseFunc(func(ctx context.Context) ([]byte, error) {
+		source, err := resolve()
+		if err != nil {
+			return nil, err
+		}
+
+		return source.Passphrase(ctx)
+	})
+}
+
+// readPassphrase returns the first line of reader without its line ending.
+func readPassphrase(reader io.Reader) ([]byte, error) {
+	content, err := io.ReadAll(io.LimitReader(reader, maxPassphraseSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read passphrase: %w", err)
+	}
+	defer domainauth.WipeBytes(content)
+	if len(content) > maxPassphraseSize {
+		return nil, fmt.Errorf("passphrase exceeds %d bytes", maxPassphraseSize)
+	}
+
+	line := content
+	if index := bytes.IndexByte(content, '\n'); index >= 0 {
+		line = content[:index]
+	}
+	line = bytes.TrimSuffix(line, []byte("\r"))
+	if len(line) == 0 {
+		return nil, ports.ErrPassphraseRequired
+	}
+
+	return append([]byte(nil), line...), nil
+}
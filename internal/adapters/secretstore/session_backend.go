@@ -0,0 +1,118 @@
+package secretstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// SessionBackendStore routes credential operations to the backend recorded in session metadata,
+// so commands keep reading secrets from wherever auth login stored them.
+// The first store is the default used before any login.
+type SessionBackendStore struct {
+	sessionStore ports.SessionStore
+	stores       []ports.CredentialStore
+}
+
+var (
+	_ ports.CredentialStore           = (*SessionBackendStore)(nil)
+	_ ports.CredentialBackendSelector = (*SessionBackendStore)(nil)
+)
+
+// NewSessionBackendStore constructs SessionBackendStore over stores, the first being the default.
+func NewSessionBackendStore(sessionStore ports.SessionStore, stores ...ports.CredentialStore) *SessionBackendStore {
+	return &SessionBackendStore{sessionStore: sessionStore, stores: stores}
+}
+
+// BackendName returns the active backend name.
+func (store *SessionBackendStore) BackendName() string {
+	active, err := store.active(context.Background())
+	if err != nil {
+		return store.stores[0].BackendName()
+	}
+
+	return active.BackendName()
+}
+
+// Save writes credential to the active backend.
+func (store *SessionBackendStore) Save(ctx context.Context, credential domainauth.Credential) error {
+	active, err := store.active(ctx)
+	if err != nil {
+		return err
+	}
+
+	return active.Save(ctx, credential)
+}
+
+// Load reads credential from the active backend.
+func (store *SessionBackendStore) Load(ctx context.Context) (domainauth.Credential, error) {
+	active, err := store.active(ctx)
+	if err != nil {
+		return domainauth.Credential{}, err
+	}
+
+	return active.Load(ctx)
+}
+
+// Exists checks the active backend.
+func (store *SessionBackendStore) Exists(ctx context.Context) (bool, error) {
+	active, err := store.active(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return active.Exists(ctx)
+}
+
+// Delete removes credential from the active backend.
+func (store *SessionBackendStore) Delete(ctx context.Context) error {
+	active, err := store.active(ctx)
+	if err != nil {
+		return err
+	}
+
+	return active.Delete(ctx)
+}
+
+// SelectBackend returns the named store; an empty name selects the active one.
+// A passphrase is applied to the encrypted-file backend only.
+func (store *SessionBackendStore) SelectBackend(name string, passphrase []byte) (ports.CredentialStore, error) {
+	if name == "" {
+		name = store.BackendName()
+	}
+
+	selected, err := store.byName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if encrypted, ok := selected.(*EncryptedFileStore); ok && len(passphrase) > 0 {
+		return encrypted.WithPassphrase(StaticPassphrase(passphrase)), nil
+	}
+
+	return selected, nil
+}
+
+func (store *SessionBackendStore) active(ctx context.Context) (ports.CredentialStore, error) {
+	session, found, err := store.sessionStore.GetSession(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("read session metadata: %w", err)
+	}
+	if !found || session.Backend == "" {
+		return store.stores[0], nil
+	}
+
+	return store.byName(session.Backend)
+}
+
+func (store *SessionBackendStore) byName(name string) (ports.CredentialStore, error) {
+	for _, candidate := range store.stores {
+		if candidate.BackendName() == name {
+			return candidate, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ports.ErrUnknownCredentialBackend, name)
+}
@@ -0,0 +1,186 @@
+package whitebit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+const (
+	URLPathCollateralBalance       = "/api/v4/collateral-account/balance"
+	URLPathCollateralSummary       = "/api/v4/collateral-account/summary"
+	URLPathCollateralOpenPositions = "/api/v4/collateral-account/positions/open"
+	URLPathCollateralLeverage      = "/api/v4/collateral-account/leverage"
+	URLPathCollateralHedgeModeSet  = "/api/v4/collateral-account/hedge-mode/update"
+)
+
+// SupportedLeverages lists documented collateral account leverage values.
+var SupportedLeverages = []int{1, 2, 3, 5, 10, 20, 50, 100}
+
+// ErrInvalidLeverage indicates leverage outside documented values.
+var ErrInvalidLeverage = errors.New("leverage must be one of 1, 2, 3, 5, 10, 20, 50, 100")
+
+// CollateralSummaryResponse models collateral account summary endpoint response.
+type CollateralSummaryResponse struct {
+	Equity            string      `json:"equity"`
+	Margin            string      `json:"margin"`
+	FreeMargin        string      `json:"freeMargin"`
+	UnrealizedFunding string      `json:"unrealizedFunding"`
+	PnL               string      `json:"pnl"`
+	Leverage          json.Number `json:"leverage,omitempty"`
+}
+
+// CollateralLeverageRequest is request payload for leverage update endpoint.
+type CollateralLeverageRequest struct {
+	Leverage int `json:"leverage"`
+}
+
+// CollateralLeverageResponse models leverage update endpoint response.
+type CollateralLeverageResponse struct {
+	Leverage int `json:"leverage"`
+}
+
+// CollateralHedgeModeRequest is request payload for hedge mode update endpoint.
+type CollateralHedgeModeRequest struct {
+	HedgeMode bool `json:"hedgeMode"`
+}
+
+// OpenPositionsRequest is request payload for open positions endpoint.
+type OpenPositionsRequest struct {
+	Market string `json:"market,omitempty"`
+}
+
+// PositionResponse models one open collateral position.
+// Amount is signed in one-way mode (negative for short); in hedge mode PositionSide tells the leg.
+type PositionResponse struct {
+	PositionID        int64        `json:"positionId"`
+	Market            string       `json:"market"`
+	OpenDate          float64      `json:"openDate"`
+	ModifyDate        float64      `json:"modifyDate"`
+	Amount            string       `json:"amount"`
+	BasePrice         string       `json:"basePrice"`
+	LiquidationPrice  string       `json:"liquidationPrice"`
+	LiquidationState  string       `json:"liquidationState"`
+	PnL               string       `json:"pnl"`
+	PnLPercent        string       `json:"pnlPercent"`
+	Margin            string       `json:"margin"`
+	FreeMargin        string       `json:"freeMargin"`
+	Funding           string       `json:"funding"`
+	UnrealizedFunding string       `json:"unrealizedFunding"`
+	PositionSide      PositionSide `json:"positionSide,omitempty"`
+}
+
+type collateralBalancePayload struct {
+	privateEnvelope
+}
+
+type collateralSummaryPayload struct {
+	privateEnvelope
+}
+
+type collateralLeveragePayload struct {
+	privateEnvelope
+	CollateralLeverageRequest
+}
+
+type collateralHedgeModeUpdatePayload struct {
+	privateEnvelope
+	CollateralHedgeModeRequest
+}
+
+type openPositionsPayload struct {
+	privateEnvelope
+	OpenPositionsRequest
+}
+
+// GetCollateralBalance calls WhiteBIT collateral balance endpoint and returns balances by asset ticker.
+func (client *Client) GetCollateralBalance(ctx context.Context, credential domainauth.Credential) (map[string]string, error) {
+	payload := collateralBalancePayload{
+		privateEnvelope: client.nextPrivateEnvelope(URLPathCollateralBalance),
+	}
+
+	response := map[string]string{}
+	if err := client.doPrivateRequest(ctx, credential, URLPathCollateralBalance, payload, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetCollateralSummary calls WhiteBIT collateral account summary endpoint.
+func (client *Client) GetCollateralSummary(ctx context.Context, credential domainauth.Credential) (CollateralSummaryResponse, error) {
+	payload := collateralSummaryPayload{
+		privateEnvelope: client.nextPrivateEnvelope(URLPathCollateralSummary),
+	}
+
+	var response CollateralSummaryResponse
+	if err := client.doPrivateRequest(ctx, credential, URLPathCollateralSummary, payload, &response); err != nil {
+		return CollateralSummaryResponse{}, err
+	}
+
+	return response, nil
+}
+
+// GetOpenPositions calls WhiteBIT open positions endpoint.
+func (client *Client) GetOpenPositions(
+	ctx context.Context,
+	credential domainauth.Credential,
+	request OpenPositionsRequest,
+) ([]PositionResponse, error) {
+	payload := openPositionsPayload{
+		privateEnvelope:      client.nextPrivateEnvelope(URLPathCollateralOpenPositions),
+		OpenPositionsRequest: request,
+	}
+
+	var response []PositionResponse
+	if err := client.doPrivateRequest(ctx, credential, URLPathCollateralOpenPositions, payload, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// SetCollateralLeverage calls WhiteBIT leverage update endpoint.
+func (client *Client) SetCollateralLeverage(
+	ctx context.Context,
+	credential domainauth.Credential,
+	request CollateralLeverageRequest,
+) (CollateralLeverageResponse, error) {
+	if !slices.Contains(SupportedLeverages, request.Leverage) {
+		return CollateralLeverageResponse{}, ErrInvalidLeverage
+	}
+
+	payload := collateralLeveragePayload{
+		privateEnvelope:           client.nextPrivateEnvelope(URLPathCollateralLeverage),
+		CollateralLeverageRequest: request,
+	}
+
+	var response CollateralLeverageResponse
+	if err := client.doPrivateRequest(ctx, credential, URLPathCollateralLeverage, payload, &response); err != nil {
+		return CollateralLeverageResponse{}, err
+	}
+
+	return response, nil
+}
+
+// SetCollateralAccountHedgeMode calls WhiteBIT hedge mode update endpoint.
+func (client *Client) SetCollateralAccountHedgeMode(
+	ctx context.Context,
+	credential domainauth.Credential,
+	request CollateralHedgeModeRequest,
+) (CollateralAccountHedgeModeResponse, error) {
+	payload := collateralHedgeModeUpdatePayload{
+		privateEnvelope:            client.nextPrivateEnvelope(URLPathCollateralHedgeModeSet),
+		CollateralHedgeModeRequest: request,
+	}
+
+	var response CollateralAccountHedgeModeResponse
+	if err := client.doPrivateRequest(ctx, credential, URLPathCollateralHedgeModeSet, payload, &response); err != nil {
+		return CollateralAccountHedgeModeResponse{}, err
+	}
+
+	return response, nil
+}
@@ -31,6 +31,24 @@ func BuildAPIError(err error, endpoint, operation string) *ports.APIError {
 			Message: operation + " failed: credentials are invalid",
 			Details: fmt.Sprintf("endpoint: %s. reason: %s", endpoint, detail),
 		}
+	case errors.Is(err, whitebit.ErrAPIValidation):
+		return &ports.APIError{
+			Code:    ports.CodeInvalidRequest,
+			Message: operation + " failed: request rejected as invalid",
+			Details: fmt.Sprintf("endpoint: %s. reason: %s", endpoint, err.Error()),
+		}
+	case errors.Is(err, whitebit.ErrAPIBusinessRule):
+		return &ports.APIError{
+			Code:    ports.CodeBusinessRule,
+			Message: operation + " failed: rejected by exchange",
+			Details: fmt.Sprintf("endpoint: %s. reason: %s", endpoint, err.Error()),
+		}
+	case errors.Is(err, whitebit.ErrAPITransport):
+		return &ports.APIError{
+			Code:    ports.CodeTransport,
+			Message: operation + " failed: exchange unavailable",
+			Details: fmt.Sprintf("endpoint: %s. reason: %s", endpoint, err.Error()),
+		}
 	default:
 		return &ports.APIError{
 			Code:    ports.CodeUnavailable,
@@ -0,0 +1,110 @@
+package whitebit_collateral_adapters
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/ChewX3D/crypto/internal/adapters/whitebit"
+	whitebit_adapters_common "github.com/ChewX3D/crypto/internal/adapters/whitebit/adapters"
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// CollateralAccountReaderAdapter adapts app account port to WhiteBIT transport client.
+type CollateralAccountReaderAdapter struct {
+	client whitebit.PrivateClient
+}
+
+var _ ports.CollateralAccountReader = (*CollateralAccountReaderAdapter)(nil)
+
+// NewCollateralAccountReaderAdapter constructs account reader adapter.
+func NewCollateralAccountReaderAdapter(client whitebit.PrivateClient) *CollateralAccountReaderAdapter {
+	return &CollateralAccountReaderAdapter{client: client}
+}
+
+// NewDefaultCollateralAccountReaderAdapter constructs account reader adapter with default client.
+func NewDefaultCollateralAccountReaderAdapter() *CollateralAccountReaderAdapter {
+	return NewCollateralAccountReaderAdapter(whitebit.NewDefaultClient())
+}
+
+// Balances returns collateral balances sorted by asset ticker.
+func (adapter *CollateralAccountReaderAdapter) Balances(
+	ctx context.Context,
+	credential domainauth.Credential,
+) ([]ports.CollateralBalance, error) {
+	response, err := adapter.client.GetCollateralBalance(ctx, credential)
+	if err != nil {
+		return nil, whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathCollateralBalance, "collateral balance query")
+	}
+
+	balances := make([]ports.CollateralBalance, 0, len(response))
+	for asset, amount := range response {
+		balances = append(balances, ports.CollateralBalance{Asset: asset, Amount: amount})
+	}
+	sort.Slice(balances, func(left int, right int) bool {
+		return balances[left].Asset < balances[right].Asset
+	})
+
+	return balances, nil
+}
+
+// Summary returns collateral account equity and margin snapshot.
+func (adapter *CollateralAccountReaderAdapter) Summary(
+	ctx context.Context,
+	credential domainauth.Credential,
+) (ports.CollateralAccountSummary, error) {
+	response, err := adapter.client.GetCollateralSummary(ctx, credential)
+	if err != nil {
+		return ports.CollateralAccountSummary{}, whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathCollateralSummary, "collateral summary query")
+	}
+
+	return ports.CollateralAccountSummary{
+		Equity:            response.Equity,
+		Margin:            response.Margin,
+		FreeMargin:        response.FreeMargin,
+		UnrealizedFunding: response.UnrealizedFunding,
+		PnL:               response.PnL,
+		Leverage:          response.Leverage.String(),
+	}, nil
+}
+
+// OpenPositions returns open positions, optionally filtered by market.
+func (adapter *CollateralAccountReaderAdapter) OpenPositions(
+	ctx context.Context,
+	credential domainauth.Credential,
+	market string,
+) ([]ports.CollateralPosition, error) {
+	response, err := adapter.client.GetOpenPositions(ctx, credential, whitebit.OpenPositionsRequest{Market: market})
+	if err != nil {
+		return nil, whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathCollateralOpenPositions, "open positions query")
+	}
+
+	positions := make([]ports.CollateralPosition, 0, len(response))
+	for _, position := range response {
+		positionSide := strings.ToLower(string(position.PositionSide))
+		if !whitebit.PositionSide(positionSide).IsValid() {
+			positionSide = ""
+		}
+
+		positions = append(positions, ports.CollateralPosition{
+			PositionID:        position.PositionID,
+			Market:            position.Market,
+			PositionSide:      positionSide,
+			Amount:            position.Amount,
+			BasePrice:         position.BasePrice,
+			LiquidationPrice:  position.LiquidationPrice,
+			LiquidationState:  position.LiquidationState,
+			PnL:               position.PnL,
+			PnLPercent:        position.PnLPercent,
+			Margin:            position.Margin,
+			FreeMargin:        position.FreeMargin,
+			Funding:           position.Funding,
+			UnrealizedFunding: position.UnrealizedFunding,
+			OpenedAt:          whitebit_adapters_common.UnixSecondsToTime(position.OpenDate),
+			UpdatedAt:         whitebit_adapters_common.UnixSecondsToTime(position.ModifyDate),
+		})
+	}
+
+	return positions, nil
+}
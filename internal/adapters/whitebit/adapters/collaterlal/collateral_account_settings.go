@@ -0,0 +1,58 @@
+package whitebit_collateral_adapters
+
+import (
+	"context"
+
+	"github.com/ChewX3D/crypto/internal/adapters/whitebit"
+	whitebit_adapters_common "github.com/ChewX3D/crypto/internal/adapters/whitebit/adapters"
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// CollateralAccountSettingsAdapter adapts app account settings port to WhiteBIT transport client.
+type CollateralAccountSettingsAdapter struct {
+	client whitebit.PrivateClient
+}
+
+var _ ports.CollateralAccountSettings = (*CollateralAccountSettingsAdapter)(nil)
+
+// NewCollateralAccountSettingsAdapter constructs account settings adapter.
+func NewCollateralAccountSettingsAdapter(client whitebit.PrivateClient) *CollateralAccountSettingsAdapter {
+	return &CollateralAccountSettingsAdapter{client: client}
+}
+
+// NewDefaultCollateralAccountSettingsAdapter constructs account settings adapter with default client.
+func NewDefaultCollateralAccountSettingsAdapter() *CollateralAccountSettingsAdapter {
+	return NewCollateralAccountSettingsAdapter(whitebit.NewDefaultClient())
+}
+
+// SetLeverage updates account leverage and returns the value reported by WhiteBIT.
+func (adapter *CollateralAccountSettingsAdapter) SetLeverage(
+	ctx context.Context,
+	credential domainauth.Credential,
+	leverage int,
+) (int, error) {
+	response, err := adapter.client.SetCollateralLeverage(ctx, credential, whitebit.CollateralLeverageRequest{Leverage: leverage})
+	if err != nil {
+		return 0, whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathCollateralLeverage, "leverage update")
+	}
+	if response.Leverage == 0 {
+		return leverage, nil
+	}
+
+	return response.Leverage, nil
+}
+
+// SetHedgeMode updates account hedge mode and returns the value reported by WhiteBIT.
+func (adapter *CollateralAccountSettingsAdapter) SetHedgeMode(
+	ctx context.Context,
+	credential domainauth.Credential,
+	enabled bool,
+) (bool, error) {
+	response, err := adapter.client.SetCollateralAccountHedgeMode(ctx, credential, whitebit.CollateralHedgeModeRequest{HedgeMode: enabled})
+	if err != nil {
+		return false, whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathCollateralHedgeModeSet, "hedge mode update")
+	}
+
+	return response.HedgeMode, nil
+}
@@ -0,0 +1,105 @@
+package whitebit_collateral_adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ChewX3D/crypto/internal/adapters/whitebit"
+	whitebit_adapters_common "github.com/ChewX3D/crypto/internal/adapters/whitebit/adapters"
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// CollateralHistoryReaderAdapter adapts app history port to WhiteBIT transport client.
+type CollateralHistoryReaderAdapter struct {
+	client whitebit.PrivateClient
+}
+
+var _ ports.CollateralHistoryReader = (*CollateralHistoryReaderAdapter)(nil)
+
+// NewCollateralHistoryReaderAdapter constructs history reader adapter.
+func NewCollateralHistoryReaderAdapter(client whitebit.PrivateClient) *CollateralHistoryReaderAdapter {
+	return &CollateralHistoryReaderAdapter{client: client}
+}
+
+// NewDefaultCollateralHistoryReaderAdapter constructs history reader adapter with default client.
+func NewDefaultCollateralHistoryReaderAdapter() *CollateralHistoryReaderAdapter {
+	return NewCollateralHistoryReaderAdapter(whitebit.NewDefaultClient())
+}
+
+// OrderHistoryPage returns one page of finished orders, newest first.
+func (adapter *CollateralHistoryReaderAdapter) OrderHistoryPage(
+	ctx context.Context,
+	credential domainauth.Credential,
+	query ports.HistoryPageQuery,
+) ([]ports.CollateralOrderHistoryEntry, error) {
+	page, err := adapter.client.GetOrderHistory(ctx, credential, whitebit.OrderHistoryRequest{
+		Market:        query.Market,
+		ClientOrderID: query.ClientOrderID,
+		Limit:         query.Limit,
+		Offset:        query.Offset,
+	})
+	if err != nil {
+		return nil, whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathOrderHistory, "order history query")
+	}
+
+	orders := make([]ports.CollateralOrderHistoryEntry, 0, len(page))
+	for _, order := range page {
+		orders = append(orders, ports.CollateralOrderHistoryEntry{
+			OrderID:       order.OrderID,
+			ClientOrderID: order.ClientOrderID,
+			Market:        order.Market,
+			Side:          strings.ToLower(string(order.Side)),
+			PositionSide:  string(order.PositionSide),
+			Type:          order.Type,
+			Status:        strings.ToLower(order.Status),
+			Price:         order.Price,
+			Amount:        order.Amount,
+			DealStock:     order.DealStock,
+			DealMoney:     order.DealMoney,
+			DealFee:       order.DealFee,
+			PostOnly:      order.PostOnly,
+			CreatedAt:     whitebit_adapters_common.UnixSecondsToTime(order.CreatedAt),
+			FinishedAt:    whitebit_adapters_common.UnixSecondsToTime(order.FinishedAt),
+		})
+	}
+
+	return orders, nil
+}
+
+// TradesPage returns one page of fills, newest first.
+func (adapter *CollateralHistoryReaderAdapter) TradesPage(
+	ctx context.Context,
+	credential domainauth.Credential,
+	query ports.HistoryPageQuery,
+) ([]ports.CollateralTrade, error) {
+	page, err := adapter.client.GetExecutedHistory(ctx, credential, whitebit.ExecutedHistoryRequest{
+		Market:        query.Market,
+		ClientOrderID: query.ClientOrderID,
+		Limit:         query.Limit,
+		Offset:        query.Offset,
+	})
+	if err != nil {
+		return nil, whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathExecutedHistory, "trade history query")
+	}
+
+	trades := make([]ports.CollateralTrade, 0, len(page))
+	for _, trade := range page {
+		trades = append(trades, ports.CollateralTrade{
+			TradeID:       trade.TradeID,
+			OrderID:       trade.OrderID,
+			ClientOrderID: trade.ClientOrderID,
+			Market:        trade.Market,
+			Side:          strings.ToLower(string(trade.Side)),
+			Role:          trade.Role.String(),
+			Price:         trade.Price,
+			Amount:        trade.Amount,
+			Deal:          trade.Deal,
+			Fee:           trade.Fee,
+			FeeAsset:      trade.FeeAsset,
+			ExecutedAt:    whitebit_adapters_common.UnixSecondsToTime(trade.Timestamp),
+		})
+	}
+
+	return trades, nil
+}
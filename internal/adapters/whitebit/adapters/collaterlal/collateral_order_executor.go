@@ -3,6 +3,7 @@ package whitebit_collateral_adapters
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/ChewX3D/crypto/internal/adapters/whitebit"
 	whitebit_adapters_common "github.com/ChewX3D/crypto/internal/adapters/whitebit/adapters"
@@ -46,9 +47,144 @@ func (adapter *CollateralOrderExecutorAdapter) PlaceCollateralLimitOrder(
 	credential domainauth.Credential,
 	request ports.CollateralLimitOrderRequest,
 ) (json.RawMessage, error) {
+	result, err := adapter.client.PlaceCollateralLimitOrder(ctx, credential, toLimitOrderRequest(request))
+	if err != nil {
+		return nil, whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathCollateralLimitOrder, "order placement")
+	}
+
+	return result, nil
+}
+
+// PlaceCollateralBulkLimitOrder submits one bulk request and returns outcomes aligned with orders.
+// Orders missing from the exchange response (for example after stopOnFail) are reported as not processed.
+func (adapter *CollateralOrderExecutorAdapter) PlaceCollateralBulkLimitOrder(
+	ctx context.Context,
+	credential domainauth.Credential,
+	orders []ports.CollateralLimitOrderRequest,
+	stopOnFail bool,
+) ([]ports.CollateralBulkOrderResult, error) {
+	request := whitebit.CollateralBulkLimitOrderRequest{
+		Orders:     make([]whitebit.CollateralLimitOrderRequest, 0, len(orders)),
+		StopOnFail: &stopOnFail,
+	}
+	for _, order := range orders {
+		request.Orders = append(request.Orders, toLimitOrderRequest(order))
+	}
+
+	response, err := adapter.client.PlaceCollateralBulkLimitOrder(ctx, credential, request)
+	if err != nil {
+		return nil, whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathCollateralLimitOrderBulk, "bulk order placement")
+	}
+
+	results := make([]ports.CollateralBulkOrderResult, len(orders))
+	for index, order := range orders {
+		results[index].ClientOrderID = order.ClientOrderID
+		if index >= len(response) {
+			results[index].Error = "not processed by exchange"
+			continue
+		}
+
+		item := response[index]
+		switch {
+		case item.Error != nil:
+			results[index].Error = item.Error.Detail()
+			if results[index].Error == "" {
+				results[index].Error = "rejected by exchange"
+			}
+		case item.Result != nil:
+			results[index].Accepted = true
+			results[index].OrderID = item.Result.OrderID
+			if item.Result.ClientOrderID != "" {
+				results[index].ClientOrderID = item.Result.ClientOrderID
+			}
+		default:
+			results[index].Error = "empty bulk order result"
+		}
+	}
+
+	return results, nil
+}
+
+// PlaceCollateralTakerOrder routes market, stop-market and stop-limit orders to their WhiteBIT endpoints.
+func (adapter *CollateralOrderExecutorAdapter) PlaceCollateralTakerOrder(
+	ctx context.Context,
+	credential domainauth.Credential,
+	request ports.CollateralTakerOrderRequest,
+) (ports.CollateralPlacedOrder, error) {
+	side := whitebit.OrderSide(request.Side)
+	positionSide := whitebit.PositionSide(request.PositionSide)
+
+	var (
+		result json.RawMessage
+		err    error
+		path   string
+	)
+	switch request.Type {
+	case ports.CollateralOrderTypeMarket:
+		path = whitebit.URLPathCollateralMarketOrder
+		result, err = adapter.client.PlaceCollateralMarketOrder(ctx, credential, whitebit.CollateralMarketOrderRequest{
+			Market:        request.Market,
+			Side:          side,
+			Amount:        request.Amount,
+			PositionSide:  positionSide,
+			ClientOrderID: request.ClientOrderID,
+		})
+	case ports.CollateralOrderTypeStopMarket:
+		path = whitebit.URLPathCollateralStopMarketOrder
+		result, err = adapter.client.PlaceCollateralStopMarketOrder(ctx, credential, whitebit.CollateralStopMarketOrderRequest{
+			Market:          request.Market,
+			Side:            side,
+			Amount:          request.Amount,
+			ActivationPrice: request.ActivationPrice,
+			PositionSide:    positionSide,
+			ClientOrderID:   request.ClientOrderID,
+		})
+	case ports.CollateralOrderTypeStopLimit:
+		path = whitebit.URLPathCollateralStopLimitOrder
+		result, err = adapter.client.PlaceCollateralStopLimitOrder(ctx, credential, whitebit.CollateralStopLimitOrderRequest{
+			Market:          request.Market,
+			Side:            side,
+			Amount:          request.Amount,
+			Price:           request.Price,
+			ActivationPrice: request.ActivationPrice,
+			PositionSide:    positionSide,
+			ClientOrderID:   request.ClientOrderID,
+		})
+	default:
+		return ports.CollateralPlacedOrder{}, fmt.Errorf("unsupported collateral order type %q", request.Type)
+	}
+	if err != nil {
+		return ports.CollateralPlacedOrder{}, whitebit_adapters_common.BuildAPIError(err, path, request.Type+" order placement")
+	}
+
+	return toPlacedOrder(result, request.ClientOrderID), nil
+}
+
+// toPlacedOrder decodes the order acknowledgement. An undecodable body still means the order was accepted,
+// so it yields an acknowledgement without fill data instead of an error.
+func toPlacedOrder(raw json.RawMessage, clientOrderID string) ports.CollateralPlacedOrder {
+	placed := ports.CollateralPlacedOrder{ClientOrderID: clientOrderID}
+
+	var response whitebit.CollateralOrderResponse
+	if err := json.Unmarshal(raw, &response); err != nil {
+		return placed
+	}
+
+	placed.OrderID = response.OrderID
+	if response.ClientOrderID != "" {
+		placed.ClientOrderID = response.ClientOrderID
+	}
+	placed.Amount = response.Amount
+	placed.DealStock = response.DealStock
+	placed.DealMoney = response.DealMoney
+
+	return placed
+}
+
+func toLimitOrderRequest(request ports.CollateralLimitOrderRequest) whitebit.CollateralLimitOrderRequest {
 	postOnly := request.PostOnly
 
-	result, err := adapter.client.PlaceCollateralLimitOrder(ctx, credential, whitebit.CollateralLimitOrderRequest{
+	return whitebit.CollateralLimitOrderRequest{
 		Market:        request.Market,
 		Side:          whitebit.OrderSide(request.Side),
 		PositionSide:  whitebit.PositionSide(request.PositionSide),
@@ -56,10 +192,7 @@ func (adapter *CollateralOrderExecutorAdapter) PlaceCollateralLimitOrder(
 		Price:         request.Price,
 		ClientOrderID: request.ClientOrderID,
 		PostOnly:      &postOnly,
-	})
-	if err != nil {
-		return nil, whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathCollateralLimitOrder, "order placement")
+		StopLoss:      request.StopLoss,
+		TakeProfit:    request.TakeProfit,
 	}
-
-	return result, nil
 }
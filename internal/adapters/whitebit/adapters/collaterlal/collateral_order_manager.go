@@ -0,0 +1,110 @@
+package whitebit_collateral_adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ChewX3D/crypto/internal/adapters/whitebit"
+	whitebit_adapters_common "github.com/ChewX3D/crypto/internal/adapters/whitebit/adapters"
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// collateralOrderTypes limits cancel-all to collateral orders so spot orders stay untouched.
+var collateralOrderTypes = []whitebit.OrderMarketType{whitebit.OrderMarketTypeMargin, whitebit.OrderMarketTypeFutures}
+
+// CollateralOrderManagerAdapter adapts app order management port to WhiteBIT transport client.
+type CollateralOrderManagerAdapter struct {
+	client whitebit.PrivateClient
+}
+
+var _ ports.CollateralOrderManager = (*CollateralOrderManagerAdapter)(nil)
+
+// NewCollateralOrderManagerAdapter constructs order manager adapter.
+func NewCollateralOrderManagerAdapter(client whitebit.PrivateClient) *CollateralOrderManagerAdapter {
+	return &CollateralOrderManagerAdapter{client: client}
+}
+
+// NewDefaultCollateralOrderManagerAdapter constructs order manager adapter with default client.
+func NewDefaultCollateralOrderManagerAdapter() *CollateralOrderManagerAdapter {
+	return NewCollateralOrderManagerAdapter(whitebit.NewDefaultClient())
+}
+
+// CancelOrder cancels one order by exchange order id or client order id.
+func (adapter *CollateralOrderManagerAdapter) CancelOrder(
+	ctx context.Context,
+	credential domainauth.Credential,
+	request ports.CollateralCancelOrderRequest,
+) error {
+	_, err := adapter.client.CancelOrder(ctx, credential, whitebit.CancelOrderRequest{
+		Market:        request.Market,
+		OrderID:       request.OrderID,
+		ClientOrderID: request.ClientOrderID,
+	})
+	if err != nil {
+		return whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathOrderCancel, "order cancellation")
+	}
+
+	return nil
+}
+
+// CancelAllOrders cancels all margin and futures orders for market.
+func (adapter *CollateralOrderManagerAdapter) CancelAllOrders(
+	ctx context.Context,
+	credential domainauth.Credential,
+	market string,
+) error {
+	err := adapter.client.CancelAllOrders(ctx, credential, whitebit.CancelAllOrdersRequest{
+		Market: market,
+		Type:   collateralOrderTypes,
+	})
+	if err != nil {
+		return whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathOrderCancelAll, "cancel all orders")
+	}
+
+	return nil
+}
+
+// ListActiveOrders returns all active orders for market, following offset pagination until a short page.
+func (adapter *CollateralOrderManagerAdapter) ListActiveOrders(
+	ctx context.Context,
+	credential domainauth.Credential,
+	market string,
+) ([]ports.CollateralOrder, error) {
+	orders := make([]ports.CollateralOrder, 0)
+	for offset := 0; ; offset += whitebit.MaxActiveOrdersLimit {
+		page, err := adapter.client.GetActiveOrders(ctx, credential, whitebit.ActiveOrdersRequest{
+			Market: market,
+			Limit:  whitebit.MaxActiveOrdersLimit,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathActiveOrders, "active orders query")
+		}
+
+		for _, order := range page {
+			orders = append(orders, toCollateralOrder(order))
+		}
+		if len(page) < whitebit.MaxActiveOrdersLimit {
+			return orders, nil
+		}
+	}
+}
+
+func toCollateralOrder(order whitebit.CollateralOrderResponse) ports.CollateralOrder {
+	return ports.CollateralOrder{
+		OrderID:       order.OrderID,
+		ClientOrderID: order.ClientOrderID,
+		Market:        order.Market,
+		Side:          strings.ToLower(string(order.Side)),
+		PositionSide:  string(order.PositionSide),
+		Type:          order.Type,
+		Price:         order.Price,
+		Amount:        order.Amount,
+		Left:          order.Left,
+		DealStock:     order.DealStock,
+		DealMoney:     order.DealMoney,
+		PostOnly:      order.PostOnly,
+		CreatedAt:     whitebit_adapters_common.UnixSecondsToTime(order.Timestamp),
+	}
+}
@@ -0,0 +1,80 @@
+package whitebit_collateral_adapters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/adapters/whitebit"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+type fixedNonceSource struct {
+	value int64
+}
+
+func (source fixedNonceSource) Next() int64 {
+	return source.value
+}
+
+func TestCollateralOrderManagerAdapterListActiveOrdersPaginates(t *testing.T) {
+	var offsets []int
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		body, _ := io.ReadAll(request.Body)
+		var payload struct {
+			Market string `json:"market"`
+			Limit  int    `json:"limit"`
+			Offset int    `json:"offset"`
+		}
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		if payload.Market != "BTC_PERP" || payload.Limit != whitebit.MaxActiveOrdersLimit {
+			t.Fatalf("unexpected payload: %s", body)
+		}
+		offsets = append(offsets, payload.Offset)
+
+		count := whitebit.MaxActiveOrdersLimit
+		if payload.Offset > 0 {
+			count = 2
+		}
+		items := make([]string, 0, count)
+		for index := range count {
+			items = append(items, fmt.Sprintf(
+				`{"orderId":%d,"clientOrderId":"run-%d","market":"BTC_PERP","side":"buy","type":"margin_limit","timestamp":1700000000.25,"amount":"0.01","left":"0.01","price":"49000","postOnly":true}`,
+				payload.Offset+index+1, payload.Offset+index,
+			))
+		}
+		writer.WriteHeader(http.StatusOK)
+		_, _ = writer.Write([]byte("[" + strings.Join(items, ",") + "]"))
+	}))
+	defer server.Close()
+
+	adapter := NewCollateralOrderManagerAdapter(whitebit.NewClient(server.URL, server.Client(), fixedNonceSource{value: 1}))
+	orders, err := adapter.ListActiveOrders(context.Background(), domainauth.Credential{
+		APIKey:    "public-key",
+		APISecret: []byte("secret-key"),
+	}, "BTC_PERP")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(offsets) != 2 || offsets[1] != whitebit.MaxActiveOrdersLimit {
+		t.Fatalf("expected two pages, got offsets %v", offsets)
+	}
+	if len(orders) != whitebit.MaxActiveOrdersLimit+2 {
+		t.Fatalf("expected %d orders, got %d", whitebit.MaxActiveOrdersLimit+2, len(orders))
+	}
+	last := orders[len(orders)-1]
+	if last.OrderID != 102 || last.ClientOrderID != "run-101" || !last.PostOnly {
+		t.Fatalf("unexpected last order: %+v", last)
+	}
+	if want := time.Unix(1700000000, 250_000_000).UTC(); !last.CreatedAt.Equal(want) {
+		t.Fatalf("expected created at %s, got %s", want, last.CreatedAt)
+	}
+}
@@ -40,7 +40,7 @@ func TestCredentialVerifierAdapterVerifyMapsErrors(t *testing.T) {
 		{
 			name:         "unavailable",
 			statusCode:   http.StatusServiceUnavailable,
-			expectedCode: ports.CodeUnavailable,
+			expectedCode: ports.CodeTransport,
 		},
 	}
 
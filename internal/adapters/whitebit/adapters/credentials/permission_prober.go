@@ -0,0 +1,173 @@
+package whitebit_credentials_adapters
+
+import (
+	"context"
+
+	"github.com/ChewX3D/crypto/internal/adapters/whitebit"
+	whitebit_adapters_common "github.com/ChewX3D/crypto/internal/adapters/whitebit/adapters"
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+const (
+	probeClientOrderID = "wbcli-permission-probe"
+
+	// probeAmount is rejected by the exchange, so order probes never place an order.
+	probeAmount = "0"
+	probePrice  = "0"
+)
+
+// permissionProbe calls one endpoint with a request that cannot change account state.
+type permissionProbe struct {
+	endpoint string
+	call     func(ctx context.Context, client whitebit.PrivateClient, credential domainauth.Credential, market string) error
+}
+
+// permissionProbes covers every private endpoint wbcli signs requests for, except cancel-all and account
+// settings updates, which have no request that is both permission-checked and guaranteed harmless.
+var permissionProbes = []permissionProbe{
+	{endpoint: whitebit.URLPathCollateralAccountHedgeMode, call: func(ctx context.Context, client whitebit.PrivateClient, credential domainauth.Credential, market string) error {
+		_, err := client.GetCollateralAccountHedgeMode(ctx, credential)
+		return err
+	}},
+	{endpoint: whitebit.URLPathCollateralBalance, call: func(ctx context.Context, client whitebit.PrivateClient, credential domainauth.Credential, market string) error {
+		_, err := client.GetCollateralBalance(ctx, credential)
+		return err
+	}},
+	{endpoint: whitebit.URLPathCollateralSummary, call: func(ctx context.Context, client whitebit.PrivateClient, credential domainauth.Credential, market string) error {
+		_, err := client.GetCollateralSummary(ctx, credential)
+		return err
+	}},
+	{endpoint: whitebit.URLPathCollateralOpenPositions, call: func(ctx context.Context, client whitebit.PrivateClient, credential domainauth.Credential, market string) error {
+		_, err := client.GetOpenPositions(ctx, credential, whitebit.OpenPositionsRequest{Market: market})
+		return err
+	}},
+	{endpoint: whitebit.URLPathActiveOrders, call: func(ctx context.Context, client whitebit.PrivateClient, credential domainauth.Credential, market string) error {
+		_, err := client.GetActiveOrders(ctx, credential, whitebit.ActiveOrdersRequest{Market: market, Limit: 1})
+		return err
+	}},
+	{endpoint: whitebit.URLPathOrderHistory, call: func(ctx context.Context, client whitebit.PrivateClient, credential domainauth.Credential, market string) error {
+		_, err := client.GetOrderHistory(ctx, credential, whitebit.OrderHistoryRequest{Market: market, Limit: 1})
+		return err
+	}},
+	{endpoint: whitebit.URLPathExecutedHistory, call: func(ctx context.Context, client whitebit.PrivateClient, credential domainauth.Credential, market string) error {
+		_, err := client.GetExecutedHistory(ctx, credential, whitebit.ExecutedHistoryRequest{Market: market, Limit: 1})
+		return err
+	}},
+	{endpoint: whitebit.URLPathCollateralLimitOrder, call: func(ctx context.Context, client whitebit.PrivateClient, credential domainauth.Credential, market string) error {
+		_, err := client.PlaceCollateralLimitOrder(ctx, credential, probeLimitOrder(market))
+		return err
+	}},
+	{endpoint: whitebit.URLPathCollateralLimitOrderBulk, call: func(ctx context.Context, client whitebit.PrivateClient, credential domainauth.Credential, market string) error {
+		stopOnFail := true
+		_, err := client.PlaceCollateralBulkLimitOrder(ctx, credential, whitebit.CollateralBulkLimitOrderRequest{
+			Orders:     []whitebit.CollateralLimitOrderRequest{probeLimitOrder(market)},
+			StopOnFail: &stopOnFail,
+		})
+		return err
+	}},
+	{endpoint: whitebit.URLPathCollateralMarketOrder, call: func(ctx context.Context, client whitebit.PrivateClient, credential domainauth.Credential, market string) error {
+		_, err := client.PlaceCollateralMarketOrder(ctx, credential, whitebit.CollateralMarketOrderRequest{
+			Market: market,
+			Side:   whitebit.OrderSideBuy,
+			Amount: probeAmount,
+		})
+		return err
+	}},
+	{endpoint: whitebit.URLPathCollateralStopMarketOrder, call: func(ctx context.Context, client whitebit.PrivateClient, credential domainauth.Credential, market string) error {
+		_, err := client.PlaceCollateralStopMarketOrder(ctx, credential, whitebit.CollateralStopMarketOrderRequest{
+			Market:          market,
+			Side:            whitebit.OrderSideBuy,
+			Amount:          probeAmount,
+			ActivationPrice: probePrice,
+		})
+		return err
+	}},
+	{endpoint: whitebit.URLPathCollateralStopLimitOrder, call: func(ctx context.Context, client whitebit.PrivateClient, credential domainauth.Credential, market string) error {
+		_, err := client.PlaceCollateralStopLimitOrder(ctx, credential, whitebit.CollateralStopLimitOrderRequest{
+			Market:          market,
+			Side:            whitebit.OrderSideBuy,
+			Amount:          probeAmount,
+			Price:           probePrice,
+			ActivationPrice: probePrice,
+		})
+		return err
+	}},
+	{endpoint: whitebit.URLPathOrderCancel, call: func(ctx context.Context, client whitebit.PrivateClient, credential domainauth.Credential, market string) error {
+		_, err := client.CancelOrder(ctx, credential, whitebit.CancelOrderRequest{Market: market, ClientOrderID: probeClientOrderID})
+		return err
+	}},
+	{endpoint: whitebit.URLPathWebSocketToken, call: func(ctx context.Context, client whitebit.PrivateClient, credential domainauth.Credential, market string) error {
+		_, err := client.GetWebSocketToken(ctx, credential)
+		return err
+	}},
+}
+
+func probeLimitOrder(market string) whitebit.CollateralLimitOrderRequest {
+	postOnly := true
+	return whitebit.CollateralLimitOrderRequest{
+		Market:        market,
+		Side:          whitebit.OrderSideBuy,
+		Amount:        probeAmount,
+		Price:         probePrice,
+		ClientOrderID: probeClientOrderID,
+		PostOnly:      &postOnly,
+	}
+}
+
+var _ ports.EndpointPermissionProber = (*CredentialVerifierAdapter)(nil)
+
+// ProbePermissions calls every probed endpoint once, using market for market-scoped requests. Validation and
+// business-rule rejections are reported as rejected, since they do not prove the permission check ran.
+// Invalid credentials stop the probe with an error.
+func (adapter *CredentialVerifierAdapter) ProbePermissions(
+	ctx context.Context,
+	credential domainauth.Credential,
+	market string,
+) ([]ports.EndpointPermission, error) {
+	if adapter == nil || adapter.client == nil {
+		return nil, &ports.APIError{
+			Code:    ports.CodeUnavailable,
+			Message: "permission check failed: exchange unavailable",
+			Details: "credential verifier adapter is not configured",
+		}
+	}
+
+	permissions := make([]ports.EndpointPermission, 0, len(permissionProbes))
+	for _, probe := range permissionProbes {
+		err := probe.call(ctx, adapter.client, credential, market)
+		if err == nil {
+			permissions = append(permissions, ports.EndpointPermission{Endpoint: probe.endpoint, Access: ports.EndpointAccessAllowed})
+			continue
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+
+		apiErr := whitebit_adapters_common.BuildAPIError(err, probe.endpoint, "permission check")
+		switch apiErr.Code {
+		case ports.CodeInvalidRequest, ports.CodeBusinessRule:
+			permissions = append(permissions, ports.EndpointPermission{
+				Endpoint: probe.endpoint,
+				Access:   ports.EndpointAccessRejected,
+				Reason:   apiErr.Details,
+			})
+		case ports.CodeForbidden:
+			permissions = append(permissions, ports.EndpointPermission{
+				Endpoint: probe.endpoint,
+				Access:   ports.EndpointAccessForbidden,
+				Reason:   apiErr.Details,
+			})
+		case ports.CodeUnauthorized:
+			return nil, apiErr
+		default:
+			permissions = append(permissions, ports.EndpointPermission{
+				Endpoint: probe.endpoint,
+				Access:   ports.EndpointAccessUnknown,
+				Reason:   apiErr.Details,
+			})
+		}
+	}
+
+	return permissions, nil
+}
@@ -0,0 +1,104 @@
+package whitebit_credentials_adapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ChewX3D/crypto/internal/adapters/whitebit"
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+func TestCredentialVerifierAdapterProbePermissionsClassifiesEndpoints(t *testing.T) {
+	requested := map[string]bool{}
+	orderMarket := ""
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requested[request.URL.Path] = true
+		switch request.URL.Path {
+		case whitebit.URLPathCollateralBalance:
+			_, _ = writer.Write([]byte(`{"USDT":"100"}`))
+		case whitebit.URLPathCollateralLimitOrder:
+			var body struct {
+				Market string `json:"market"`
+			}
+			_ = json.NewDecoder(request.Body).Decode(&body)
+			orderMarket = body.Market
+			writer.WriteHeader(http.StatusUnauthorized)
+			_, _ = writer.Write([]byte(`{"message":"This API Key is not authorized to perform this action."}`))
+		case whitebit.URLPathWebSocketToken:
+			writer.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			writer.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = writer.Write([]byte(`{"code":30,"message":"Validation failed","errors":{"amount":["Amount must be greater than 0."]}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := whitebit.NewClient(server.URL, server.Client(), fixedNonceSource{value: 1})
+	permissions, err := NewCredentialVerifierAdapter(client).ProbePermissions(context.Background(), domainauth.Credential{
+		APIKey:    "public-key",
+		APISecret: []byte("secret-key"),
+	}, "ETH_PERP")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if orderMarket != "ETH_PERP" {
+		t.Fatalf("expected order probe on the requested market, got %q", orderMarket)
+	}
+	if len(permissions) != len(permissionProbes) || len(requested) != len(permissionProbes) {
+		t.Fatalf("expected every probe to reach the exchange, got %d results for %d paths", len(permissions), len(requested))
+	}
+
+	for _, permission := range permissions {
+		switch permission.Endpoint {
+		case whitebit.URLPathCollateralLimitOrder:
+			if permission.Access != ports.EndpointAccessForbidden {
+				t.Fatalf("expected forbidden limit order endpoint, got %+v", permission)
+			}
+			if !strings.Contains(permission.Reason, "enable access to endpoint "+whitebit.URLPathCollateralLimitOrder) {
+				t.Fatalf("expected endpoint access hint, got %q", permission.Reason)
+			}
+		case whitebit.URLPathWebSocketToken:
+			if permission.Access != ports.EndpointAccessUnknown || permission.Reason == "" {
+				t.Fatalf("expected unknown websocket token endpoint, got %+v", permission)
+			}
+		case whitebit.URLPathCollateralBalance:
+			if permission.Access != ports.EndpointAccessAllowed || permission.Reason != "" {
+				t.Fatalf("expected allowed balance endpoint, got %+v", permission)
+			}
+		default:
+			if permission.Access != ports.EndpointAccessRejected || !strings.Contains(permission.Reason, "Amount must be greater than 0") {
+				t.Fatalf("expected rejected endpoint, got %+v", permission)
+			}
+		}
+	}
+}
+
+func TestCredentialVerifierAdapterProbePermissionsStopsOnInvalidCredentials(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		calls++
+		writer.WriteHeader(http.StatusUnauthorized)
+		_, _ = writer.Write([]byte(`{"message":"invalid signature"}`))
+	}))
+	defer server.Close()
+
+	client := whitebit.NewClient(server.URL, server.Client(), fixedNonceSource{value: 1})
+	_, err := NewCredentialVerifierAdapter(client).ProbePermissions(context.Background(), domainauth.Credential{
+		APIKey:    "public-key",
+		APISecret: []byte("secret-key"),
+	}, "BTC_PERP")
+
+	var apiErr *ports.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != ports.CodeUnauthorized {
+		t.Fatalf("expected unauthorized APIError, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected probing to stop after the first call, got %d calls", calls)
+	}
+}
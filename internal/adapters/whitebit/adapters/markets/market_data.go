@@ -0,0 +1,145 @@
+package whitebit_markets_adapters
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/adapters/whitebit"
+	whitebit_adapters_common "github.com/ChewX3D/crypto/internal/adapters/whitebit/adapters"
+	"github.com/ChewX3D/crypto/internal/app/ports"
+)
+
+// MarketDataAdapter adapts app market data port to WhiteBIT public endpoints.
+type MarketDataAdapter struct {
+	client whitebit.PublicClient
+}
+
+var (
+	_ ports.MarketDataReader = (*MarketDataAdapter)(nil)
+	_ ports.KlineReader      = (*MarketDataAdapter)(nil)
+)
+
+// NewMarketDataAdapter constructs market data adapter.
+func NewMarketDataAdapter(client whitebit.PublicClient) *MarketDataAdapter {
+	return &MarketDataAdapter{client: client}
+}
+
+// NewDefaultMarketDataAdapter constructs market data adapter with default client.
+func NewDefaultMarketDataAdapter() *MarketDataAdapter {
+	return NewMarketDataAdapter(whitebit.NewDefaultClient())
+}
+
+// Tickers fetches 24h tickers for all markets.
+func (adapter *MarketDataAdapter) Tickers(ctx context.Context) ([]ports.Ticker, error) {
+	response, err := adapter.client.GetTickers(ctx)
+	if err != nil {
+		return nil, whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathPublicTicker, "ticker query")
+	}
+
+	tickers := make([]ports.Ticker, 0, len(response))
+	for market, ticker := range response {
+		tickers = append(tickers, ports.Ticker{
+			Market:      market,
+			LastPrice:   ticker.LastPrice,
+			Change:      ticker.Change,
+			BaseVolume:  ticker.BaseVolume,
+			QuoteVolume: ticker.QuoteVolume,
+			Frozen:      ticker.IsFrozen,
+		})
+	}
+
+	return tickers, nil
+}
+
+// OrderBook fetches order book snapshot with up to limit levels per side.
+func (adapter *MarketDataAdapter) OrderBook(ctx context.Context, market string, limit int) (ports.OrderBook, error) {
+	response, err := adapter.client.GetOrderBook(ctx, whitebit.OrderBookRequest{Market: market, Limit: limit})
+	if err != nil {
+		return ports.OrderBook{}, whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathPublicOrderBook+market, "order book query")
+	}
+
+	book := ports.OrderBook{
+		Market:    market,
+		Timestamp: whitebit_adapters_common.UnixSecondsToTime(response.Timestamp),
+		Asks:      toOrderBookLevels(response.Asks),
+		Bids:      toOrderBookLevels(response.Bids),
+	}
+	if response.TickerID != "" {
+		book.Market = response.TickerID
+	}
+
+	return book, nil
+}
+
+// RecentTrades fetches latest public trades, optionally filtered by taker side.
+func (adapter *MarketDataAdapter) RecentTrades(ctx context.Context, market string, side string) ([]ports.PublicTrade, error) {
+	response, err := adapter.client.GetRecentTrades(ctx, whitebit.RecentTradesRequest{
+		Market: market,
+		Side:   whitebit.OrderSide(strings.ToLower(side)),
+	})
+	if err != nil {
+		return nil, whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathPublicTrades+market, "recent trades query")
+	}
+
+	trades := make([]ports.PublicTrade, 0, len(response))
+	for _, trade := range response {
+		trades = append(trades, ports.PublicTrade{
+			TradeID:    trade.TradeID,
+			Side:       string(trade.Type),
+			Price:      trade.Price,
+			Amount:     trade.BaseVolume,
+			Total:      trade.QuoteVolume,
+			ExecutedAt: whitebit_adapters_common.UnixSecondsToTime(trade.TradeTimestamp),
+		})
+	}
+
+	return trades, nil
+}
+
+func toOrderBookLevels(levels [][2]string) []ports.OrderBookLevel {
+	converted := make([]ports.OrderBookLevel, 0, len(levels))
+	for _, level := range levels {
+		converted = append(converted, ports.OrderBookLevel{Price: level[0], Amount: level[1]})
+	}
+
+	return converted
+}
+
+// Klines fetches up to limit candles with open time in [start, end].
+func (adapter *MarketDataAdapter) Klines(
+	ctx context.Context,
+	market string,
+	interval string,
+	start time.Time,
+	end time.Time,
+	limit int,
+) ([]ports.Kline, error) {
+	request := whitebit.KlineRequest{Market: market, Interval: interval, Limit: limit}
+	if !start.IsZero() {
+		request.Start = start.Unix()
+	}
+	if !end.IsZero() {
+		request.End = end.Unix()
+	}
+
+	response, err := adapter.client.GetKlines(ctx, request)
+	if err != nil {
+		return nil, whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathPublicKline, "kline query")
+	}
+
+	klines := make([]ports.Kline, 0, len(response))
+	for _, kline := range response {
+		klines = append(klines, ports.Kline{
+			OpenTime:    time.Unix(kline.Time, 0).UTC(),
+			Open:        kline.Open,
+			High:        kline.High,
+			Low:         kline.Low,
+			Close:       kline.Close,
+			Volume:      kline.Volume,
+			QuoteVolume: kline.QuoteVolume,
+		})
+	}
+
+	return klines, nil
+}
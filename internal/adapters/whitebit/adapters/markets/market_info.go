@@ -0,0 +1,93 @@
+package whitebit_markets_adapters
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ChewX3D/crypto/internal/adapters/whitebit"
+	whitebit_adapters_common "github.com/ChewX3D/crypto/internal/adapters/whitebit/adapters"
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
+)
+
+// MarketInfoAdapter adapts app market info port to WhiteBIT public markets endpoint.
+type MarketInfoAdapter struct {
+	client whitebit.PublicClient
+}
+
+var _ ports.MarketInfoProvider = (*MarketInfoAdapter)(nil)
+
+// NewMarketInfoAdapter constructs market info adapter.
+func NewMarketInfoAdapter(client whitebit.PublicClient) *MarketInfoAdapter {
+	return &MarketInfoAdapter{client: client}
+}
+
+// NewDefaultMarketInfoAdapter constructs market info adapter with default client.
+func NewDefaultMarketInfoAdapter() *MarketInfoAdapter {
+	return NewMarketInfoAdapter(whitebit.NewDefaultClient())
+}
+
+// ListMarkets fetches trading rules for all WhiteBIT markets.
+// WhiteBIT does not publish a separate tick size, so tick is derived from money precision.
+func (adapter *MarketInfoAdapter) ListMarkets(ctx context.Context) ([]ports.MarketInfo, error) {
+	response, err := adapter.client.GetMarkets(ctx)
+	if err != nil {
+		return nil, whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathPublicMarkets, "market info query")
+	}
+
+	markets := make([]ports.MarketInfo, 0, len(response))
+	for _, market := range response {
+		info, err := toMarketInfo(market)
+		if err != nil {
+			return nil, fmt.Errorf("decode market %s: %w", market.Name, err)
+		}
+		markets = append(markets, info)
+	}
+
+	return markets, nil
+}
+
+func toMarketInfo(market whitebit.MarketResponse) (ports.MarketInfo, error) {
+	stockPrecision, err := strconv.Atoi(strings.TrimSpace(market.StockPrec))
+	if err != nil {
+		return ports.MarketInfo{}, fmt.Errorf("parse stockPrec: %w", err)
+	}
+	moneyPrecision, err := strconv.Atoi(strings.TrimSpace(market.MoneyPrec))
+	if err != nil {
+		return ports.MarketInfo{}, fmt.Errorf("parse moneyPrec: %w", err)
+	}
+	minAmount, err := parseOptionalDecimal(market.MinAmount)
+	if err != nil {
+		return ports.MarketInfo{}, fmt.Errorf("parse minAmount: %w", err)
+	}
+	minTotal, err := parseOptionalDecimal(market.MinTotal)
+	if err != nil {
+		return ports.MarketInfo{}, fmt.Errorf("parse minTotal: %w", err)
+	}
+	maxTotal, err := parseOptionalDecimal(market.MaxTotal)
+	if err != nil {
+		return ports.MarketInfo{}, fmt.Errorf("parse maxTotal: %w", err)
+	}
+
+	return ports.MarketInfo{
+		Name:           market.Name,
+		StockPrecision: stockPrecision,
+		MoneyPrecision: moneyPrecision,
+		TickSize:       decimal.New(1, moneyPrecision),
+		MinAmount:      minAmount,
+		MinTotal:       minTotal,
+		MaxTotal:       maxTotal,
+		TradesEnabled:  market.TradesEnabled,
+		IsCollateral:   market.IsCollateral,
+	}, nil
+}
+
+func parseOptionalDecimal(value string) (decimal.Decimal, error) {
+	if strings.TrimSpace(value) == "" {
+		return decimal.Decimal{}, nil
+	}
+
+	return decimal.Parse(value)
+}
@@ -0,0 +1,73 @@
+package whitebit_markets_adapters
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ChewX3D/crypto/internal/adapters/whitebit"
+	"github.com/ChewX3D/crypto/internal/app/ports"
+)
+
+func TestMarketInfoAdapterListMarketsMapsRules(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet || request.URL.Path != whitebit.URLPathPublicMarkets {
+			t.Fatalf("unexpected request %s %s", request.Method, request.URL.Path)
+		}
+		writer.WriteHeader(http.StatusOK)
+		_, _ = writer.Write([]byte(`[{
+			"name": "BTC_PERP",
+			"stock": "BTC",
+			"money": "USDT",
+			"stockPrec": "4",
+			"moneyPrec": "1",
+			"feePrec": "4",
+			"makerFee": "0.01",
+			"takerFee": "0.055",
+			"minAmount": "0.0001",
+			"minTotal": "5",
+			"maxTotal": "10000000000",
+			"tradesEnabled": true,
+			"isCollateral": true,
+			"type": "futures"
+		}]`))
+	}))
+	defer server.Close()
+
+	adapter := NewMarketInfoAdapter(whitebit.NewClient(server.URL, server.Client(), nil))
+	markets, err := adapter.ListMarkets(context.Background())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(markets) != 1 {
+		t.Fatalf("expected one market, got %d", len(markets))
+	}
+
+	market := markets[0]
+	if market.Name != "BTC_PERP" || market.StockPrecision != 4 || market.MoneyPrecision != 1 {
+		t.Fatalf("unexpected market precision: %+v", market)
+	}
+	if market.TickSize.String() != "0.1" || market.MinAmount.String() != "0.0001" || market.MinTotal.String() != "5" {
+		t.Fatalf("unexpected market limits: tick=%s min_amount=%s min_total=%s", market.TickSize, market.MinAmount, market.MinTotal)
+	}
+	if !market.TradesEnabled || !market.IsCollateral {
+		t.Fatalf("expected collateral market with trading enabled")
+	}
+}
+
+func TestMarketInfoAdapterListMarketsMapsTransportError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	adapter := NewMarketInfoAdapter(whitebit.NewClient(server.URL, server.Client(), nil))
+	_, err := adapter.ListMarkets(context.Background())
+
+	var apiErr *ports.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != ports.CodeTransport {
+		t.Fatalf("expected transport api error, got %v", err)
+	}
+}
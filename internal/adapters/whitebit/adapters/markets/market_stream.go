@@ -0,0 +1,179 @@
+package whitebit_markets_adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ChewX3D/crypto/internal/adapters/whitebit"
+	whitebit_adapters_common "github.com/ChewX3D/crypto/internal/adapters/whitebit/adapters"
+	"github.com/ChewX3D/crypto/internal/adapters/whitebit/ws"
+	"github.com/ChewX3D/crypto/internal/app/ports"
+)
+
+// MarketStreamAdapter adapts app market stream port to the WhiteBIT websocket API.
+type MarketStreamAdapter struct {
+	client whitebit.PrivateClient
+	config ws.Config
+}
+
+var _ ports.MarketStreamer = (*MarketStreamAdapter)(nil)
+
+// NewMarketStreamAdapter constructs market stream adapter.
+// client issues websocket tokens for private channels; config.TokenProvider is replaced per stream.
+func NewMarketStreamAdapter(client whitebit.PrivateClient, config ws.Config) *MarketStreamAdapter {
+	return &MarketStreamAdapter{client: client, config: config}
+}
+
+// NewDefaultMarketStreamAdapter constructs market stream adapter with default clients.
+func NewDefaultMarketStreamAdapter() *MarketStreamAdapter {
+	return NewMarketStreamAdapter(whitebit.NewDefaultClient(), ws.Config{
+		Limiter: whitebit.DefaultRateLimiter().Group(whitebit.RateGroupWebSocket),
+	})
+}
+
+// StreamMarket subscribes last price, depth and, with a credential, own pending orders of one market.
+// It returns ctx.Err() on cancellation and stops early when the exchange rejects the credential.
+func (adapter *MarketStreamAdapter) StreamMarket(
+	ctx context.Context,
+	request ports.MarketStreamRequest,
+	handle func(ports.MarketStreamEvent) error,
+) error {
+	config := adapter.config
+	config.TokenProvider = nil
+	if request.Credential != nil {
+		credential := *request.Credential
+		config.TokenProvider = ws.TokenProviderFunc(func(ctx context.Context) (string, error) {
+			response, err := adapter.client.GetWebSocketToken(ctx, credential)
+			if err != nil {
+				return "", whitebit_adapters_common.BuildAPIError(err, whitebit.URLPathWebSocketToken, "websocket token request")
+			}
+
+			return response.WebSocketToken, nil
+		})
+	}
+
+	client := ws.NewClient(config)
+	if err := client.SubscribeLastPrice(request.Market); err != nil {
+		return err
+	}
+	if err := client.SubscribeDepth(request.Market, request.DepthLimit); err != nil {
+		return err
+	}
+	if request.Credential != nil {
+		if err := client.SubscribeOrdersPending(request.Market); err != nil {
+			return err
+		}
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- client.Run(streamCtx)
+	}()
+
+	var stopErr error
+	for event := range client.Events() {
+		if stopErr != nil {
+			continue
+		}
+		if err := permanentStreamError(event); err != nil {
+			stopErr = err
+			cancel()
+			continue
+		}
+		converted, ok := toMarketStreamEvent(event)
+		if !ok {
+			continue
+		}
+		if err := handle(converted); err != nil {
+			stopErr = err
+			cancel()
+		}
+	}
+
+	err := <-runErr
+	if stopErr != nil {
+		return stopErr
+	}
+
+	return err
+}
+
+// permanentStreamError returns auth failures from the token request; retrying them cannot succeed.
+func permanentStreamError(event ws.Event) error {
+	state, ok := event.(ws.StateEvent)
+	if !ok || state.Err == nil {
+		return nil
+	}
+
+	var apiErr *ports.APIError
+	if errors.As(state.Err, &apiErr) && (apiErr.Code == ports.CodeUnauthorized || apiErr.Code == ports.CodeForbidden) {
+		return apiErr
+	}
+
+	return nil
+}
+
+func toMarketStreamEvent(event ws.Event) (ports.MarketStreamEvent, bool) {
+	switch typed := event.(type) {
+	case ws.StateEvent:
+		converted := ports.MarketStreamEvent{
+			Kind:      ports.MarketStreamState,
+			Connected: typed.Connected,
+			RetryIn:   typed.RetryIn,
+		}
+		if typed.Err != nil {
+			converted.Message = typed.Err.Error()
+		}
+
+		return converted, true
+	case ws.ErrorEvent:
+		message := typed.Message
+		if typed.Method != "" {
+			message = typed.Method + ": " + message
+		}
+
+		return ports.MarketStreamEvent{Kind: ports.MarketStreamError, Message: message}, true
+	case ws.LastPriceEvent:
+		return ports.MarketStreamEvent{Kind: ports.MarketStreamLastPrice, Market: typed.Market, Price: typed.Price}, true
+	case ws.DepthEvent:
+		return ports.MarketStreamEvent{
+			Kind:       ports.MarketStreamDepth,
+			Market:     typed.Market,
+			FullReload: typed.FullReload,
+			Asks:       fromStreamLevels(typed.Asks),
+			Bids:       fromStreamLevels(typed.Bids),
+		}, true
+	case ws.OrderEvent:
+		order := typed.Order
+		return ports.MarketStreamEvent{
+			Kind:   ports.MarketStreamOrder,
+			Market: order.Market,
+			Order: ports.OwnOrderUpdate{
+				OrderID:       order.ID,
+				ClientOrderID: order.ClientOrderID,
+				Market:        order.Market,
+				Side:          order.Side,
+				Price:         order.Price,
+				Amount:        order.Amount,
+				Left:          order.Left,
+				DealStock:     order.DealStock,
+				DealMoney:     order.DealMoney,
+				DealFee:       order.DealFee,
+				Finished:      typed.Kind == ws.OrderEventFinish || typed.Kind == ws.OrderEventExecuted,
+			},
+		}, true
+	}
+
+	return ports.MarketStreamEvent{}, false
+}
+
+func fromStreamLevels(levels []ws.Level) []ports.OrderBookLevel {
+	converted := make([]ports.OrderBookLevel, 0, len(levels))
+	for _, level := range levels {
+		converted = append(converted, ports.OrderBookLevel{Price: level.Price, Amount: level.Amount})
+	}
+
+	return converted
+}
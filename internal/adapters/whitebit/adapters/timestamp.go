@@ -0,0 +1,16 @@
+package whitebit_adapters_common
+
+import (
+	"math"
+	"time"
+)
+
+// UnixSecondsToTime converts WhiteBIT fractional unix seconds timestamps to UTC time.
+func UnixSecondsToTime(timestamp float64) time.Time {
+	if timestamp <= 0 {
+		return time.Time{}
+	}
+
+	seconds, fraction := math.Modf(timestamp)
+	return time.Unix(int64(seconds), int64(math.Round(fraction*1e6))*int64(time.Microsecond)).UTC()
+}
@@ -22,9 +22,11 @@ import (
 )
 
 const (
-	defaultBaseURL      = "https://whitebit.com"
-	defaultHTTPTimeout  = 10 * time.Second
-	maxResponseBodySize = 64 * 1024
+	defaultBaseURL     = "https://whitebit.com"
+	defaultHTTPTimeout = 10 * time.Second
+
+	// maxResponseBodySize bounds private and public responses; full history and order book pages stay well below it.
+	maxResponseBodySize = 4 * 1024 * 1024
 )
 
 var (
@@ -40,6 +42,8 @@ var (
 	ErrAPIBusinessRule = errors.New("whitebit api business rule error")
 	// ErrAPITransport indicates temporary transport/server/rate-limit failure.
 	ErrAPITransport = errors.New("whitebit api transport error")
+	// ErrResponseTooLarge indicates a response body above maxResponseBodySize.
+	ErrResponseTooLarge = errors.New("whitebit response too large")
 )
 
 // HTTPDoer executes HTTP requests. It enables client testability.
@@ -77,7 +81,21 @@ func (source *MonotonicUnixMilliNonceSource) Next() int64 {
 type PrivateClient interface {
 	GetCollateralAccountHedgeMode(ctx context.Context, credential domainauth.Credential) (CollateralAccountHedgeModeResponse, error)
 	PlaceCollateralLimitOrder(ctx context.Context, credential domainauth.Credential, request CollateralLimitOrderRequest) (json.RawMessage, error)
-	PlaceCollateralBulkLimitOrder(ctx context.Context, credential domainauth.Credential, request CollateralBulkLimitOrderRequest) (json.RawMessage, error)
+	PlaceCollateralBulkLimitOrder(ctx context.Context, credential domainauth.Credential, request CollateralBulkLimitOrderRequest) ([]CollateralBulkLimitOrderResult, error)
+	PlaceCollateralMarketOrder(ctx context.Context, credential domainauth.Credential, request CollateralMarketOrderRequest) (json.RawMessage, error)
+	PlaceCollateralStopMarketOrder(ctx context.Context, credential domainauth.Credential, request CollateralStopMarketOrderRequest) (json.RawMessage, error)
+	PlaceCollateralStopLimitOrder(ctx context.Context, credential domainauth.Credential, request CollateralStopLimitOrderRequest) (json.RawMessage, error)
+	CancelOrder(ctx context.Context, credential domainauth.Credential, request CancelOrderRequest) (CollateralOrderResponse, error)
+	CancelAllOrders(ctx context.Context, credential domainauth.Credential, request CancelAllOrdersRequest) error
+	GetActiveOrders(ctx context.Context, credential domainauth.Credential, request ActiveOrdersRequest) ([]CollateralOrderResponse, error)
+	GetOrderHistory(ctx context.Context, credential domainauth.Credential, request OrderHistoryRequest) ([]OrderHistoryResponse, error)
+	GetExecutedHistory(ctx context.Context, credential domainauth.Credential, request ExecutedHistoryRequest) ([]TradeResponse, error)
+	GetCollateralBalance(ctx context.Context, credential domainauth.Credential) (map[string]string, error)
+	GetCollateralSummary(ctx context.Context, credential domainauth.Credential) (CollateralSummaryResponse, error)
+	GetOpenPositions(ctx context.Context, credential domainauth.Credential, request OpenPositionsRequest) ([]PositionResponse, error)
+	SetCollateralLeverage(ctx context.Context, credential domainauth.Credential, request CollateralLeverageRequest) (CollateralLeverageResponse, error)
+	SetCollateralAccountHedgeMode(ctx context.Context, credential domainauth.Credential, request CollateralHedgeModeRequest) (CollateralAccountHedgeModeResponse, error)
+	GetWebSocketToken(ctx context.Context, credential domainauth.Credential) (WebSocketTokenResponse, error)
 }
 
 // Client executes signed private WhiteBIT HTTP API requests.
@@ -85,15 +103,29 @@ type Client struct {
 	baseURL     string
 	httpDoer    HTTPDoer
 	nonceSource NonceSource
+	rateLimiter *RateLimiter
 }
 
 // NewDefaultClient constructs Client with production defaults.
+// All default clients share DefaultNonceSource and DefaultRateLimiter, so concurrent adapters draw from one budget.
 func NewDefaultClient() *Client {
-	return NewClient(defaultBaseURL, nil, nil)
+	return NewDefaultClientWithNonceSource(DefaultNonceSource())
+}
+
+// NewDefaultClientWithNonceSource constructs Client with production defaults and nonceSource,
+// for runs that must not share the nonce file.
+func NewDefaultClientWithNonceSource(nonceSource NonceSource) *Client {
+	return NewRateLimitedClient(defaultBaseURL, nil, nonceSource, DefaultRateLimiter())
 }
 
-// NewClient constructs Client with injectable dependencies for tests.
+// NewClient constructs Client with injectable dependencies for tests. Requests are not rate limited.
 func NewClient(baseURL string, httpDoer HTTPDoer, nonceSource NonceSource) *Client {
+	return NewRateLimitedClient(baseURL, httpDoer, nonceSource, nil)
+}
+
+// NewRateLimitedClient constructs Client that waits on rateLimiter before every request.
+// A nil rateLimiter disables limiting.
+func NewRateLimitedClient(baseURL string, httpDoer HTTPDoer, nonceSource NonceSource, rateLimiter *RateLimiter) *Client {
 	if strings.TrimSpace(baseURL) == "" {
 		baseURL = defaultBaseURL
 	}
@@ -108,6 +140,7 @@ func NewClient(baseURL string, httpDoer HTTPDoer, nonceSource NonceSource) *Clie
 		baseURL:     strings.TrimRight(baseURL, "/"),
 		httpDoer:    httpDoer,
 		nonceSource: nonceSource,
+		rateLimiter: rateLimiter,
 	}
 }
 
@@ -154,18 +187,18 @@ func (client *Client) doPrivateRequest(
 	request.Header.Set("X-TXC-PAYLOAD", encodedPayload)
 	request.Header.Set("X-TXC-SIGNATURE", signature)
 
-	response, err := client.httpDoer.Do(request)
+	response, err := client.do(ctx, request, path)
 	if err != nil {
-		return fmt.Errorf("%w: request failed", ErrAPITransport)
+		return err
 	}
 	defer func() {
 		_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, maxResponseBodySize))
 		_ = response.Body.Close()
 	}()
 
-	responseBody, err := io.ReadAll(io.LimitReader(response.Body, maxResponseBodySize))
+	responseBody, err := readResponseBody(response.Body)
 	if err != nil {
-		return fmt.Errorf("read response body: %w", err)
+		return err
 	}
 
 	if response.StatusCode < http.StatusOK || response.StatusCode > 299 {
@@ -182,6 +215,39 @@ func (client *Client) doPrivateRequest(
 	return nil
 }
 
+// readResponseBody reads at most maxResponseBodySize bytes and fails instead of truncating a larger body.
+func readResponseBody(body io.Reader) ([]byte, error) {
+	content, err := io.ReadAll(io.LimitReader(body, maxResponseBodySize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read response body: %w", err)
+	}
+	if len(content) > maxResponseBodySize {
+		return nil, fmt.Errorf("%w: more than %d bytes", ErrResponseTooLarge, maxResponseBodySize)
+	}
+
+	return content, nil
+}
+
+// do sends request within the rate budget of path and pauses that budget when WhiteBIT answers 429.
+func (client *Client) do(ctx context.Context, request *http.Request, path string) (*http.Response, error) {
+	group := rateGroupForPath(path)
+	if client.rateLimiter != nil {
+		if err := client.rateLimiter.Wait(ctx, group); err != nil {
+			return nil, fmt.Errorf("wait for rate limit: %w", err)
+		}
+	}
+
+	response, err := client.httpDoer.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request failed", ErrAPITransport)
+	}
+	if response.StatusCode == http.StatusTooManyRequests && client.rateLimiter != nil {
+		client.rateLimiter.Block(group, parseRetryAfter(response.Header.Get("Retry-After"), client.rateLimiter.clock.Now()))
+	}
+
+	return response, nil
+}
+
 func mapHTTPStatusError(statusCode int, body []byte) error {
 	responseMessage := extractErrorMessage(body)
 	wrapStatus := func(base error) error {
@@ -3,6 +3,7 @@ package whitebit
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -218,3 +219,360 @@ func TestClientValidatesEnumAndOrderRules(t *testing.T) {
 		t.Fatalf("expected ioc conflict error for ioc+rpi, got %v", err)
 	}
 }
+
+func TestClientPlaceCollateralBulkLimitOrderParsesPerOrderResults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != URLPathCollateralLimitOrderBulk {
+			t.Fatalf("expected path %s, got %s", URLPathCollateralLimitOrderBulk, request.URL.Path)
+		}
+		writer.WriteHeader(http.StatusOK)
+		_, _ = writer.Write([]byte(`[
+			{"result":{"orderId":4180284841,"clientOrderId":"run-0","market":"BTC_PERP","side":"buy","type":"margin_limit","amount":"0.01","price":"49000","left":"0.01","postOnly":true},"error":null},
+			{"result":null,"error":{"code":32,"message":"Validation failed","errors":{"amount":["Amount should be greater than 0.001"]}}}
+		]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, server.Client(), fixedNonceSource{value: 1})
+	results, err := client.PlaceCollateralBulkLimitOrder(context.Background(), domainauth.Credential{
+		APIKey:    "public-key",
+		APISecret: []byte("secret-key"),
+	}, CollateralBulkLimitOrderRequest{
+		Orders: []CollateralLimitOrderRequest{
+			{Market: "BTC_PERP", Side: OrderSideBuy, Amount: "0.01", Price: "49000", ClientOrderID: "run-0"},
+			{Market: "BTC_PERP", Side: OrderSideBuy, Amount: "0.0001", Price: "48950", ClientOrderID: "run-1"},
+		},
+		StopOnFail: boolPtr(false),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected two results, got %d", len(results))
+	}
+	if results[0].Result == nil || results[0].Result.OrderID != 4180284841 || results[0].Error != nil {
+		t.Fatalf("expected accepted first order, got %#v", results[0])
+	}
+	if results[1].Error == nil || results[1].Result != nil {
+		t.Fatalf("expected rejected second order, got %#v", results[1])
+	}
+	if got := results[1].Error.Detail(); got != "Validation failed: amount: Amount should be greater than 0.001" {
+		t.Fatalf("unexpected bulk error detail: %q", got)
+	}
+}
+
+func TestClientPlaceCollateralBulkLimitOrderRejectsOversizedBatch(t *testing.T) {
+	client := NewClient("https://whitebit.com", &http.Client{}, fixedNonceSource{value: 1})
+	orders := make([]CollateralLimitOrderRequest, MaxCollateralBulkOrders+1)
+	for index := range orders {
+		orders[index] = CollateralLimitOrderRequest{Market: "BTC_PERP", Side: OrderSideBuy, Amount: "0.001", Price: "50000"}
+	}
+
+	_, err := client.PlaceCollateralBulkLimitOrder(context.Background(), domainauth.Credential{
+		APIKey:    "public-key",
+		APISecret: []byte("secret-key"),
+	}, CollateralBulkLimitOrderRequest{Orders: orders})
+	if !errors.Is(err, ErrTooManyOrders) {
+		t.Fatalf("expected too many orders error, got %v", err)
+	}
+}
+
+func TestClientCancelOrderValidatesIdentifiers(t *testing.T) {
+	client := NewClient("https://whitebit.com", &http.Client{}, fixedNonceSource{value: 1})
+	credential := domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")}
+
+	_, err := client.CancelOrder(context.Background(), credential, CancelOrderRequest{Market: "BTC_PERP"})
+	if !errors.Is(err, ErrOrderIdentifierRequired) {
+		t.Fatalf("expected identifier required error, got %v", err)
+	}
+
+	_, err = client.CancelOrder(context.Background(), credential, CancelOrderRequest{Market: "BTC_PERP", OrderID: 1, ClientOrderID: "run-0"})
+	if !errors.Is(err, ErrOrderIdentifierConflict) {
+		t.Fatalf("expected identifier conflict error, got %v", err)
+	}
+
+	err = client.CancelAllOrders(context.Background(), credential, CancelAllOrdersRequest{Type: []OrderMarketType{"collateral"}})
+	if !errors.Is(err, ErrInvalidOrderMarketType) {
+		t.Fatalf("expected invalid market type error, got %v", err)
+	}
+
+	_, err = client.GetActiveOrders(context.Background(), credential, ActiveOrdersRequest{Limit: MaxActiveOrdersLimit + 1})
+	if !errors.Is(err, ErrInvalidLimit) {
+		t.Fatalf("expected invalid limit error, got %v", err)
+	}
+}
+
+func TestClientCancelAllOrdersSendsTypeFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != URLPathOrderCancelAll {
+			t.Fatalf("expected path %s, got %s", URLPathOrderCancelAll, request.URL.Path)
+		}
+		body, _ := io.ReadAll(request.Body)
+		if !strings.Contains(string(body), `"market":"BTC_PERP","type":["margin","futures"]`) {
+			t.Fatalf("unexpected request body: %s", body)
+		}
+		writer.WriteHeader(http.StatusOK)
+		_, _ = writer.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, server.Client(), fixedNonceSource{value: 1})
+	err := client.CancelAllOrders(context.Background(), domainauth.Credential{
+		APIKey:    "public-key",
+		APISecret: []byte("secret-key"),
+	}, CancelAllOrdersRequest{Market: "BTC_PERP", Type: []OrderMarketType{OrderMarketTypeMargin, OrderMarketTypeFutures}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestClientGetExecutedHistoryDecodesMarketKeyedResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != URLPathExecutedHistory {
+			t.Fatalf("expected path %s, got %s", URLPathExecutedHistory, request.URL.Path)
+		}
+		writer.WriteHeader(http.StatusOK)
+		_, _ = writer.Write([]byte(`{
+			"ETH_PERP": [{"id": 2, "orderId": 20, "time": 1700000100.5, "side": "sell", "role": 2, "price": "2000", "amount": "1"}],
+			"BTC_PERP": [{"id": 1, "orderId": 10, "time": 1700000200.25, "side": "buy", "role": 1, "price": "50000", "amount": "0.01"}]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, server.Client(), fixedNonceSource{value: 1})
+	trades, err := client.GetExecutedHistory(context.Background(), domainauth.Credential{
+		APIKey:    "public-key",
+		APISecret: []byte("secret-key"),
+	}, ExecutedHistoryRequest{Limit: MaxHistoryLimit})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(trades) != 2 {
+		t.Fatalf("expected 2 trades, got %+v", trades)
+	}
+	if trades[0].TradeID != 1 || trades[0].Market != "BTC_PERP" || trades[0].Role != TradeRoleMaker {
+		t.Fatalf("expected newest BTC_PERP maker fill first, got %+v", trades[0])
+	}
+	if trades[1].Market != "ETH_PERP" || trades[1].Role.String() != "taker" {
+		t.Fatalf("expected ETH_PERP taker fill second, got %+v", trades[1])
+	}
+}
+
+func TestClientGetOrderHistoryRejectsOffsetOutOfRange(t *testing.T) {
+	client := NewClient("http://127.0.0.1:0", http.DefaultClient, fixedNonceSource{value: 1})
+	_, err := client.GetOrderHistory(context.Background(), domainauth.Credential{}, OrderHistoryRequest{Offset: MaxHistoryOffset + 1})
+	if !errors.Is(err, ErrInvalidLimit) {
+		t.Fatalf("expected ErrInvalidLimit, got %v", err)
+	}
+}
+
+func TestClientSetCollateralLeverageRejectsUnsupportedValue(t *testing.T) {
+	client := NewClient("http://127.0.0.1:0", http.DefaultClient, fixedNonceSource{value: 1})
+	_, err := client.SetCollateralLeverage(context.Background(), domainauth.Credential{}, CollateralLeverageRequest{Leverage: 4})
+	if !errors.Is(err, ErrInvalidLeverage) {
+		t.Fatalf("expected ErrInvalidLeverage, got %v", err)
+	}
+}
+
+func TestClientSetCollateralAccountHedgeModeSendsFlag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != URLPathCollateralHedgeModeSet {
+			t.Fatalf("expected path %s, got %s", URLPathCollateralHedgeModeSet, request.URL.Path)
+		}
+		body, _ := io.ReadAll(request.Body)
+		if !strings.Contains(string(body), `"hedgeMode":false`) {
+			t.Fatalf("expected explicit hedgeMode false in body: %s", body)
+		}
+		writer.WriteHeader(http.StatusOK)
+		_, _ = writer.Write([]byte(`{"hedgeMode":false}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, server.Client(), fixedNonceSource{value: 1})
+	response, err := client.SetCollateralAccountHedgeMode(context.Background(), domainauth.Credential{
+		APIKey:    "public-key",
+		APISecret: []byte("secret-key"),
+	}, CollateralHedgeModeRequest{HedgeMode: false})
+	if err != nil || response.HedgeMode {
+		t.Fatalf("expected hedge mode false without error, got %+v err=%v", response, err)
+	}
+}
+
+func TestClientPlaceCollateralStopLimitOrderSendsActivationPrice(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != URLPathCollateralStopLimitOrder {
+			t.Fatalf("expected path %s, got %s", URLPathCollateralStopLimitOrder, request.URL.Path)
+		}
+		body, _ := io.ReadAll(request.Body)
+		if !strings.Contains(string(body), `"activation_price":"49500"`) || strings.Contains(string(body), "postOnly") {
+			t.Fatalf("unexpected stop-limit body: %s", body)
+		}
+		writer.WriteHeader(http.StatusOK)
+		_, _ = writer.Write([]byte(`{"orderId":1}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, server.Client(), fixedNonceSource{value: 1})
+	_, err := client.PlaceCollateralStopLimitOrder(context.Background(), domainauth.Credential{
+		APIKey:    "public-key",
+		APISecret: []byte("secret-key"),
+	}, CollateralStopLimitOrderRequest{
+		Market:          "BTC_PERP",
+		Side:            OrderSideSell,
+		Amount:          "0.01",
+		Price:           "49400",
+		ActivationPrice: "49500",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestClientStopOrdersRequireActivationPrice(t *testing.T) {
+	client := NewClient("http://127.0.0.1:0", http.DefaultClient, fixedNonceSource{value: 1})
+
+	_, err := client.PlaceCollateralStopMarketOrder(context.Background(), domainauth.Credential{}, CollateralStopMarketOrderRequest{
+		Market: "BTC_PERP",
+		Side:   OrderSideBuy,
+		Amount: "0.01",
+	})
+	if !errors.Is(err, ErrActivationPriceRequired) {
+		t.Fatalf("expected ErrActivationPriceRequired, got %v", err)
+	}
+
+	_, err = client.PlaceCollateralMarketOrder(context.Background(), domainauth.Credential{}, CollateralMarketOrderRequest{
+		Market: "BTC_PERP",
+		Side:   "hold",
+		Amount: "0.01",
+	})
+	if !errors.Is(err, ErrInvalidOrderSide) {
+		t.Fatalf("expected ErrInvalidOrderSide, got %v", err)
+	}
+}
+
+func TestClientGetOrderBookSendsUnsignedLimitQuery(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method != http.MethodGet || request.URL.Path != URLPathPublicOrderBook+"BTC_PERP" {
+			t.Fatalf("unexpected request %s %s", request.Method, request.URL.Path)
+		}
+		if request.URL.Query().Get("limit") != "5" {
+			t.Fatalf("expected limit=5, got %q", request.URL.RawQuery)
+		}
+		if request.Header.Get("X-TXC-APIKEY") != "" || request.Header.Get("X-TXC-SIGNATURE") != "" {
+			t.Fatalf("public request must not be signed")
+		}
+		writer.WriteHeader(http.StatusOK)
+		_, _ = writer.Write([]byte(`{"ticker_id":"BTC_PERP","timestamp":1700000000,"asks":[["60010","0.5"]],"bids":[["59990","1.2"]]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, server.Client(), fixedNonceSource{value: 1})
+	response, err := client.GetOrderBook(context.Background(), OrderBookRequest{Market: "BTC_PERP", Limit: 5})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(response.Asks) != 1 || response.Asks[0][0] != "60010" || response.Bids[0][1] != "1.2" {
+		t.Fatalf("unexpected order book: %+v", response)
+	}
+
+	if _, err := client.GetOrderBook(context.Background(), OrderBookRequest{Market: "BTC_PERP", Limit: MaxOrderBookLimit + 1}); !errors.Is(err, ErrOrderBookLimitOutOfRange) {
+		t.Fatalf("expected ErrOrderBookLimitOutOfRange, got %v", err)
+	}
+}
+
+func TestClientGetRecentTradesMapsStatusErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Query().Get("type") != "sell" {
+			t.Fatalf("expected type=sell, got %q", request.URL.RawQuery)
+		}
+		writer.WriteHeader(http.StatusTooManyRequests)
+		_, _ = writer.Write([]byte(`{"message":"too many requests"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, server.Client(), fixedNonceSource{value: 1})
+	_, err := client.GetRecentTrades(context.Background(), RecentTradesRequest{Market: "BTC_PERP", Side: OrderSideSell})
+	if !errors.Is(err, ErrAPITransport) {
+		t.Fatalf("expected ErrAPITransport, got %v", err)
+	}
+}
+
+func TestClientGetKlinesDecodesMixedRows(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		query := request.URL.Query()
+		if request.URL.Path != URLPathPublicKline || query.Get("market") != "BTC_PERP" || query.Get("interval") != "15m" {
+			t.Fatalf("unexpected request %s?%s", request.URL.Path, request.URL.RawQuery)
+		}
+		if query.Get("start") != "1772323200" || query.Get("limit") != "1440" {
+			t.Fatalf("unexpected window query %q", request.URL.RawQuery)
+		}
+		writer.WriteHeader(http.StatusOK)
+		_, _ = writer.Write([]byte(`{"success":true,"message":"","result":[[1772323200,"60000","60050","60100","59900","12.5","750000"]]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, server.Client(), fixedNonceSource{value: 1})
+	klines, err := client.GetKlines(context.Background(), KlineRequest{
+		Market:   "BTC_PERP",
+		Interval: "15m",
+		Start:    1772323200,
+		End:      1772409600,
+		Limit:    MaxKlineLimit,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(klines) != 1 || klines[0].Time != 1772323200 || klines[0].Close != "60050" || klines[0].High != "60100" || klines[0].QuoteVolume != "750000" {
+		t.Fatalf("unexpected klines: %+v", klines)
+	}
+
+	if _, err := client.GetKlines(context.Background(), KlineRequest{Market: "BTC_PERP", Interval: "15m", Limit: MaxKlineLimit + 1}); !errors.Is(err, ErrKlineLimitOutOfRange) {
+		t.Fatalf("expected ErrKlineLimitOutOfRange, got %v", err)
+	}
+}
+
+func TestClientGetWebSocketTokenRejectsEmptyToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != URLPathWebSocketToken {
+			t.Fatalf("expected path %s, got %s", URLPathWebSocketToken, request.URL.Path)
+		}
+		if request.Header.Get("X-TXC-SIGNATURE") == "" {
+			t.Fatalf("expected signed request")
+		}
+		writer.WriteHeader(http.StatusOK)
+		_, _ = writer.Write([]byte(`{"websocket_token":""}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, server.Client(), fixedNonceSource{value: 1})
+	_, err := client.GetWebSocketToken(context.Background(), domainauth.Credential{
+		APIKey:    "public-key",
+		APISecret: []byte("secret-key"),
+	})
+	if !errors.Is(err, ErrWebSocketTokenEmpty) {
+		t.Fatalf("expected ErrWebSocketTokenEmpty, got %v", err)
+	}
+}
+
+func TestClientReadsFullPagesAndRejectsOversizedResponses(t *testing.T) {
+	order := `{"orderId":1,"clientOrderId":"` + strings.Repeat("x", 900) + `","market":"BTC_PERP","side":"buy","type":"margin limit"}`
+	page := "[" + strings.TrimSuffix(strings.Repeat(order+",", MaxActiveOrdersLimit), ",") + "]"
+	body := page
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, server.Client(), fixedNonceSource{value: 1})
+	credential := domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")}
+	orders, err := client.GetActiveOrders(context.Background(), credential, ActiveOrdersRequest{Market: "BTC_PERP", Limit: MaxActiveOrdersLimit})
+	if err != nil || len(orders) != MaxActiveOrdersLimit {
+		t.Fatalf("expected a full %d-byte page to decode, got %d orders and %v", len(page), len(orders), err)
+	}
+
+	body = strings.Repeat(" ", maxResponseBodySize) + page
+	if _, err := client.GetActiveOrders(context.Background(), credential, ActiveOrdersRequest{Market: "BTC_PERP", Limit: 1}); !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("expected ErrResponseTooLarge, got %v", err)
+	}
+}
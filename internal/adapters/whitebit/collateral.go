@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strings"
 
 	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
 )
@@ -12,8 +13,14 @@ const (
 	URLPathCollateralAccountHedgeMode = "/api/v4/collateral-account/hedge-mode"
 	URLPathCollateralLimitOrder       = "/api/v4/order/collateral/limit"
 	URLPathCollateralLimitOrderBulk   = "/api/v4/order/collateral/bulk"
+	URLPathCollateralMarketOrder      = "/api/v4/order/collateral/market"
+	URLPathCollateralStopMarketOrder  = "/api/v4/order/collateral/trigger-market"
+	URLPathCollateralStopLimitOrder   = "/api/v4/order/collateral/stop-limit"
 )
 
+// MaxCollateralBulkOrders is the documented maximum number of orders per bulk request.
+const MaxCollateralBulkOrders = 20
+
 var (
 	// ErrMarketRequired indicates missing market in order request.
 	ErrMarketRequired = errors.New("market is required")
@@ -21,6 +28,8 @@ var (
 	ErrAmountRequired = errors.New("amount is required")
 	// ErrPriceRequired indicates missing price in order request.
 	ErrPriceRequired = errors.New("price is required")
+	// ErrActivationPriceRequired indicates missing activation price in stop order request.
+	ErrActivationPriceRequired = errors.New("activation price is required")
 	// ErrInvalidOrderSide indicates unknown order side enum value.
 	ErrInvalidOrderSide = errors.New("invalid order side")
 	// ErrInvalidPositionSide indicates unknown position side enum value.
@@ -29,6 +38,8 @@ var (
 	ErrIOCConflict = errors.New("ioc cannot be combined with postOnly or rpi")
 	// ErrOrdersRequired indicates missing orders array for bulk endpoint.
 	ErrOrdersRequired = errors.New("orders are required")
+	// ErrTooManyOrders indicates bulk orders array above documented maximum.
+	ErrTooManyOrders = errors.New("too many orders in bulk request")
 )
 
 // OrderSide is a documented WhiteBIT enum for order direction.
@@ -81,12 +92,90 @@ type CollateralLimitOrderRequest struct {
 	TakeProfit    string       `json:"takeProfit,omitempty"`
 }
 
+// CollateralMarketOrderRequest is request payload for market order endpoint.
+type CollateralMarketOrderRequest struct {
+	Market        string       `json:"market"`
+	Side          OrderSide    `json:"side"`
+	Amount        string       `json:"amount"`
+	PositionSide  PositionSide `json:"positionSide,omitempty"`
+	ClientOrderID string       `json:"clientOrderId,omitempty"`
+}
+
+// CollateralStopMarketOrderRequest is request payload for trigger (stop) market order endpoint.
+type CollateralStopMarketOrderRequest struct {
+	Market          string       `json:"market"`
+	Side            OrderSide    `json:"side"`
+	Amount          string       `json:"amount"`
+	ActivationPrice string       `json:"activation_price"`
+	PositionSide    PositionSide `json:"positionSide,omitempty"`
+	ClientOrderID   string       `json:"clientOrderId,omitempty"`
+}
+
+// CollateralStopLimitOrderRequest is request payload for stop-limit order endpoint.
+type CollateralStopLimitOrderRequest struct {
+	Market          string       `json:"market"`
+	Side            OrderSide    `json:"side"`
+	Amount          string       `json:"amount"`
+	Price           string       `json:"price"`
+	ActivationPrice string       `json:"activation_price"`
+	PositionSide    PositionSide `json:"positionSide,omitempty"`
+	ClientOrderID   string       `json:"clientOrderId,omitempty"`
+}
+
 // CollateralBulkLimitOrderRequest is request payload for bulk limit order endpoint.
 type CollateralBulkLimitOrderRequest struct {
 	Orders     []CollateralLimitOrderRequest `json:"orders"`
 	StopOnFail *bool                         `json:"stopOnFail,omitempty"`
 }
 
+// CollateralOrderResponse models order payload returned by collateral order endpoints.
+type CollateralOrderResponse struct {
+	OrderID       int64        `json:"orderId"`
+	ClientOrderID string       `json:"clientOrderId"`
+	Market        string       `json:"market"`
+	Side          OrderSide    `json:"side"`
+	Type          string       `json:"type"`
+	Timestamp     float64      `json:"timestamp"`
+	DealMoney     string       `json:"dealMoney"`
+	DealStock     string       `json:"dealStock"`
+	Amount        string       `json:"amount"`
+	Left          string       `json:"left"`
+	DealFee       string       `json:"dealFee"`
+	Price         string       `json:"price"`
+	PostOnly      bool         `json:"postOnly"`
+	IOC           bool         `json:"ioc"`
+	PositionSide  PositionSide `json:"positionSide,omitempty"`
+}
+
+// BulkOrderError models per-order error object returned by bulk endpoints.
+type BulkOrderError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Errors  any    `json:"errors,omitempty"`
+}
+
+// Detail returns message with flattened validation errors.
+func (bulkError BulkOrderError) Detail() string {
+	message := strings.TrimSpace(bulkError.Message)
+	validationDetails := flattenValidationErrors(bulkError.Errors)
+
+	switch {
+	case message == "":
+		return validationDetails
+	case validationDetails == "":
+		return message
+	default:
+		return message + ": " + validationDetails
+	}
+}
+
+// CollateralBulkLimitOrderResult models one item of bulk limit order response array.
+// Items keep request order; exactly one of Result and Error is set.
+type CollateralBulkLimitOrderResult struct {
+	Result *CollateralOrderResponse `json:"result"`
+	Error  *BulkOrderError          `json:"error"`
+}
+
 type collateralHedgeModePayload struct {
 	privateEnvelope
 }
@@ -96,6 +185,21 @@ type collateralLimitOrderPayload struct {
 	CollateralLimitOrderRequest
 }
 
+type collateralMarketOrderPayload struct {
+	privateEnvelope
+	CollateralMarketOrderRequest
+}
+
+type collateralStopMarketOrderPayload struct {
+	privateEnvelope
+	CollateralStopMarketOrderRequest
+}
+
+type collateralStopLimitOrderPayload struct {
+	privateEnvelope
+	CollateralStopLimitOrderRequest
+}
+
 type collateralBulkLimitOrderPayload struct {
 	privateEnvelope
 	Orders     []CollateralLimitOrderRequest `json:"orders"`
@@ -103,21 +207,12 @@ type collateralBulkLimitOrderPayload struct {
 }
 
 func (request CollateralLimitOrderRequest) validate() error {
-	if request.Market == "" {
-		return ErrMarketRequired
-	}
-	if request.Amount == "" {
-		return ErrAmountRequired
+	if err := validateOrderFields(request.Market, request.Amount, request.Side, request.PositionSide); err != nil {
+		return err
 	}
 	if request.Price == "" {
 		return ErrPriceRequired
 	}
-	if !request.Side.IsValid() {
-		return ErrInvalidOrderSide
-	}
-	if request.PositionSide != "" && !request.PositionSide.IsValid() {
-		return ErrInvalidPositionSide
-	}
 	if request.IOC != nil && *request.IOC {
 		if request.PostOnly != nil && *request.PostOnly {
 			return ErrIOCConflict
@@ -130,10 +225,59 @@ func (request CollateralLimitOrderRequest) validate() error {
 	return nil
 }
 
+func (request CollateralMarketOrderRequest) validate() error {
+	return validateOrderFields(request.Market, request.Amount, request.Side, request.PositionSide)
+}
+
+func (request CollateralStopMarketOrderRequest) validate() error {
+	if err := validateOrderFields(request.Market, request.Amount, request.Side, request.PositionSide); err != nil {
+		return err
+	}
+	if request.ActivationPrice == "" {
+		return ErrActivationPriceRequired
+	}
+
+	return nil
+}
+
+func (request CollateralStopLimitOrderRequest) validate() error {
+	if err := validateOrderFields(request.Market, request.Amount, request.Side, request.PositionSide); err != nil {
+		return err
+	}
+	if request.Price == "" {
+		return ErrPriceRequired
+	}
+	if request.ActivationPrice == "" {
+		return ErrActivationPriceRequired
+	}
+
+	return nil
+}
+
+func validateOrderFields(market string, amount string, side OrderSide, positionSide PositionSide) error {
+	if market == "" {
+		return ErrMarketRequired
+	}
+	if amount == "" {
+		return ErrAmountRequired
+	}
+	if !side.IsValid() {
+		return ErrInvalidOrderSide
+	}
+	if positionSide != "" && !positionSide.IsValid() {
+		return ErrInvalidPositionSide
+	}
+
+	return nil
+}
+
 func (request CollateralBulkLimitOrderRequest) validate() error {
 	if len(request.Orders) == 0 {
 		return ErrOrdersRequired
 	}
+	if len(request.Orders) > MaxCollateralBulkOrders {
+		return ErrTooManyOrders
+	}
 
 	for index := range request.Orders {
 		if err := request.Orders[index].validate(); err != nil {
@@ -184,12 +328,82 @@ func (client *Client) PlaceCollateralLimitOrder(
 	return response, nil
 }
 
+// PlaceCollateralMarketOrder calls WhiteBIT collateral market order endpoint.
+// Market orders always take liquidity.
+func (client *Client) PlaceCollateralMarketOrder(
+	ctx context.Context,
+	credential domainauth.Credential,
+	request CollateralMarketOrderRequest,
+) (json.RawMessage, error) {
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+
+	payload := collateralMarketOrderPayload{
+		privateEnvelope:              client.nextPrivateEnvelope(URLPathCollateralMarketOrder),
+		CollateralMarketOrderRequest: request,
+	}
+
+	var response json.RawMessage
+	if err := client.doPrivateRequest(ctx, credential, URLPathCollateralMarketOrder, payload, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// PlaceCollateralStopMarketOrder calls WhiteBIT collateral trigger market order endpoint.
+func (client *Client) PlaceCollateralStopMarketOrder(
+	ctx context.Context,
+	credential domainauth.Credential,
+	request CollateralStopMarketOrderRequest,
+) (json.RawMessage, error) {
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+
+	payload := collateralStopMarketOrderPayload{
+		privateEnvelope:                  client.nextPrivateEnvelope(URLPathCollateralStopMarketOrder),
+		CollateralStopMarketOrderRequest: request,
+	}
+
+	var response json.RawMessage
+	if err := client.doPrivateRequest(ctx, credential, URLPathCollateralStopMarketOrder, payload, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// PlaceCollateralStopLimitOrder calls WhiteBIT collateral stop-limit order endpoint.
+func (client *Client) PlaceCollateralStopLimitOrder(
+	ctx context.Context,
+	credential domainauth.Credential,
+	request CollateralStopLimitOrderRequest,
+) (json.RawMessage, error) {
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+
+	payload := collateralStopLimitOrderPayload{
+		privateEnvelope:                 client.nextPrivateEnvelope(URLPathCollateralStopLimitOrder),
+		CollateralStopLimitOrderRequest: request,
+	}
+
+	var response json.RawMessage
+	if err := client.doPrivateRequest(ctx, credential, URLPathCollateralStopLimitOrder, payload, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
 // PlaceCollateralBulkLimitOrder calls WhiteBIT collateral bulk limit order endpoint.
 func (client *Client) PlaceCollateralBulkLimitOrder(
 	ctx context.Context,
 	credential domainauth.Credential,
 	request CollateralBulkLimitOrderRequest,
-) (json.RawMessage, error) {
+) ([]CollateralBulkLimitOrderResult, error) {
 	if err := request.validate(); err != nil {
 		return nil, err
 	}
@@ -200,7 +414,7 @@ func (client *Client) PlaceCollateralBulkLimitOrder(
 		StopOnFail:      request.StopOnFail,
 	}
 
-	var response json.RawMessage
+	var response []CollateralBulkLimitOrderResult
 	if err := client.doPrivateRequest(ctx, credential, URLPathCollateralLimitOrderBulk, payload, &response); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,225 @@
+package whitebit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+const (
+	URLPathOrderHistory    = "/api/v4/trade-account/order/history"
+	URLPathExecutedHistory = "/api/v4/trade-account/executed-history"
+)
+
+const (
+	// MaxHistoryLimit is the documented maximum page size of history endpoints.
+	MaxHistoryLimit = 100
+	// MaxHistoryOffset is the documented maximum offset of history endpoints.
+	MaxHistoryOffset = 10000
+)
+
+// TradeRole is a documented WhiteBIT enum for fill liquidity role.
+type TradeRole int
+
+const (
+	// TradeRoleMaker marks fills that added liquidity.
+	TradeRoleMaker TradeRole = 1
+	// TradeRoleTaker marks fills that removed liquidity.
+	TradeRoleTaker TradeRole = 2
+)
+
+// String returns lower-case role name.
+func (role TradeRole) String() string {
+	switch role {
+	case TradeRoleMaker:
+		return "maker"
+	case TradeRoleTaker:
+		return "taker"
+	default:
+		return fmt.Sprintf("role(%d)", int(role))
+	}
+}
+
+// OrderHistoryRequest is request payload for executed order history endpoint.
+type OrderHistoryRequest struct {
+	Market        string `json:"market,omitempty"`
+	OrderID       int64  `json:"orderId,omitempty"`
+	ClientOrderID string `json:"clientOrderId,omitempty"`
+	Limit         int    `json:"limit,omitempty"`
+	Offset        int    `json:"offset,omitempty"`
+}
+
+// ExecutedHistoryRequest is request payload for executed trades (fills) endpoint.
+type ExecutedHistoryRequest struct {
+	Market        string `json:"market,omitempty"`
+	ClientOrderID string `json:"clientOrderId,omitempty"`
+	Limit         int    `json:"limit,omitempty"`
+	Offset        int    `json:"offset,omitempty"`
+}
+
+// OrderHistoryResponse models one finished order from order history endpoint.
+type OrderHistoryResponse struct {
+	OrderID       int64        `json:"id"`
+	ClientOrderID string       `json:"clientOrderId"`
+	Market        string       `json:"-"`
+	Side          OrderSide    `json:"side"`
+	PositionSide  PositionSide `json:"positionSide,omitempty"`
+	Type          string       `json:"type"`
+	Status        string       `json:"status"`
+	Price         string       `json:"price"`
+	Amount        string       `json:"amount"`
+	DealStock     string       `json:"dealStock"`
+	DealMoney     string       `json:"dealMoney"`
+	DealFee       string       `json:"dealFee"`
+	PostOnly      bool         `json:"postOnly"`
+	CreatedAt     float64      `json:"ctime"`
+	FinishedAt    float64      `json:"ftime"`
+}
+
+// TradeResponse models one fill from executed history endpoint.
+type TradeResponse struct {
+	TradeID       int64     `json:"id"`
+	OrderID       int64     `json:"orderId"`
+	ClientOrderID string    `json:"clientOrderId"`
+	Market        string    `json:"-"`
+	Side          OrderSide `json:"side"`
+	Role          TradeRole `json:"role"`
+	Price         string    `json:"price"`
+	Amount        string    `json:"amount"`
+	Deal          string    `json:"deal"`
+	Fee           string    `json:"fee"`
+	FeeAsset      string    `json:"feeAsset"`
+	Timestamp     float64   `json:"time"`
+}
+
+type orderHistoryPayload struct {
+	privateEnvelope
+	OrderHistoryRequest
+}
+
+type executedHistoryPayload struct {
+	privateEnvelope
+	ExecutedHistoryRequest
+}
+
+func validateHistoryPage(limit int, offset int) error {
+	if limit < 0 || limit > MaxHistoryLimit || offset < 0 || offset > MaxHistoryOffset {
+		return ErrInvalidLimit
+	}
+
+	return nil
+}
+
+// GetOrderHistory calls WhiteBIT order history endpoint and returns one page, newest first.
+func (client *Client) GetOrderHistory(
+	ctx context.Context,
+	credential domainauth.Credential,
+	request OrderHistoryRequest,
+) ([]OrderHistoryResponse, error) {
+	if err := validateHistoryPage(request.Limit, request.Offset); err != nil {
+		return nil, err
+	}
+
+	payload := orderHistoryPayload{
+		privateEnvelope:     client.nextPrivateEnvelope(URLPathOrderHistory),
+		OrderHistoryRequest: request,
+	}
+
+	var response json.RawMessage
+	if err := client.doPrivateRequest(ctx, credential, URLPathOrderHistory, payload, &response); err != nil {
+		return nil, err
+	}
+
+	orders, err := decodeMarketRows(response, request.Market, func(order *OrderHistoryResponse, market string) {
+		order.Market = market
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(orders, func(left int, right int) bool {
+		return orders[left].FinishedAt > orders[right].FinishedAt
+	})
+
+	return orders, nil
+}
+
+// GetExecutedHistory calls WhiteBIT executed history endpoint and returns one page of fills, newest first.
+func (client *Client) GetExecutedHistory(
+	ctx context.Context,
+	credential domainauth.Credential,
+	request ExecutedHistoryRequest,
+) ([]TradeResponse, error) {
+	if err := validateHistoryPage(request.Limit, request.Offset); err != nil {
+		return nil, err
+	}
+
+	payload := executedHistoryPayload{
+		privateEnvelope:        client.nextPrivateEnvelope(URLPathExecutedHistory),
+		ExecutedHistoryRequest: request,
+	}
+
+	var response json.RawMessage
+	if err := client.doPrivateRequest(ctx, credential, URLPathExecutedHistory, payload, &response); err != nil {
+		return nil, err
+	}
+
+	trades, err := decodeMarketRows(response, request.Market, func(trade *TradeResponse, market string) {
+		trade.Market = market
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(trades, func(left int, right int) bool {
+		return trades[left].Timestamp > trades[right].Timestamp
+	})
+
+	return trades, nil
+}
+
+// decodeMarketRows decodes history responses that are either a plain array (market filter set)
+// or an object keyed by market name, and stamps each row with its market.
+func decodeMarketRows[T any](body json.RawMessage, market string, setMarket func(*T, string)) ([]T, error) {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return []T{}, nil
+	}
+
+	if trimmed[0] == '[' {
+		var rows []T
+		if err := json.Unmarshal(trimmed, &rows); err != nil {
+			return nil, fmt.Errorf("decode response body: %w", err)
+		}
+		for index := range rows {
+			setMarket(&rows[index], market)
+		}
+
+		return rows, nil
+	}
+
+	var keyed map[string][]T
+	if err := json.Unmarshal(trimmed, &keyed); err != nil {
+		return nil, fmt.Errorf("decode response body: %w", err)
+	}
+
+	markets := make([]string, 0, len(keyed))
+	for name := range keyed {
+		markets = append(markets, name)
+	}
+	sort.Strings(markets)
+
+	rows := make([]T, 0)
+	for _, name := range markets {
+		for _, row := range keyed[name] {
+			setMarket(&row, name)
+			rows = append(rows, row)
+		}
+	}
+
+	return rows, nil
+}
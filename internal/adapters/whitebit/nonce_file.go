@@ -0,0 +1,107 @@
+package whitebit
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ChewX3D/crypto/internal/adapters/clock"
+	"github.com/ChewX3D/crypto/internal/app/ports"
+)
+
+const (
+	nonceDirName         = ".wbcli"
+	defaultNonceFileName = "nonce"
+)
+
+var defaultNonceSource = sync.OnceValue(func() NonceSource {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		slog.Warn("nonce file unavailable, using process-local nonces", "error", err)
+		return &MonotonicUnixMilliNonceSource{}
+	}
+
+	return NewFileNonceSource(filepath.Join(homeDir, nonceDirName, defaultNonceFileName), clock.Real{})
+})
+
+// DefaultNonceSource returns the process-wide nonce source persisted at ~/.wbcli/nonce.
+func DefaultNonceSource() NonceSource {
+	return defaultNonceSource()
+}
+
+// FileNonceSource generates unix-millisecond nonces that never repeat or regress across processes.
+// The last issued nonce is kept in a file holding nothing but that number; every Next takes an
+// exclusive lock on it, so concurrent CLI runs, cron jobs and the bot are serialized.
+// When the file cannot be used, Next logs a warning and stays monotonic within the process.
+type FileNonceSource struct {
+	path  string
+	clock ports.Clock
+	mu    sync.Mutex
+	last  int64
+}
+
+// NewFileNonceSource constructs nonce source persisted at path.
+func NewFileNonceSource(path string, clock ports.Clock) *FileNonceSource {
+	return &FileNonceSource{path: path, clock: clock}
+}
+
+// Next returns a nonce greater than any nonce previously issued through the same file.
+func (source *FileNonceSource) Next() int64 {
+	source.mu.Lock()
+	defer source.mu.Unlock()
+
+	candidate := max(source.clock.Now().UnixMilli(), source.last+1)
+	value, err := source.advance(candidate)
+	if err != nil {
+		slog.Warn("nonce file unavailable, using process-local nonce", "path", source.path, "error", err)
+		value = candidate
+	}
+	source.last = value
+
+	return value
+}
+
+// advance stores and returns max(candidate, persisted+1) under an exclusive file lock.
+func (source *FileNonceSource) advance(candidate int64) (int64, error) {
+	if err := os.MkdirAll(filepath.Dir(source.path), 0o700); err != nil {
+		return 0, fmt.Errorf("create nonce directory: %w", err)
+	}
+
+	file, err := os.OpenFile(source.path, os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return 0, fmt.Errorf("open nonce file: %w", err)
+	}
+	defer file.Close()
+
+	if err := lockFile(file); err != nil {
+		return 0, fmt.Errorf("lock nonce file: %w", err)
+	}
+	defer func() {
+		_ = unlockFile(file)
+	}()
+
+	content, err := io.ReadAll(io.LimitReader(file, 64))
+	if err != nil {
+		return 0, fmt.Errorf("read nonce file: %w", err)
+	}
+
+	value := candidate
+	// an empty or corrupt file is overwritten; the clock-based candidate is still monotonic in practice
+	if stored, err := strconv.ParseInt(strings.TrimSpace(string(content)), 10, 64); err == nil && stored >= value {
+		value = stored + 1
+	}
+
+	if err := file.Truncate(0); err != nil {
+		return 0, fmt.Errorf("truncate nonce file: %w", err)
+	}
+	if _, err := file.WriteAt([]byte(strconv.FormatInt(value, 10)+"\n"), 0); err != nil {
+		return 0, fmt.Errorf("write nonce file: %w", err)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,95 @@
+package whitebit
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	clock_mock "github.com/ChewX3D/crypto/mocks/clock"
+)
+
+func fixedMockClock(t *testing.T, now time.Time) *clock_mock.MockClock {
+	t.Helper()
+
+	clock := clock_mock.NewMockClock(t)
+	clock.EXPECT().Now().Return(now).Maybe()
+
+	return clock
+}
+
+func TestFileNonceSourceNeverRepeatsAcrossSources(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".wbcli", "nonce")
+	now := time.UnixMilli(1_700_000_000_000)
+	first := NewFileNonceSource(path, fixedMockClock(t, now))
+	second := NewFileNonceSource(path, fixedMockClock(t, now))
+
+	values := []int64{first.Next(), second.Next(), first.Next(), second.Next()}
+	for index, value := range values {
+		if value != now.UnixMilli()+int64(index) {
+			t.Fatalf("expected strictly increasing nonces from %d, got %v", now.UnixMilli(), values)
+		}
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("stat nonce file: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected 0600 nonce file, got %v", info.Mode().Perm())
+	}
+}
+
+func TestFileNonceSourceDoesNotRegressWhenClockGoesBack(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nonce")
+	if err := os.WriteFile(path, []byte("1700000005000\n"), 0o600); err != nil {
+		t.Fatalf("seed nonce file: %v", err)
+	}
+
+	source := NewFileNonceSource(path, fixedMockClock(t, time.UnixMilli(1_700_000_000_000)))
+	if value := source.Next(); value != 1_700_000_005_001 {
+		t.Fatalf("expected persisted nonce to win over earlier clock, got %d", value)
+	}
+}
+
+func TestFileNonceSourceConcurrentProcessesGetUniqueNonces(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nonce")
+	now := time.UnixMilli(1_700_000_000_000)
+
+	const sources, perSource = 4, 25
+	results := make(chan int64, sources*perSource)
+	var group sync.WaitGroup
+	for range sources {
+		source := NewFileNonceSource(path, fixedMockClock(t, now))
+		group.Add(1)
+		go func() {
+			defer group.Done()
+			for range perSource {
+				results <- source.Next()
+			}
+		}()
+	}
+	group.Wait()
+	close(results)
+
+	seen := map[int64]bool{}
+	for value := range results {
+		if seen[value] {
+			t.Fatalf("nonce %d issued twice", value)
+		}
+		seen[value] = true
+	}
+}
+
+func TestFileNonceSourceFallsBackToProcessLocalNonces(t *testing.T) {
+	blocker := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(blocker, nil, 0o600); err != nil {
+		t.Fatalf("create blocker: %v", err)
+	}
+
+	source := NewFileNonceSource(filepath.Join(blocker, "nonce"), fixedMockClock(t, time.UnixMilli(1_000)))
+	if first, second := source.Next(), source.Next(); first != 1_000 || second != 1_001 {
+		t.Fatalf("expected monotonic fallback nonces, got %d and %d", first, second)
+	}
+}
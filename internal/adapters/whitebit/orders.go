@@ -0,0 +1,180 @@
+package whitebit
+
+import (
+	"context"
+	"errors"
+
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+const (
+	URLPathOrderCancel    = "/api/v4/order/cancel"
+	URLPathOrderCancelAll = "/api/v4/order/cancel/all"
+	URLPathActiveOrders   = "/api/v4/orders"
+)
+
+// MaxActiveOrdersLimit is the documented maximum page size of the active orders endpoint.
+const MaxActiveOrdersLimit = 100
+
+var (
+	// ErrOrderIdentifierRequired indicates cancel request without orderId or clientOrderId.
+	ErrOrderIdentifierRequired = errors.New("orderId or clientOrderId is required")
+	// ErrOrderIdentifierConflict indicates cancel request with both orderId and clientOrderId.
+	ErrOrderIdentifierConflict = errors.New("orderId and clientOrderId cannot be combined")
+	// ErrInvalidLimit indicates page size outside documented bounds.
+	ErrInvalidLimit = errors.New("limit is out of documented range")
+	// ErrInvalidOrderMarketType indicates unknown cancel-all market type enum value.
+	ErrInvalidOrderMarketType = errors.New("invalid order market type")
+)
+
+// OrderMarketType is a documented WhiteBIT enum for cancel-all order type filter.
+type OrderMarketType string
+
+const (
+	// OrderMarketTypeSpot selects spot orders.
+	OrderMarketTypeSpot OrderMarketType = "spot"
+	// OrderMarketTypeMargin selects margin (collateral) orders.
+	OrderMarketTypeMargin OrderMarketType = "margin"
+	// OrderMarketTypeFutures selects futures (collateral perpetual) orders.
+	OrderMarketTypeFutures OrderMarketType = "futures"
+)
+
+// IsValid returns true when market type matches documented enum values.
+func (marketType OrderMarketType) IsValid() bool {
+	return marketType == OrderMarketTypeSpot || marketType == OrderMarketTypeMargin || marketType == OrderMarketTypeFutures
+}
+
+// CancelOrderRequest is request payload for cancel order endpoint.
+// Exactly one of OrderID and ClientOrderID must be set.
+type CancelOrderRequest struct {
+	Market        string `json:"market"`
+	OrderID       int64  `json:"orderId,omitempty"`
+	ClientOrderID string `json:"clientOrderId,omitempty"`
+}
+
+// CancelAllOrdersRequest is request payload for cancel all orders endpoint.
+// Empty Market cancels across all markets; empty Type cancels all order types.
+type CancelAllOrdersRequest struct {
+	Market string            `json:"market,omitempty"`
+	Type   []OrderMarketType `json:"type,omitempty"`
+}
+
+// ActiveOrdersRequest is request payload for active (unexecuted) orders endpoint.
+type ActiveOrdersRequest struct {
+	Market        string `json:"market,omitempty"`
+	OrderID       int64  `json:"orderId,omitempty"`
+	ClientOrderID string `json:"clientOrderId,omitempty"`
+	Limit         int    `json:"limit,omitempty"`
+	Offset        int    `json:"offset,omitempty"`
+}
+
+type cancelOrderPayload struct {
+	privateEnvelope
+	CancelOrderRequest
+}
+
+type cancelAllOrdersPayload struct {
+	privateEnvelope
+	CancelAllOrdersRequest
+}
+
+type activeOrdersPayload struct {
+	privateEnvelope
+	ActiveOrdersRequest
+}
+
+func (request CancelOrderRequest) validate() error {
+	if request.Market == "" {
+		return ErrMarketRequired
+	}
+	if request.OrderID == 0 && request.ClientOrderID == "" {
+		return ErrOrderIdentifierRequired
+	}
+	if request.OrderID != 0 && request.ClientOrderID != "" {
+		return ErrOrderIdentifierConflict
+	}
+
+	return nil
+}
+
+func (request CancelAllOrdersRequest) validate() error {
+	for _, marketType := range request.Type {
+		if !marketType.IsValid() {
+			return ErrInvalidOrderMarketType
+		}
+	}
+
+	return nil
+}
+
+func (request ActiveOrdersRequest) validate() error {
+	if request.Limit < 0 || request.Limit > MaxActiveOrdersLimit || request.Offset < 0 {
+		return ErrInvalidLimit
+	}
+
+	return nil
+}
+
+// CancelOrder calls WhiteBIT cancel order endpoint.
+func (client *Client) CancelOrder(
+	ctx context.Context,
+	credential domainauth.Credential,
+	request CancelOrderRequest,
+) (CollateralOrderResponse, error) {
+	if err := request.validate(); err != nil {
+		return CollateralOrderResponse{}, err
+	}
+
+	payload := cancelOrderPayload{
+		privateEnvelope:    client.nextPrivateEnvelope(URLPathOrderCancel),
+		CancelOrderRequest: request,
+	}
+
+	var response CollateralOrderResponse
+	if err := client.doPrivateRequest(ctx, credential, URLPathOrderCancel, payload, &response); err != nil {
+		return CollateralOrderResponse{}, err
+	}
+
+	return response, nil
+}
+
+// CancelAllOrders calls WhiteBIT cancel all orders endpoint.
+func (client *Client) CancelAllOrders(
+	ctx context.Context,
+	credential domainauth.Credential,
+	request CancelAllOrdersRequest,
+) error {
+	if err := request.validate(); err != nil {
+		return err
+	}
+
+	payload := cancelAllOrdersPayload{
+		privateEnvelope:        client.nextPrivateEnvelope(URLPathOrderCancelAll),
+		CancelAllOrdersRequest: request,
+	}
+
+	return client.doPrivateRequest(ctx, credential, URLPathOrderCancelAll, payload, nil)
+}
+
+// GetActiveOrders calls WhiteBIT active orders endpoint and returns one page.
+func (client *Client) GetActiveOrders(
+	ctx context.Context,
+	credential domainauth.Credential,
+	request ActiveOrdersRequest,
+) ([]CollateralOrderResponse, error) {
+	if err := request.validate(); err != nil {
+		return nil, err
+	}
+
+	payload := activeOrdersPayload{
+		privateEnvelope:     client.nextPrivateEnvelope(URLPathActiveOrders),
+		ActiveOrdersRequest: request,
+	}
+
+	var response []CollateralOrderResponse
+	if err := client.doPrivateRequest(ctx, credential, URLPathActiveOrders, payload, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
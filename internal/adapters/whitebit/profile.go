@@ -0,0 +1,41 @@
+package whitebit
+
+import (
+	"context"
+	"errors"
+
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// URLPathWebSocketToken issues tokens for private websocket subscriptions.
+const URLPathWebSocketToken = "/api/v4/profile/websocket_token"
+
+// ErrWebSocketTokenEmpty indicates a websocket token response without token.
+var ErrWebSocketTokenEmpty = errors.New("websocket token is empty")
+
+// WebSocketTokenResponse models websocket token endpoint response.
+type WebSocketTokenResponse struct {
+	WebSocketToken string `json:"websocket_token"`
+}
+
+type webSocketTokenPayload struct {
+	privateEnvelope
+}
+
+// GetWebSocketToken calls WhiteBIT websocket token endpoint.
+// The token authorizes private websocket subscriptions through the `authorize` method.
+func (client *Client) GetWebSocketToken(ctx context.Context, credential domainauth.Credential) (WebSocketTokenResponse, error) {
+	payload := webSocketTokenPayload{
+		privateEnvelope: client.nextPrivateEnvelope(URLPathWebSocketToken),
+	}
+
+	var response WebSocketTokenResponse
+	if err := client.doPrivateRequest(ctx, credential, URLPathWebSocketToken, payload, &response); err != nil {
+		return WebSocketTokenResponse{}, err
+	}
+	if response.WebSocketToken == "" {
+		return WebSocketTokenResponse{}, ErrWebSocketTokenEmpty
+	}
+
+	return response, nil
+}
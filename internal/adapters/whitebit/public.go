@@ -0,0 +1,336 @@
+package whitebit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	URLPathPublicMarkets   = "/api/v4/public/markets"
+	URLPathPublicTicker    = "/api/v4/public/ticker"
+	URLPathPublicOrderBook = "/api/v4/public/orderbook/"
+	URLPathPublicTrades    = "/api/v4/public/trades/"
+	URLPathPublicTime      = "/api/v4/public/time"
+	URLPathPublicKline     = "/api/v4/public/kline"
+)
+
+const (
+	// MaxOrderBookLimit is the documented maximum number of levels per order book side.
+	MaxOrderBookLimit = 100
+	// MaxKlineLimit is the documented maximum number of candles per kline request.
+	MaxKlineLimit = 1440
+)
+
+var (
+	// ErrOrderBookLimitOutOfRange indicates order book depth outside documented range.
+	ErrOrderBookLimitOutOfRange = errors.New("order book limit must be between 1 and 100")
+	// ErrKlineLimitOutOfRange indicates kline page size outside documented range.
+	ErrKlineLimitOutOfRange = errors.New("kline limit must be between 1 and 1440")
+	// ErrKlineIntervalRequired indicates a kline request without interval.
+	ErrKlineIntervalRequired = errors.New("kline interval is required")
+	// ErrKlineResponseInvalid indicates a kline row that does not match documented layout.
+	ErrKlineResponseInvalid = errors.New("invalid kline response")
+)
+
+// PublicClient defines the contract for unauthenticated public WhiteBIT API operations.
+type PublicClient interface {
+	GetMarkets(ctx context.Context) ([]MarketResponse, error)
+	GetTickers(ctx context.Context) (map[string]TickerResponse, error)
+	GetOrderBook(ctx context.Context, request OrderBookRequest) (OrderBookResponse, error)
+	GetRecentTrades(ctx context.Context, request RecentTradesRequest) ([]PublicTradeResponse, error)
+	GetServerTime(ctx context.Context) (ServerTimeResponse, error)
+	GetKlines(ctx context.Context, request KlineRequest) ([]KlineResponse, error)
+}
+
+// MarketResponse models one item of the public market info response.
+// Numeric fields are documented as strings and are kept verbatim.
+type MarketResponse struct {
+	Name          string `json:"name"`
+	Stock         string `json:"stock"`
+	Money         string `json:"money"`
+	StockPrec     string `json:"stockPrec"`
+	MoneyPrec     string `json:"moneyPrec"`
+	FeePrec       string `json:"feePrec"`
+	MakerFee      string `json:"makerFee"`
+	TakerFee      string `json:"takerFee"`
+	MinAmount     string `json:"minAmount"`
+	MinTotal      string `json:"minTotal"`
+	MaxTotal      string `json:"maxTotal"`
+	TradesEnabled bool   `json:"tradesEnabled"`
+	IsCollateral  bool   `json:"isCollateral"`
+	Type          string `json:"type"`
+}
+
+// TickerResponse models one market of the public ticker response.
+type TickerResponse struct {
+	BaseID      int    `json:"base_id"`
+	QuoteID     int    `json:"quote_id"`
+	LastPrice   string `json:"last_price"`
+	QuoteVolume string `json:"quote_volume"`
+	BaseVolume  string `json:"base_volume"`
+	IsFrozen    bool   `json:"isFrozen"`
+	Change      string `json:"change"`
+}
+
+// OrderBookRequest selects market and depth for the public order book endpoint.
+// Zero Limit uses the exchange default.
+type OrderBookRequest struct {
+	Market string
+	Limit  int
+}
+
+// OrderBookResponse models public order book response; levels are [price, amount] pairs, best first.
+type OrderBookResponse struct {
+	TickerID  string      `json:"ticker_id"`
+	Timestamp float64     `json:"timestamp"`
+	Asks      [][2]string `json:"asks"`
+	Bids      [][2]string `json:"bids"`
+}
+
+// RecentTradesRequest selects market and optional taker side for the public trades endpoint.
+type RecentTradesRequest struct {
+	Market string
+	Side   OrderSide
+}
+
+// PublicTradeResponse models one item of the public recent trades response.
+// Type is the taker side.
+type PublicTradeResponse struct {
+	TradeID        int64     `json:"tradeID"`
+	Price          string    `json:"price"`
+	QuoteVolume    string    `json:"quote_volume"`
+	BaseVolume     string    `json:"base_volume"`
+	TradeTimestamp float64   `json:"trade_timestamp"`
+	Type           OrderSide `json:"type"`
+}
+
+// ServerTimeResponse models public server time response in unix seconds.
+type ServerTimeResponse struct {
+	Time int64 `json:"time"`
+}
+
+// KlineRequest selects market, interval and optional time window for the public kline endpoint.
+// Start and End are unix seconds; zero values and zero Limit use exchange defaults.
+type KlineRequest struct {
+	Market   string
+	Interval string
+	Start    int64
+	End      int64
+	Limit    int
+}
+
+// KlineResponse is one decoded candle of the public kline response.
+// Rows are documented as [time, open, close, high, low, stock volume, money volume].
+type KlineResponse struct {
+	Time        int64
+	Open        string
+	Close       string
+	High        string
+	Low         string
+	Volume      string
+	QuoteVolume string
+}
+
+type klineEnvelope struct {
+	Success bool                `json:"success"`
+	Message any                 `json:"message"`
+	Result  [][]json.RawMessage `json:"result"`
+}
+
+// GetMarkets calls GET /api/v4/public/markets.
+func (client *Client) GetMarkets(ctx context.Context) ([]MarketResponse, error) {
+	var response []MarketResponse
+	if err := client.doPublicRequest(ctx, URLPathPublicMarkets, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetTickers calls GET /api/v4/public/ticker and returns tickers keyed by market name.
+func (client *Client) GetTickers(ctx context.Context) (map[string]TickerResponse, error) {
+	response := map[string]TickerResponse{}
+	if err := client.doPublicRequest(ctx, URLPathPublicTicker, nil, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetOrderBook calls GET /api/v4/public/orderbook/{market}.
+func (client *Client) GetOrderBook(ctx context.Context, request OrderBookRequest) (OrderBookResponse, error) {
+	if strings.TrimSpace(request.Market) == "" {
+		return OrderBookResponse{}, ErrMarketRequired
+	}
+	if request.Limit < 0 || request.Limit > MaxOrderBookLimit {
+		return OrderBookResponse{}, ErrOrderBookLimitOutOfRange
+	}
+
+	query := url.Values{}
+	if request.Limit > 0 {
+		query.Set("limit", strconv.Itoa(request.Limit))
+	}
+
+	var response OrderBookResponse
+	if err := client.doPublicRequest(ctx, URLPathPublicOrderBook+url.PathEscape(request.Market), query, &response); err != nil {
+		return OrderBookResponse{}, err
+	}
+
+	return response, nil
+}
+
+// GetRecentTrades calls GET /api/v4/public/trades/{market}.
+func (client *Client) GetRecentTrades(ctx context.Context, request RecentTradesRequest) ([]PublicTradeResponse, error) {
+	if strings.TrimSpace(request.Market) == "" {
+		return nil, ErrMarketRequired
+	}
+	if request.Side != "" && !request.Side.IsValid() {
+		return nil, ErrInvalidOrderSide
+	}
+
+	query := url.Values{}
+	if request.Side != "" {
+		query.Set("type", string(request.Side))
+	}
+
+	var response []PublicTradeResponse
+	if err := client.doPublicRequest(ctx, URLPathPublicTrades+url.PathEscape(request.Market), query, &response); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// GetServerTime calls GET /api/v4/public/time.
+func (client *Client) GetServerTime(ctx context.Context) (ServerTimeResponse, error) {
+	var response ServerTimeResponse
+	if err := client.doPublicRequest(ctx, URLPathPublicTime, nil, &response); err != nil {
+		return ServerTimeResponse{}, err
+	}
+
+	return response, nil
+}
+
+// GetKlines calls GET /api/v4/public/kline.
+func (client *Client) GetKlines(ctx context.Context, request KlineRequest) ([]KlineResponse, error) {
+	if strings.TrimSpace(request.Market) == "" {
+		return nil, ErrMarketRequired
+	}
+	if strings.TrimSpace(request.Interval) == "" {
+		return nil, ErrKlineIntervalRequired
+	}
+	if request.Limit < 0 || request.Limit > MaxKlineLimit {
+		return nil, ErrKlineLimitOutOfRange
+	}
+
+	query := url.Values{}
+	query.Set("market", request.Market)
+	query.Set("interval", request.Interval)
+	if request.Start > 0 {
+		query.Set("start", strconv.FormatInt(request.Start, 10))
+	}
+	if request.End > 0 {
+		query.Set("end", strconv.FormatInt(request.End, 10))
+	}
+	if request.Limit > 0 {
+		query.Set("limit", strconv.Itoa(request.Limit))
+	}
+
+	var envelope klineEnvelope
+	if err := client.doPublicRequest(ctx, URLPathPublicKline, query, &envelope); err != nil {
+		return nil, err
+	}
+
+	klines := make([]KlineResponse, 0, len(envelope.Result))
+	for _, row := range envelope.Result {
+		kline, err := decodeKlineRow(row)
+		if err != nil {
+			return nil, err
+		}
+		klines = append(klines, kline)
+	}
+
+	return klines, nil
+}
+
+func decodeKlineRow(row []json.RawMessage) (KlineResponse, error) {
+	if len(row) < 7 {
+		return KlineResponse{}, fmt.Errorf("%w: expected 7 fields, got %d", ErrKlineResponseInvalid, len(row))
+	}
+
+	fields := make([]string, len(row))
+	for index, raw := range row {
+		var text string
+		if err := json.Unmarshal(raw, &text); err == nil {
+			fields[index] = text
+			continue
+		}
+		var number json.Number
+		if err := json.Unmarshal(raw, &number); err != nil {
+			return KlineResponse{}, fmt.Errorf("%w: field %d: %s", ErrKlineResponseInvalid, index, raw)
+		}
+		fields[index] = number.String()
+	}
+
+	openTime, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return KlineResponse{}, fmt.Errorf("%w: time %q", ErrKlineResponseInvalid, fields[0])
+	}
+
+	return KlineResponse{
+		Time:        openTime,
+		Open:        fields[1],
+		Close:       fields[2],
+		High:        fields[3],
+		Low:         fields[4],
+		Volume:      fields[5],
+		QuoteVolume: fields[6],
+	}, nil
+}
+
+func (client *Client) doPublicRequest(ctx context.Context, path string, query url.Values, responsePayload any) error {
+	endpointURL := client.baseURL + path
+	if len(query) > 0 {
+		endpointURL += "?" + query.Encode()
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpointURL, nil)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	request.Header.Set("Accept", "application/json")
+
+	response, err := client.do(ctx, request, path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, maxResponseBodySize))
+		_ = response.Body.Close()
+	}()
+
+	responseBody, err := readResponseBody(response.Body)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode < http.StatusOK || response.StatusCode > 299 {
+		return mapHTTPStatusError(response.StatusCode, responseBody)
+	}
+
+	if responsePayload == nil || len(responseBody) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(responseBody, responsePayload); err != nil {
+		return fmt.Errorf("decode response body: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package whitebit
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/adapters/clock"
+	"github.com/ChewX3D/crypto/internal/app/ports"
+)
+
+// Endpoint groups sharing one client-side request budget.
+const (
+	RateGroupPublic    = "public"
+	RateGroupTrade     = "trade"
+	RateGroupAccount   = "account"
+	RateGroupWebSocket = "websocket"
+)
+
+const (
+	// defaultRetryAfter blocks a group after 429 responses without a usable Retry-After header.
+	defaultRetryAfter = time.Second
+	// maxRetryAfter bounds server-requested pauses so a bad header cannot stall the CLI.
+	maxRetryAfter = time.Minute
+)
+
+// RateBudget is a token bucket: Burst requests may go at once, refilled at PerSecond requests per second.
+type RateBudget struct {
+	PerSecond float64
+	Burst     int
+}
+
+// DefaultRateBudgets are client-side budgets kept well below WhiteBIT published per-endpoint limits.
+var DefaultRateBudgets = map[string]RateBudget{
+	RateGroupPublic:    {PerSecond: 10, Burst: 20},
+	RateGroupTrade:     {PerSecond: 20, Burst: 20},
+	RateGroupAccount:   {PerSecond: 10, Burst: 10},
+	RateGroupWebSocket: {PerSecond: 1, Burst: 2},
+}
+
+var defaultRateLimiter = sync.OnceValue(func() *RateLimiter {
+	return NewRateLimiter(DefaultRateBudgets, clock.Real{})
+})
+
+// DefaultRateLimiter returns the process-wide limiter shared by all default WhiteBIT clients.
+func DefaultRateLimiter() *RateLimiter {
+	return defaultRateLimiter()
+}
+
+type rateBucket struct {
+	budget       RateBudget
+	tokens       float64
+	updated      time.Time
+	blockedUntil time.Time
+	announced    bool
+}
+
+// RateLimiter delays requests so every endpoint group stays within its RateBudget.
+// Groups without a budget are not limited. It is safe for concurrent use.
+type RateLimiter struct {
+	clock ports.Clock
+	wait  func(ctx context.Context, delay time.Duration) error
+
+	mu      sync.Mutex
+	buckets map[string]*rateBucket
+}
+
+// NewRateLimiter constructs RateLimiter with full buckets.
+func NewRateLimiter(budgets map[string]RateBudget, clock ports.Clock) *RateLimiter {
+	buckets := make(map[string]*rateBucket, len(budgets))
+	for group, budget := range budgets {
+		if budget.PerSecond <= 0 || budget.Burst <= 0 {
+			continue
+		}
+		buckets[group] = &rateBucket{budget: budget, tokens: float64(budget.Burst)}
+	}
+
+	return &RateLimiter{
+		clock:   clock,
+		wait:    waitContext,
+		buckets: buckets,
+	}
+}
+
+// Wait takes one token of group, blocking until it is available, a Retry-After pause ends or ctx is done.
+func (limiter *RateLimiter) Wait(ctx context.Context, group string) error {
+	limiter.mu.Lock()
+	bucket, ok := limiter.buckets[group]
+	if !ok {
+		limiter.mu.Unlock()
+		return nil
+	}
+
+	now := limiter.clock.Now()
+	if !bucket.announced {
+		bucket.announced = true
+		slog.Debug("whitebit rate limit budget", "group", group, "per_second", bucket.budget.PerSecond, "burst", bucket.budget.Burst)
+	}
+	bucket.refill(now)
+
+	// tokens may go negative: each waiter reserves its slot and sleeps for its share of the refill
+	bucket.tokens--
+	var delay time.Duration
+	if bucket.tokens < 0 {
+		delay = time.Duration(-bucket.tokens / bucket.budget.PerSecond * float64(time.Second))
+	}
+	if blocked := bucket.blockedUntil.Sub(now); blocked > delay {
+		delay = blocked
+	}
+	limiter.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	slog.Debug("whitebit rate limit wait", "group", group, "delay", delay)
+	if err := limiter.wait(ctx, delay); err != nil {
+		limiter.mu.Lock()
+		bucket.tokens++
+		limiter.mu.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+// Block pauses group until retryAfter elapses, as requested by a 429 response.
+func (limiter *RateLimiter) Block(group string, retryAfter time.Duration) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	bucket, ok := limiter.buckets[group]
+	if !ok {
+		return
+	}
+
+	until := limiter.clock.Now().Add(retryAfter)
+	if until.After(bucket.blockedUntil) {
+		bucket.blockedUntil = until
+	}
+	slog.Debug("whitebit rate limited by exchange", "group", group, "retry_after", retryAfter)
+}
+
+// Group binds limiter to one endpoint group, for clients that only issue one kind of request.
+func (limiter *RateLimiter) Group(group string) GroupLimiter {
+	return GroupLimiter{limiter: limiter, group: group}
+}
+
+// GroupLimiter waits on one endpoint group of RateLimiter.
+type GroupLimiter struct {
+	limiter *RateLimiter
+	group   string
+}
+
+// Wait takes one token of the bound group.
+func (limiter GroupLimiter) Wait(ctx context.Context) error {
+	return limiter.limiter.Wait(ctx, limiter.group)
+}
+
+func (bucket *rateBucket) refill(now time.Time) {
+	if !bucket.updated.IsZero() && now.After(bucket.updated) {
+		bucket.tokens += now.Sub(bucket.updated).Seconds() * bucket.budget.PerSecond
+		bucket.tokens = min(bucket.tokens, float64(bucket.budget.Burst))
+	}
+	if bucket.updated.IsZero() || now.After(bucket.updated) {
+		bucket.updated = now
+	}
+}
+
+// rateGroupForPath maps a WhiteBIT API path to its endpoint group.
+func rateGroupForPath(path string) string {
+	switch {
+	case strings.HasPrefix(path, "/api/v4/public/"):
+		return RateGroupPublic
+	case strings.HasPrefix(path, "/api/v4/order/"):
+		return RateGroupTrade
+	default:
+		return RateGroupAccount
+	}
+}
+
+// parseRetryAfter reads delay-seconds or HTTP-date Retry-After values, falling back to defaultRetryAfter.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	delay := defaultRetryAfter
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		delay = time.Duration(seconds) * time.Second
+	} else if at, err := http.ParseTime(value); err == nil {
+		delay = at.Sub(now)
+	}
+
+	return min(max(delay, 0), maxRetryAfter)
+}
+
+func waitContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,137 @@
+package whitebit
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+	clock_mock "github.com/ChewX3D/crypto/mocks/clock"
+)
+
+type testRateLimiter struct {
+	*RateLimiter
+	now   time.Time
+	waits []time.Duration
+}
+
+func newTestRateLimiter(t *testing.T, budgets map[string]RateBudget) *testRateLimiter {
+	t.Helper()
+
+	clock := clock_mock.NewMockClock(t)
+	limiter := &testRateLimiter{now: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+	clock.EXPECT().Now().RunAndReturn(func() time.Time { return limiter.now }).Maybe()
+
+	limiter.RateLimiter = NewRateLimiter(budgets, clock)
+	limiter.wait = func(_ context.Context, delay time.Duration) error {
+		limiter.waits = append(limiter.waits, delay)
+		limiter.now = limiter.now.Add(delay)
+		return nil
+	}
+
+	return limiter
+}
+
+func TestRateLimiterAllowsBurstThenPacesByGroup(t *testing.T) {
+	limiter := newTestRateLimiter(t, map[string]RateBudget{
+		RateGroupTrade:  {PerSecond: 2, Burst: 2},
+		RateGroupPublic: {PerSecond: 1, Burst: 1},
+	})
+
+	for range 3 {
+		if err := limiter.Wait(context.Background(), RateGroupTrade); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	if len(limiter.waits) != 1 || limiter.waits[0] != 500*time.Millisecond {
+		t.Fatalf("expected one 500ms wait after the burst, got %v", limiter.waits)
+	}
+
+	if err := limiter.Wait(context.Background(), RateGroupPublic); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := limiter.Wait(context.Background(), "unknown"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(limiter.waits) != 1 {
+		t.Fatalf("expected independent group budgets, got waits %v", limiter.waits)
+	}
+
+	limiter.now = limiter.now.Add(10 * time.Second)
+	for range 2 {
+		_ = limiter.Wait(context.Background(), RateGroupTrade)
+	}
+	if len(limiter.waits) != 1 {
+		t.Fatalf("expected refill to cap at burst without waiting, got %v", limiter.waits)
+	}
+}
+
+func TestRateLimiterReturnsTokenWhenWaitIsCancelled(t *testing.T) {
+	limiter := newTestRateLimiter(t, map[string]RateBudget{RateGroupTrade: {PerSecond: 1, Burst: 1}})
+	_ = limiter.Wait(context.Background(), RateGroupTrade)
+
+	limiter.wait = func(context.Context, time.Duration) error {
+		return context.Canceled
+	}
+	if err := limiter.Wait(context.Background(), RateGroupTrade); err != context.Canceled {
+		t.Fatalf("expected cancellation, got %v", err)
+	}
+	if tokens := limiter.buckets[RateGroupTrade].tokens; tokens != 0 {
+		t.Fatalf("expected cancelled reservation to be released, got %v tokens", tokens)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		value    string
+		expected time.Duration
+	}{
+		{value: "3", expected: 3 * time.Second},
+		{value: now.Add(5 * time.Second).Format(http.TimeFormat), expected: 5 * time.Second},
+		{value: "", expected: defaultRetryAfter},
+		{value: "soon", expected: defaultRetryAfter},
+		{value: "86400", expected: maxRetryAfter},
+	}
+
+	for _, testCase := range testCases {
+		if actual := parseRetryAfter(testCase.value, now); actual != testCase.expected {
+			t.Fatalf("retry-after %q: expected %v, got %v", testCase.value, testCase.expected, actual)
+		}
+	}
+}
+
+func TestClientHonorsRetryAfterOnTooManyRequests(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requests++
+		if requests == 1 {
+			writer.Header().Set("Retry-After", "2")
+			writer.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+		_, _ = writer.Write([]byte(`{"hedgeMode":true}`))
+	}))
+	defer server.Close()
+
+	limiter := newTestRateLimiter(t, DefaultRateBudgets)
+	client := NewRateLimitedClient(server.URL, server.Client(), fixedNonceSource{value: 1}, limiter.RateLimiter)
+	credential := domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")}
+
+	if _, err := client.GetCollateralAccountHedgeMode(context.Background(), credential); err == nil {
+		t.Fatalf("expected rate limit error")
+	}
+	if _, err := client.GetCollateralAccountHedgeMode(context.Background(), credential); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(limiter.waits) != 1 || limiter.waits[0] != 2*time.Second {
+		t.Fatalf("expected Retry-After pause before the second request, got %v", limiter.waits)
+	}
+	_, _ = client.GetMarkets(context.Background())
+	if requests != 3 || len(limiter.waits) != 1 {
+		t.Fatalf("expected public group unaffected, got %v", limiter.waits)
+	}
+}
@@ -0,0 +1,535 @@
+package ws
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultURL is the production WhiteBIT websocket endpoint.
+	DefaultURL = "wss://api.whitebit.com/ws"
+
+	defaultPingInterval = 30 * time.Second
+	defaultMinBackoff   = 500 * time.Millisecond
+	defaultMaxBackoff   = 30 * time.Second
+	defaultEventBuffer  = 256
+
+	// MaxDepthLimit is the documented maximum number of levels per depth subscription.
+	MaxDepthLimit = 100
+)
+
+var (
+	// ErrTokenProviderRequired indicates a private subscription without token provider.
+	ErrTokenProviderRequired = errors.New("private websocket subscriptions require a token provider")
+	// ErrAlreadyRunning indicates a second Run call on the same client.
+	ErrAlreadyRunning = errors.New("websocket client is already running")
+	// ErrInvalidSubscription indicates subscription arguments outside documented ranges.
+	ErrInvalidSubscription = errors.New("invalid websocket subscription")
+)
+
+// TokenProvider issues websocket tokens for the `authorize` method of private subscriptions.
+type TokenProvider interface {
+	WebSocketToken(ctx context.Context) (string, error)
+}
+
+// TokenProviderFunc adapts a function to TokenProvider.
+type TokenProviderFunc func(ctx context.Context) (string, error)
+
+// WebSocketToken calls provider.
+func (provider TokenProviderFunc) WebSocketToken(ctx context.Context) (string, error) {
+	return provider(ctx)
+}
+
+// Limiter delays connection attempts to stay within a shared request budget.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// Config configures Client. Zero values use defaults.
+// A connection that delivers no message for ReadTimeout (default twice PingInterval) is treated as dead.
+// Limiter, when set, is waited on before every dial, including reconnects.
+type Config struct {
+	URL           string
+	TokenProvider TokenProvider
+	Limiter       Limiter
+	TLSConfig     *tls.Config
+	PingInterval  time.Duration
+	ReadTimeout   time.Duration
+	MinBackoff    time.Duration
+	MaxBackoff    time.Duration
+	EventBuffer   int
+}
+
+type subscription struct {
+	method  string
+	private bool
+	markets []string
+	params  func(markets []string) []any
+}
+
+type request struct {
+	ID     int64  `json:"id"`
+	Method string `json:"method"`
+	Params []any  `json:"params"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type envelope struct {
+	ID     *int64          `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+// Client keeps one WhiteBIT websocket connection alive and delivers typed events.
+// Subscriptions are remembered and replayed after every reconnect.
+type Client struct {
+	config  Config
+	events  chan Event
+	running atomic.Bool
+	nextID  atomic.Int64
+
+	// subscribeMu serializes subscription changes with the replay after connect,
+	// so a subscription added mid-replay is neither lost nor sent twice.
+	subscribeMu sync.Mutex
+
+	mu            sync.Mutex
+	subscriptions map[string]*subscription
+	current       *conn
+	currentCtx    context.Context // session context of current; late authorizations honor Run cancellation
+	authorized    bool
+	pending       map[int64]string
+}
+
+// NewClient constructs Client.
+func NewClient(config Config) *Client {
+	if strings.TrimSpace(config.URL) == "" {
+		config.URL = DefaultURL
+	}
+	if config.PingInterval <= 0 {
+		config.PingInterval = defaultPingInterval
+	}
+	if config.ReadTimeout <= 0 {
+		config.ReadTimeout = 2 * config.PingInterval
+	}
+	if config.MinBackoff <= 0 {
+		config.MinBackoff = defaultMinBackoff
+	}
+	if config.MaxBackoff < config.MinBackoff {
+		config.MaxBackoff = max(defaultMaxBackoff, config.MinBackoff)
+	}
+	if config.EventBuffer <= 0 {
+		config.EventBuffer = defaultEventBuffer
+	}
+
+	return &Client{
+		config:        config,
+		events:        make(chan Event, config.EventBuffer),
+		subscriptions: map[string]*subscription{},
+		pending:       map[int64]string{},
+	}
+}
+
+// Events returns the event channel. It is closed when Run returns.
+func (client *Client) Events() <-chan Event {
+	return client.events
+}
+
+// SubscribeLastPrice subscribes to last price updates of markets.
+func (client *Client) SubscribeLastPrice(markets ...string) error {
+	return client.subscribe("lastprice", "lastprice_subscribe", false, markets, marketParams)
+}
+
+// SubscribeTrades subscribes to public trades of markets.
+func (client *Client) SubscribeTrades(markets ...string) error {
+	return client.subscribe("trades", "trades_subscribe", false, markets, marketParams)
+}
+
+// SubscribeDepth subscribes to order book of market with up to limit levels per side.
+// The first update is a full reload; later updates carry changed levels only.
+func (client *Client) SubscribeDepth(market string, limit int) error {
+	if limit < 1 || limit > MaxDepthLimit {
+		return fmt.Errorf("%w: depth limit must be between 1 and %d", ErrInvalidSubscription, MaxDepthLimit)
+	}
+
+	return client.replace("depth:"+strings.ToUpper(strings.TrimSpace(market)), "depth_subscribe", false, []string{market}, func(markets []string) []any {
+		return []any{markets[0], limit, "0", true}
+	})
+}
+
+// SubscribeKline subscribes to candles of market; WhiteBIT keeps one kline subscription per connection.
+func (client *Client) SubscribeKline(market string, interval time.Duration) error {
+	if interval < time.Minute || interval%time.Second != 0 {
+		return fmt.Errorf("%w: kline interval must be whole seconds of at least 1m", ErrInvalidSubscription)
+	}
+	seconds := int64(interval / time.Second)
+
+	return client.replace("kline", "kline_subscribe", false, []string{market}, func(markets []string) []any {
+		return []any{markets[0], seconds}
+	})
+}
+
+// SubscribeOrdersPending subscribes to own active order updates of markets.
+func (client *Client) SubscribeOrdersPending(markets ...string) error {
+	return client.subscribe("ordersPending", "ordersPending_subscribe", true, markets, marketParams)
+}
+
+// SubscribeOrdersExecuted subscribes to own executed orders of markets.
+func (client *Client) SubscribeOrdersExecuted(markets ...string) error {
+	return client.subscribe("ordersExecuted", "ordersExecuted_subscribe", true, markets, func(markets []string) []any {
+		return []any{markets, 0}
+	})
+}
+
+// SubscribePositions subscribes to own collateral positions.
+func (client *Client) SubscribePositions() error {
+	return client.replace("positions", "positionsMargin_subscribe", true, nil, func([]string) []any {
+		return []any{}
+	})
+}
+
+// Run connects, authorizes, subscribes and reads until ctx is cancelled, reconnecting with
+// jittered exponential backoff after every failure. It closes Events on return.
+func (client *Client) Run(ctx context.Context) error {
+	if !client.running.CompareAndSwap(false, true) {
+		return ErrAlreadyRunning
+	}
+	defer close(client.events)
+
+	attempt := 0
+	for {
+		connected, err := client.session(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if connected {
+			attempt = 0
+		}
+		attempt++
+
+		delay := client.backoff(attempt)
+		if !client.emit(ctx, StateEvent{Connected: false, Attempt: attempt, RetryIn: delay, Err: err}) {
+			return ctx.Err()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// session runs one connection until it fails; connected reports whether subscriptions were sent.
+func (client *Client) session(ctx context.Context) (bool, error) {
+	if client.config.Limiter != nil {
+		if err := client.config.Limiter.Wait(ctx); err != nil {
+			return false, err
+		}
+	}
+
+	connection, err := dial(ctx, client.config.URL, client.config.TLSConfig)
+	if err != nil {
+		return false, err
+	}
+
+	sessionCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-sessionCtx.Done()
+		_ = connection.close()
+	}()
+
+	client.mu.Lock()
+	client.pending = map[int64]string{}
+	client.mu.Unlock()
+	defer func() {
+		client.mu.Lock()
+		client.current = nil
+		client.currentCtx = nil
+		client.authorized = false
+		client.mu.Unlock()
+	}()
+
+	if err := client.authorizeAndSubscribe(sessionCtx, connection); err != nil {
+		return false, err
+	}
+	if !client.emit(ctx, StateEvent{Connected: true}) {
+		return true, ctx.Err()
+	}
+
+	go client.keepAlive(sessionCtx, connection)
+
+	for {
+		message, err := connection.readMessage(sessionCtx, client.config.ReadTimeout)
+		if err != nil {
+			return true, err
+		}
+		if !client.dispatch(ctx, message) {
+			return true, ctx.Err()
+		}
+	}
+}
+
+func (client *Client) authorizeAndSubscribe(ctx context.Context, connection *conn) error {
+	client.subscribeMu.Lock()
+	defer client.subscribeMu.Unlock()
+
+	client.mu.Lock()
+	keys := make([]string, 0, len(client.subscriptions))
+	needsToken := false
+	for key, item := range client.subscriptions {
+		keys = append(keys, key)
+		needsToken = needsToken || item.private
+	}
+	sort.Strings(keys)
+	requests := make([]request, 0, len(keys))
+	for _, key := range keys {
+		item := client.subscriptions[key]
+		requests = append(requests, request{Method: item.method, Params: item.params(item.markets)})
+	}
+	client.mu.Unlock()
+
+	if needsToken {
+		if err := client.authorize(ctx, connection); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range requests {
+		if err := client.send(connection, item); err != nil {
+			return err
+		}
+	}
+
+	// subscriptions added from now on are sent directly
+	client.mu.Lock()
+	client.current = connection
+	client.currentCtx = ctx
+	client.authorized = needsToken
+	client.mu.Unlock()
+
+	return nil
+}
+
+func (client *Client) authorize(ctx context.Context, connection *conn) error {
+	if client.config.TokenProvider == nil {
+		return ErrTokenProviderRequired
+	}
+	token, err := client.config.TokenProvider.WebSocketToken(ctx)
+	if err != nil {
+		return fmt.Errorf("get websocket token: %w", err)
+	}
+
+	return client.send(connection, request{Method: "authorize", Params: []any{token, "public"}})
+}
+
+// keepAlive sends the application-level `ping` method; its replies keep reads within ReadTimeout.
+func (client *Client) keepAlive(ctx context.Context, connection *conn) {
+	ticker := time.NewTicker(client.config.PingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := client.send(connection, request{Method: "ping", Params: []any{}}); err != nil {
+				_ = connection.abort()
+				return
+			}
+		}
+	}
+}
+
+// dispatch decodes one message and emits its events; it returns false when ctx is done.
+func (client *Client) dispatch(ctx context.Context, message []byte) bool {
+	var decoded envelope
+	if err := json.Unmarshal(message, &decoded); err != nil {
+		return client.emit(ctx, ErrorEvent{Message: fmt.Sprintf("decode message: %v", err)})
+	}
+
+	if decoded.Method == "" {
+		if decoded.ID == nil {
+			return true
+		}
+		client.mu.Lock()
+		method := client.pending[*decoded.ID]
+		delete(client.pending, *decoded.ID)
+		client.mu.Unlock()
+
+		if decoded.Error != nil {
+			return client.emit(ctx, ErrorEvent{Method: method, Code: decoded.Error.Code, Message: decoded.Error.Message})
+		}
+
+		return true
+	}
+
+	events, err := decodeUpdate(decoded.Method, decoded.Params)
+	if err != nil {
+		return client.emit(ctx, ErrorEvent{Method: decoded.Method, Message: err.Error()})
+	}
+	for _, event := range events {
+		if !client.emit(ctx, event) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (client *Client) emit(ctx context.Context, event Event) bool {
+	select {
+	case client.events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (client *Client) send(connection *conn, item request) error {
+	item.ID = client.nextID.Add(1)
+	payload, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("encode %s: %w", item.Method, err)
+	}
+
+	client.mu.Lock()
+	client.pending[item.ID] = item.Method
+	client.mu.Unlock()
+
+	if err := connection.writeText(payload); err != nil {
+		return fmt.Errorf("send %s: %w", item.Method, err)
+	}
+
+	return nil
+}
+
+// subscribe merges markets into the subscription under key and sends it when connected.
+func (client *Client) subscribe(key string, method string, private bool, markets []string, params func([]string) []any) error {
+	if private && client.config.TokenProvider == nil {
+		return ErrTokenProviderRequired
+	}
+	normalized, err := normalizeMarkets(markets)
+	if err != nil {
+		return err
+	}
+
+	client.subscribeMu.Lock()
+	defer client.subscribeMu.Unlock()
+
+	client.mu.Lock()
+	item, ok := client.subscriptions[key]
+	if !ok {
+		item = &subscription{method: method, private: private, params: params}
+		client.subscriptions[key] = item
+	}
+	for _, market := range normalized {
+		if !slices.Contains(item.markets, market) {
+			item.markets = append(item.markets, market)
+		}
+	}
+	client.mu.Unlock()
+
+	return client.sendIfConnected(item)
+}
+
+// replace overwrites the subscription under key; used by channels with one subscription per connection.
+func (client *Client) replace(key string, method string, private bool, markets []string, params func([]string) []any) error {
+	if private && client.config.TokenProvider == nil {
+		return ErrTokenProviderRequired
+	}
+	normalized := []string{}
+	if markets != nil {
+		var err error
+		if normalized, err = normalizeMarkets(markets); err != nil {
+			return err
+		}
+	}
+
+	item := &subscription{method: method, private: private, markets: normalized, params: params}
+	client.subscribeMu.Lock()
+	defer client.subscribeMu.Unlock()
+
+	client.mu.Lock()
+	client.subscriptions[key] = item
+	client.mu.Unlock()
+
+	return client.sendIfConnected(item)
+}
+
+func (client *Client) sendIfConnected(item *subscription) error {
+	client.mu.Lock()
+	connection := client.current
+	ctx := client.currentCtx
+	authorized := client.authorized
+	params := item.params(item.markets)
+	client.mu.Unlock()
+
+	if connection == nil {
+		return nil
+	}
+	if item.private && !authorized {
+		if err := client.authorize(ctx, connection); err != nil {
+			return err
+		}
+		client.mu.Lock()
+		client.authorized = true
+		client.mu.Unlock()
+	}
+
+	return client.send(connection, request{Method: item.method, Params: params})
+}
+
+// backoff returns a random delay between half MinBackoff and MinBackoff*2^(attempt-1), capped at MaxBackoff.
+func (client *Client) backoff(attempt int) time.Duration {
+	ceiling := client.config.MinBackoff
+	for step := 1; step < attempt && ceiling < client.config.MaxBackoff; step++ {
+		ceiling *= 2
+	}
+	ceiling = min(ceiling, client.config.MaxBackoff)
+
+	return client.config.MinBackoff/2 + rand.N(ceiling-client.config.MinBackoff/2+1)
+}
+
+func normalizeMarkets(markets []string) ([]string, error) {
+	normalized := make([]string, 0, len(markets))
+	for _, market := range markets {
+		market = strings.ToUpper(strings.TrimSpace(market))
+		if market == "" {
+			return nil, fmt.Errorf("%w: market is required", ErrInvalidSubscription)
+		}
+		normalized = append(normalized, market)
+	}
+	if len(normalized) == 0 {
+		return nil, fmt.Errorf("%w: market is required", ErrInvalidSubscription)
+	}
+
+	return normalized, nil
+}
+
+func marketParams(markets []string) []any {
+	params := make([]any, 0, len(markets))
+	for _, market := range markets {
+		params = append(params, market)
+	}
+
+	return params
+}
@@ -0,0 +1,286 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+)
+
+type serverConn struct {
+	socket *websocket.Conn
+}
+
+func (connection *serverConn) readRequest(t *testing.T) request {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_, payload, err := connection.socket.Read(ctx)
+	if err != nil {
+		t.Errorf("server read failed: %v", err)
+		return request{}
+	}
+
+	var decoded request
+	if err := json.Unmarshal(payload, &decoded); err != nil {
+		t.Errorf("server decode failed: %v", err)
+	}
+
+	return decoded
+}
+
+func (connection *serverConn) write(t *testing.T, payload string) {
+	t.Helper()
+
+	if err := connection.socket.Write(context.Background(), websocket.MessageText, []byte(payload)); err != nil {
+		t.Errorf("server write failed: %v", err)
+	}
+}
+
+// newTestServer upgrades every request and hands the connection to handle with its 1-based index.
+func newTestServer(t *testing.T, handle func(index int, connection *serverConn)) *httptest.Server {
+	t.Helper()
+
+	var connections atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		socket, err := websocket.Accept(writer, request, nil)
+		if err != nil {
+			t.Errorf("accept failed: %v", err)
+			return
+		}
+		defer socket.CloseNow()
+
+		handle(int(connections.Add(1)), &serverConn{socket: socket})
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func wsURL(server *httptest.Server) string {
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func nextEvent(t *testing.T, events <-chan Event) Event {
+	t.Helper()
+
+	select {
+	case event, ok := <-events:
+		if !ok {
+			t.Fatalf("event channel closed")
+		}
+		return event
+	case <-time.After(3 * time.Second):
+		t.Fatalf("timed out waiting for event")
+	}
+
+	return nil
+}
+
+func TestClientResubscribesAndReauthorizesAfterReconnect(t *testing.T) {
+	var tokens atomic.Int32
+	server := newTestServer(t, func(index int, connection *serverConn) {
+		authorize := connection.readRequest(t)
+		first := connection.readRequest(t)
+		second := connection.readRequest(t)
+		if authorize.Method != "authorize" || authorize.Params[0] != "token" {
+			t.Errorf("connection %d: expected authorize first, got %+v", index, authorize)
+		}
+		if first.Method != "lastprice_subscribe" || second.Method != "ordersExecuted_subscribe" {
+			t.Errorf("connection %d: unexpected subscriptions %s, %s", index, first.Method, second.Method)
+		}
+
+		if index == 1 {
+			connection.write(t, `{"id":null,"method":"lastprice_update","params":["BTC_PERP","60000.5"]}`)
+			return
+		}
+
+		connection.write(t, `{"id":null,"method":"ordersExecuted_update","params":[{"id":42,"market":"BTC_PERP","side":2,"price":"60000","amount":"0.01","deal_stock":"0.01","client_order_id":"run-1"}]}`)
+		connection.write(t, `{"id":`+jsonNumber(second.ID)+`,"result":null,"error":{"code":6,"message":"market not available"}}`)
+		time.Sleep(time.Second)
+	})
+
+	client := NewClient(Config{
+		URL:        wsURL(server),
+		MinBackoff: 10 * time.Millisecond,
+		MaxBackoff: 20 * time.Millisecond,
+		TokenProvider: TokenProviderFunc(func(context.Context) (string, error) {
+			tokens.Add(1)
+			return "token", nil
+		}),
+	})
+	if err := client.SubscribeLastPrice("btc_perp"); err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+	if err := client.SubscribeOrdersExecuted("BTC_PERP"); err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- client.Run(ctx) }()
+
+	if state, ok := nextEvent(t, client.Events()).(StateEvent); !ok || !state.Connected {
+		t.Fatalf("expected connected state, got %+v", state)
+	}
+	if price, ok := nextEvent(t, client.Events()).(LastPriceEvent); !ok || price.Market != "BTC_PERP" || price.Price != "60000.5" {
+		t.Fatalf("unexpected last price event %+v", price)
+	}
+	if state, ok := nextEvent(t, client.Events()).(StateEvent); !ok || state.Connected || state.Attempt != 1 || state.Err == nil {
+		t.Fatalf("expected disconnect state, got %+v", state)
+	}
+	if state, ok := nextEvent(t, client.Events()).(StateEvent); !ok || !state.Connected {
+		t.Fatalf("expected reconnected state, got %+v", state)
+	}
+	order, ok := nextEvent(t, client.Events()).(OrderEvent)
+	if !ok || order.Kind != OrderEventExecuted || order.Order.Side != "buy" || order.Order.ClientOrderID != "run-1" {
+		t.Fatalf("unexpected order event %+v", order)
+	}
+	rejected, ok := nextEvent(t, client.Events()).(ErrorEvent)
+	if !ok || rejected.Method != "ordersExecuted_subscribe" || rejected.Message != "market not available" {
+		t.Fatalf("unexpected error event %+v", rejected)
+	}
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context cancellation, got %v", err)
+	}
+	if tokens.Load() != 2 {
+		t.Fatalf("expected a fresh token per connection, got %d", tokens.Load())
+	}
+}
+
+func TestClientReconnectsWhenPingsGoUnanswered(t *testing.T) {
+	pinged := make(chan struct{}, 1)
+	server := newTestServer(t, func(index int, connection *serverConn) {
+		if index > 1 {
+			time.Sleep(time.Second)
+			return
+		}
+		connection.readRequest(t)
+		if ping := connection.readRequest(t); ping.Method == "ping" {
+			pinged <- struct{}{}
+		}
+		time.Sleep(time.Second)
+	})
+
+	client := NewClient(Config{
+		URL:          wsURL(server),
+		PingInterval: 20 * time.Millisecond,
+		ReadTimeout:  100 * time.Millisecond,
+		MinBackoff:   10 * time.Millisecond,
+	})
+	if err := client.SubscribeTrades("BTC_PERP"); err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = client.Run(ctx) }()
+
+	nextEvent(t, client.Events())
+	state, ok := nextEvent(t, client.Events()).(StateEvent)
+	if !ok || state.Connected || !errors.Is(state.Err, context.DeadlineExceeded) {
+		t.Fatalf("expected read timeout disconnect, got %+v", state)
+	}
+	select {
+	case <-pinged:
+	default:
+		t.Fatalf("expected application ping before timeout")
+	}
+	if state, ok := nextEvent(t, client.Events()).(StateEvent); !ok || !state.Connected {
+		t.Fatalf("expected reconnect, got %+v", state)
+	}
+}
+
+func TestClientCancelsLateAuthorizationWithRunContext(t *testing.T) {
+	server := newTestServer(t, func(index int, connection *serverConn) {
+		connection.readRequest(t)
+		time.Sleep(time.Second)
+	})
+
+	requested := make(chan struct{})
+	client := NewClient(Config{
+		URL: wsURL(server),
+		TokenProvider: TokenProviderFunc(func(ctx context.Context) (string, error) {
+			close(requested)
+			<-ctx.Done()
+			return "", ctx.Err()
+		}),
+	})
+	if err := client.SubscribeTrades("BTC_PERP"); err != nil {
+		t.Fatalf("subscribe failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = client.Run(ctx) }()
+
+	if state, ok := nextEvent(t, client.Events()).(StateEvent); !ok || !state.Connected {
+		t.Fatalf("expected connected state, got %+v", state)
+	}
+
+	subscribed := make(chan error, 1)
+	go func() { subscribed <- client.SubscribePositions() }()
+	<-requested
+	cancel()
+
+	select {
+	case err := <-subscribed:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("expected context cancellation, got %v", err)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatalf("late authorization ignored run cancellation")
+	}
+}
+
+func TestClientRejectsPrivateSubscriptionsWithoutTokenProvider(t *testing.T) {
+	client := NewClient(Config{})
+	if err := client.SubscribePositions(); !errors.Is(err, ErrTokenProviderRequired) {
+		t.Fatalf("expected ErrTokenProviderRequired, got %v", err)
+	}
+	if err := client.SubscribeOrdersPending("BTC_PERP"); !errors.Is(err, ErrTokenProviderRequired) {
+		t.Fatalf("expected ErrTokenProviderRequired, got %v", err)
+	}
+	if len(client.subscriptions) != 0 {
+		t.Fatalf("expected rejected subscriptions not to be stored")
+	}
+}
+
+func TestDecodeUpdateDepthAndKline(t *testing.T) {
+	events, err := decodeUpdate("depth_update", json.RawMessage(`[false,{"timestamp":1700000000.5,"asks":[["60010","0"]],"bids":[["59990","1.5"]]},"BTC_PERP"]`))
+	if err != nil || len(events) != 1 {
+		t.Fatalf("depth decode failed: %v", err)
+	}
+	depth := events[0].(DepthEvent)
+	if depth.FullReload || depth.Market != "BTC_PERP" || depth.Asks[0].Amount != "0" || depth.Bids[0].Price != "59990" {
+		t.Fatalf("unexpected depth event %+v", depth)
+	}
+
+	events, err = decodeUpdate("kline_update", json.RawMessage(`[[1700000100,"60000","60050","60100","59900","12.5","750000","BTC_PERP"]]`))
+	if err != nil || len(events) != 1 {
+		t.Fatalf("kline decode failed: %v", err)
+	}
+	kline := events[0].(KlineEvent)
+	if kline.Market != "BTC_PERP" || kline.Close != "60050" || kline.High != "60100" || kline.OpenTime.Unix() != 1700000100 {
+		t.Fatalf("unexpected kline event %+v", kline)
+	}
+
+	if _, err := decodeUpdate("trades_update", json.RawMessage(`["BTC_PERP"]`)); !errors.Is(err, ErrUpdateInvalid) {
+		t.Fatalf("expected ErrUpdateInvalid, got %v", err)
+	}
+}
+
+func jsonNumber(value int64) string {
+	encoded, _ := json.Marshal(value)
+	return string(encoded)
+}
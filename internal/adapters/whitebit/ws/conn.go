@@ -0,0 +1,69 @@
+package ws
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/coder/websocket"
+)
+
+// maxMessageSize bounds one reassembled message, matching the HTTP response limit.
+const maxMessageSize = 4 * 1024 * 1024
+
+// conn wraps one websocket connection; framing, masking and control frames are handled by the library.
+type conn struct {
+	socket *websocket.Conn
+}
+
+// dial opens a ws:// or wss:// connection and performs the opening handshake.
+func dial(ctx context.Context, rawURL string, tlsConfig *tls.Config) (*conn, error) {
+	options := &websocket.DialOptions{}
+	if tlsConfig != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = tlsConfig.Clone()
+		options.HTTPClient = &http.Client{Transport: transport}
+	}
+
+	socket, response, err := websocket.Dial(ctx, rawURL, options)
+	if response != nil && response.Body != nil {
+		_ = response.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dial websocket: %w", err)
+	}
+	socket.SetReadLimit(maxMessageSize)
+
+	return &conn{socket: socket}, nil
+}
+
+// readMessage returns the next text or binary message; a message that does not arrive
+// within timeout fails with context.DeadlineExceeded and closes the connection.
+func (connection *conn) readMessage(ctx context.Context, timeout time.Duration) ([]byte, error) {
+	readCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	_, message, err := connection.socket.Read(readCtx)
+	if err != nil {
+		return nil, fmt.Errorf("read websocket: %w", err)
+	}
+
+	return message, nil
+}
+
+// writeText sends one text message; it is safe for concurrent use.
+func (connection *conn) writeText(payload []byte) error {
+	return connection.socket.Write(context.Background(), websocket.MessageText, payload)
+}
+
+// close sends a normal closure frame and closes the socket.
+func (connection *conn) close() error {
+	return connection.socket.Close(websocket.StatusNormalClosure, "")
+}
+
+// abort closes the socket without the closing handshake.
+func (connection *conn) abort() error {
+	return connection.socket.CloseNow()
+}
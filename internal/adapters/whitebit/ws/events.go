@@ -0,0 +1,388 @@
+package ws
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	whitebit_adapters_common "github.com/ChewX3D/crypto/internal/adapters/whitebit/adapters"
+)
+
+// ErrUpdateInvalid indicates an update payload that does not match the documented layout.
+var ErrUpdateInvalid = errors.New("invalid websocket update")
+
+// Event is one item of the client event channel. Use a type switch on the concrete event types.
+type Event interface {
+	event()
+}
+
+// StateEvent reports connection state changes. After a disconnect, RetryIn is the backoff before the next attempt.
+type StateEvent struct {
+	Connected bool
+	Attempt   int
+	RetryIn   time.Duration
+	Err       error
+}
+
+// ErrorEvent reports a request rejected by the server or an update that failed to decode.
+type ErrorEvent struct {
+	Method  string
+	Code    int
+	Message string
+}
+
+// LastPriceEvent is one `lastprice_update`.
+type LastPriceEvent struct {
+	Market string
+	Price  string
+}
+
+// Trade is one public trade; Side is the taker side.
+type Trade struct {
+	ID     int64
+	Time   time.Time
+	Price  string
+	Amount string
+	Side   string
+}
+
+// TradesEvent is one `trades_update`.
+type TradesEvent struct {
+	Market string
+	Trades []Trade
+}
+
+// Level is one order book level; zero Amount in an incremental update removes the level.
+type Level struct {
+	Price  string
+	Amount string
+}
+
+// DepthEvent is one `depth_update`. FullReload replaces the book; otherwise levels are deltas.
+type DepthEvent struct {
+	Market     string
+	FullReload bool
+	Timestamp  time.Time
+	Asks       []Level
+	Bids       []Level
+}
+
+// KlineEvent is one candle of a `kline_update`; the last candle of a market is still forming.
+type KlineEvent struct {
+	Market      string
+	OpenTime    time.Time
+	Open        string
+	Close       string
+	High        string
+	Low         string
+	Volume      string
+	QuoteVolume string
+}
+
+// Order is an own order from private order channels.
+type Order struct {
+	ID            int64
+	ClientOrderID string
+	Market        string
+	Side          string
+	Type          int
+	Price         string
+	Amount        string
+	Left          string
+	DealStock     string
+	DealMoney     string
+	DealFee       string
+	PostOnly      bool
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Order event kinds.
+const (
+	OrderEventNew      = "new"
+	OrderEventUpdate   = "update"
+	OrderEventFinish   = "finish"
+	OrderEventExecuted = "executed"
+)
+
+// OrderEvent is one `ordersPending_update` (new, update or finish) or `ordersExecuted_update` (executed).
+type OrderEvent struct {
+	Kind  string
+	Order Order
+}
+
+// Position is an own collateral position from the positions channel.
+type Position struct {
+	PositionID       int64
+	Market           string
+	PositionSide     string
+	Amount           string
+	BasePrice        string
+	LiquidationPrice string
+	PnL              string
+}
+
+// PositionsEvent is one `positionsMargin_update` with the current open positions.
+type PositionsEvent struct {
+	Positions []Position
+}
+
+func (StateEvent) event()     {}
+func (ErrorEvent) event()     {}
+func (LastPriceEvent) event() {}
+func (TradesEvent) event()    {}
+func (DepthEvent) event()     {}
+func (KlineEvent) event()     {}
+func (OrderEvent) event()     {}
+func (PositionsEvent) event() {}
+
+type wsTrade struct {
+	ID     int64   `json:"id"`
+	Time   float64 `json:"time"`
+	Price  string  `json:"price"`
+	Amount string  `json:"amount"`
+	Type   string  `json:"type"`
+}
+
+type wsDepth struct {
+	Timestamp float64     `json:"timestamp"`
+	Asks      [][2]string `json:"asks"`
+	Bids      [][2]string `json:"bids"`
+}
+
+type wsOrder struct {
+	ID            int64   `json:"id"`
+	ClientOrderID string  `json:"client_order_id"`
+	Market        string  `json:"market"`
+	Side          int     `json:"side"`
+	Type          int     `json:"type"`
+	Price         string  `json:"price"`
+	Amount        string  `json:"amount"`
+	Left          string  `json:"left"`
+	DealStock     string  `json:"deal_stock"`
+	DealMoney     string  `json:"deal_money"`
+	DealFee       string  `json:"deal_fee"`
+	PostOnly      bool    `json:"post_only"`
+	CTime         float64 `json:"ctime"`
+	MTime         float64 `json:"mtime"`
+}
+
+type wsPosition struct {
+	PositionID       int64  `json:"positionId"`
+	Market           string `json:"market"`
+	PositionSide     string `json:"positionSide"`
+	Amount           string `json:"amount"`
+	BasePrice        string `json:"basePrice"`
+	LiquidationPrice string `json:"liquidationPrice"`
+	PnL              string `json:"pnl"`
+}
+
+// decodeUpdate converts one server push into events; unknown methods return no events.
+func decodeUpdate(method string, params json.RawMessage) ([]Event, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(params, &raw); err != nil {
+		return nil, fmt.Errorf("%w: %s params: %v", ErrUpdateInvalid, method, err)
+	}
+
+	switch method {
+	case "lastprice_update":
+		var market, price string
+		if err := unmarshalParams(raw, &market, &price); err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrUpdateInvalid, method, err)
+		}
+
+		return []Event{LastPriceEvent{Market: market, Price: price}}, nil
+	case "trades_update":
+		var market string
+		var trades []wsTrade
+		if err := unmarshalParams(raw, &market, &trades); err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrUpdateInvalid, method, err)
+		}
+
+		event := TradesEvent{Market: market, Trades: make([]Trade, 0, len(trades))}
+		for _, trade := range trades {
+			event.Trades = append(event.Trades, Trade{
+				ID:     trade.ID,
+				Time:   whitebit_adapters_common.UnixSecondsToTime(trade.Time),
+				Price:  trade.Price,
+				Amount: trade.Amount,
+				Side:   trade.Type,
+			})
+		}
+
+		return []Event{event}, nil
+	case "depth_update":
+		var fullReload bool
+		var depth wsDepth
+		var market string
+		if err := unmarshalParams(raw, &fullReload, &depth, &market); err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrUpdateInvalid, method, err)
+		}
+
+		return []Event{DepthEvent{
+			Market:     market,
+			FullReload: fullReload,
+			Timestamp:  whitebit_adapters_common.UnixSecondsToTime(depth.Timestamp),
+			Asks:       toLevels(depth.Asks),
+			Bids:       toLevels(depth.Bids),
+		}}, nil
+	case "kline_update":
+		events := make([]Event, 0, len(raw))
+		for _, row := range raw {
+			event, err := decodeKlineRow(row)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %s: %v", ErrUpdateInvalid, method, err)
+			}
+			events = append(events, event)
+		}
+
+		return events, nil
+	case "ordersPending_update":
+		var eventID int
+		var order wsOrder
+		if err := unmarshalParams(raw, &eventID, &order); err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrUpdateInvalid, method, err)
+		}
+		kind := OrderEventUpdate
+		switch eventID {
+		case 1:
+			kind = OrderEventNew
+		case 3:
+			kind = OrderEventFinish
+		}
+
+		return []Event{OrderEvent{Kind: kind, Order: toOrder(order)}}, nil
+	case "ordersExecuted_update":
+		var order wsOrder
+		if err := unmarshalParams(raw, &order); err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrUpdateInvalid, method, err)
+		}
+
+		return []Event{OrderEvent{Kind: OrderEventExecuted, Order: toOrder(order)}}, nil
+	case "positionsMargin_update":
+		positions, err := decodePositions(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrUpdateInvalid, method, err)
+		}
+
+		return []Event{PositionsEvent{Positions: positions}}, nil
+	}
+
+	return nil, nil
+}
+
+// unmarshalParams decodes positional params into targets; extra params are ignored.
+func unmarshalParams(raw []json.RawMessage, targets ...any) error {
+	if len(raw) < len(targets) {
+		return fmt.Errorf("expected %d params, got %d", len(targets), len(raw))
+	}
+	for index, target := range targets {
+		if err := json.Unmarshal(raw[index], target); err != nil {
+			return fmt.Errorf("param %d: %w", index, err)
+		}
+	}
+
+	return nil
+}
+
+// decodeKlineRow decodes [time, open, close, high, low, volume, deal, market].
+func decodeKlineRow(row json.RawMessage) (KlineEvent, error) {
+	var fields []json.RawMessage
+	if err := json.Unmarshal(row, &fields); err != nil {
+		return KlineEvent{}, err
+	}
+	if len(fields) < 8 {
+		return KlineEvent{}, fmt.Errorf("expected 8 kline fields, got %d", len(fields))
+	}
+
+	var openTime json.Number
+	if err := json.Unmarshal(fields[0], &openTime); err != nil {
+		return KlineEvent{}, fmt.Errorf("kline time: %w", err)
+	}
+	seconds, err := strconv.ParseInt(openTime.String(), 10, 64)
+	if err != nil {
+		return KlineEvent{}, fmt.Errorf("kline time: %w", err)
+	}
+
+	values := make([]string, 7)
+	for index := 1; index < 8; index++ {
+		if err := json.Unmarshal(fields[index], &values[index-1]); err != nil {
+			return KlineEvent{}, fmt.Errorf("kline field %d: %w", index, err)
+		}
+	}
+
+	return KlineEvent{
+		OpenTime:    time.Unix(seconds, 0).UTC(),
+		Open:        values[0],
+		Close:       values[1],
+		High:        values[2],
+		Low:         values[3],
+		Volume:      values[4],
+		QuoteVolume: values[5],
+		Market:      values[6],
+	}, nil
+}
+
+// decodePositions accepts a bare position list or an object with `records`.
+func decodePositions(raw []json.RawMessage) ([]Position, error) {
+	if len(raw) == 0 {
+		return []Position{}, nil
+	}
+
+	var records []wsPosition
+	if err := json.Unmarshal(raw[0], &records); err != nil {
+		var wrapped struct {
+			Records []wsPosition `json:"records"`
+		}
+		if err := json.Unmarshal(raw[0], &wrapped); err != nil {
+			return nil, err
+		}
+		records = wrapped.Records
+	}
+
+	positions := make([]Position, 0, len(records))
+	for _, record := range records {
+		positions = append(positions, Position(record))
+	}
+
+	return positions, nil
+}
+
+func toLevels(levels [][2]string) []Level {
+	converted := make([]Level, 0, len(levels))
+	for _, level := range levels {
+		converted = append(converted, Level{Price: level[0], Amount: level[1]})
+	}
+
+	return converted
+}
+
+// toOrder maps websocket order; websocket sides are numeric: 1 sell, 2 buy.
+func toOrder(order wsOrder) Order {
+	side := ""
+	switch order.Side {
+	case 1:
+		side = "sell"
+	case 2:
+		side = "buy"
+	}
+
+	return Order{
+		ID:            order.ID,
+		ClientOrderID: order.ClientOrderID,
+		Market:        order.Market,
+		Side:          side,
+		Type:          order.Type,
+		Price:         order.Price,
+		Amount:        order.Amount,
+		Left:          order.Left,
+		DealStock:     order.DealStock,
+		DealMoney:     order.DealMoney,
+		DealFee:       order.DealFee,
+		PostOnly:      order.PostOnly,
+		CreatedAt:     whitebit_adapters_common.UnixSecondsToTime(order.CTime),
+		UpdatedAt:     whitebit_adapters_common.UnixSecondsToTime(order.MTime),
+	}
+}
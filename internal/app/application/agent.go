@@ -0,0 +1,29 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/adapters/clock"
+	"github.com/ChewX3D/crypto/internal/adapters/credentialagent"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+const (
+	// CredentialAgentServeCommand is the hidden auth subcommand that runs the credential agent process.
+	CredentialAgentServeCommand = credentialagent.ServeCommand
+	// CredentialAgentReadyLine is what the agent process prints once it is ready.
+	CredentialAgentReadyLine = credentialagent.ReadyLine
+)
+
+// ServeCredentialAgent runs the credential agent in the current process until its TTL elapses or it is locked.
+// ready is called once the socket accepts connections.
+func ServeCredentialAgent(
+	ctx context.Context,
+	socketPath string,
+	credential domainauth.Credential,
+	ttl time.Duration,
+	ready func() error,
+) error {
+	return credentialagent.Serve(ctx, socketPath, credential, ttl, clock.Real{}, ready)
+}
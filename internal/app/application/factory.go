@@ -6,11 +6,18 @@ import (
 
 	"github.com/ChewX3D/crypto/internal/adapters/clock"
 	"github.com/ChewX3D/crypto/internal/adapters/configstore"
+	"github.com/ChewX3D/crypto/internal/adapters/credentialagent"
 	"github.com/ChewX3D/crypto/internal/adapters/secretstore"
+	"github.com/ChewX3D/crypto/internal/adapters/whitebit"
 	"github.com/ChewX3D/crypto/internal/adapters/whitebit/adapters/collaterlal"
 	whitebit_credentials_adapters "github.com/ChewX3D/crypto/internal/adapters/whitebit/adapters/credentials"
+	whitebit_markets_adapters "github.com/ChewX3D/crypto/internal/adapters/whitebit/adapters/markets"
+	"github.com/ChewX3D/crypto/internal/adapters/whitebit/ws"
+	"github.com/ChewX3D/crypto/internal/app/ports"
 	authservice "github.com/ChewX3D/crypto/internal/app/services/auth"
 	collateralservice "github.com/ChewX3D/crypto/internal/app/services/collateral"
+	marketservice "github.com/ChewX3D/crypto/internal/app/services/market"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
 )
 
 // AuthUseCases defines auth operations exposed to command adapters.
@@ -18,27 +25,83 @@ type AuthUseCases interface {
 	Login(ctx context.Context, request authservice.LoginRequest) (authservice.LoginResult, error)
 	Logout(ctx context.Context) (authservice.LogoutResult, error)
 	Status(ctx context.Context) (authservice.StatusResult, error)
+	Rotate(ctx context.Context, request authservice.RotateRequest) (authservice.RotateResult, error)
+	Unlock(ctx context.Context, request authservice.UnlockRequest) (authservice.UnlockResult, error)
+	Lock(ctx context.Context) (authservice.LockResult, error)
+	Use(ctx context.Context, request authservice.UseRequest) (authservice.UseResult, error)
+	CheckPermissions(ctx context.Context, request authservice.CheckPermissionsRequest) (authservice.CheckPermissionsResult, error)
 }
 
 // CollateralUseCases defines collateral operations exposed to command adapters.
 type CollateralUseCases interface {
 	PlaceOrder(ctx context.Context, request collateralservice.PlaceOrderRequest) (collateralservice.PlaceOrderResult, error)
+	PlanRange(ctx context.Context, request collateralservice.RangePlanRequest) (collateralservice.RangePlanResult, error)
+	SubmitRange(ctx context.Context, request collateralservice.RangeSubmitRequest) (collateralservice.RangePlanResult, error)
+	CancelOrders(ctx context.Context, request collateralservice.CancelOrderRequest) (collateralservice.PlaceOrderResult, error)
+	ListOrders(ctx context.Context, request collateralservice.ListOrdersRequest) (collateralservice.ListOrdersResult, error)
+	OrderHistory(ctx context.Context, request collateralservice.HistoryRequest) (collateralservice.OrderHistoryResult, error)
+	Trades(ctx context.Context, request collateralservice.HistoryRequest) (collateralservice.TradesResult, error)
+	Positions(ctx context.Context, request collateralservice.PositionsRequest) (collateralservice.PositionsResult, error)
+	Account(ctx context.Context) (collateralservice.AccountResult, error)
+	GetLeverage(ctx context.Context) (collateralservice.LeverageResult, error)
+	SetLeverage(ctx context.Context, leverage int) (collateralservice.LeverageResult, error)
+	GetHedgeMode(ctx context.Context) (collateralservice.HedgeModeResult, error)
+	SetHedgeMode(ctx context.Context, enabled bool) (collateralservice.HedgeModeResult, error)
+	ClosePosition(ctx context.Context, request collateralservice.ClosePositionRequest) (collateralservice.ClosePositionResult, error)
+}
+
+// MarketUseCases defines public market data operations exposed to command adapters.
+type MarketUseCases interface {
+	Ticker(ctx context.Context, request marketservice.TickerRequest) (marketservice.TickerResult, error)
+	Depth(ctx context.Context, request marketservice.DepthRequest) (marketservice.DepthResult, error)
+	Trades(ctx context.Context, request marketservice.TradesRequest) (marketservice.TradesResult, error)
+	Klines(ctx context.Context, request marketservice.KlinesRequest) (marketservice.KlinesResult, error)
+	Watch(ctx context.Context, request marketservice.WatchRequest, emit func(marketservice.WatchEvent) error) error
 }
 
 // Application holds use-case interfaces used by CLI command adapters.
 type Application struct {
 	Auth       AuthUseCases
 	Collateral CollateralUseCases
+	Market     MarketUseCases
 }
 
 type authUseCases struct {
 	login  *authservice.LoginService
 	logout *authservice.LogoutService
 	status *authservice.StatusService
+	rotate *authservice.RotateService
+	unlock *authservice.UnlockService
+	lock   *authservice.LockService
+	use    *authservice.UseService
+	check  *authservice.CheckPermissionsService
 }
 
 type collateralUseCases struct {
-	placeOrder *collateralservice.PlaceOrderService
+	placeOrder  *collateralservice.PlaceOrderService
+	planRange   *collateralservice.RangePlanService
+	submitRange *collateralservice.RangeSubmitService
+	cancelOrder *collateralservice.CancelOrderService
+	listOrders  *collateralservice.ListOrdersService
+	history     *collateralservice.HistoryService
+	account     *collateralservice.AccountService
+	settings    *collateralservice.AccountSettingsService
+	closeLeg    *collateralservice.ClosePositionService
+}
+
+type marketUseCases struct {
+	data   *marketservice.DataService
+	klines *marketservice.KlineService
+	watch  *marketservice.WatchService
+}
+
+// NewMarketUseCases constructs market use-cases from concrete market services.
+func NewMarketUseCases(
+	data *marketservice.DataService,
+	klines *marketservice.KlineService,
+	watch *marketservice.WatchService,
+) MarketUseCases {
+	return &marketUseCases{data: data, klines: klines, watch: watch}
 }
 
 // New constructs application container from prepared use-case interfaces.
@@ -59,11 +122,21 @@ func NewWithAuthServices(
 	login *authservice.LoginService,
 	logout *authservice.LogoutService,
 	status *authservice.StatusService,
+	rotate *authservice.RotateService,
+	unlock *authservice.UnlockService,
+	lock *authservice.LockService,
+	use *authservice.UseService,
+	check *authservice.CheckPermissionsService,
 ) *Application {
 	return NewWithUseCases(&authUseCases{
 		login:  login,
 		logout: logout,
 		status: status,
+		rotate: rotate,
+		unlock: unlock,
+		lock:   lock,
+		use:    use,
+		check:  check,
 	}, nil)
 }
 
@@ -72,35 +145,229 @@ func NewWithServices(
 	login *authservice.LoginService,
 	logout *authservice.LogoutService,
 	status *authservice.StatusService,
+	rotate *authservice.RotateService,
+	unlock *authservice.UnlockService,
+	lock *authservice.LockService,
+	use *authservice.UseService,
+	check *authservice.CheckPermissionsService,
 	placeOrder *collateralservice.PlaceOrderService,
+	planRange *collateralservice.RangePlanService,
+	submitRange *collateralservice.RangeSubmitService,
+	cancelOrder *collateralservice.CancelOrderService,
+	listOrders *collateralservice.ListOrdersService,
+	history *collateralservice.HistoryService,
+	account *collateralservice.AccountService,
+	settings *collateralservice.AccountSettingsService,
+	closeLeg *collateralservice.ClosePositionService,
 ) *Application {
 	return NewWithUseCases(&authUseCases{
 		login:  login,
 		logout: logout,
 		status: status,
+		rotate: rotate,
+		unlock: unlock,
+		lock:   lock,
+		use:    use,
+		check:  check,
 	}, &collateralUseCases{
-		placeOrder: placeOrder,
+		placeOrder:  placeOrder,
+		planRange:   planRange,
+		submitRange: submitRange,
+		cancelOrder: cancelOrder,
+		listOrders:  listOrders,
+		history:     history,
+		account:     account,
+		settings:    settings,
+		closeLeg:    closeLeg,
 	})
 }
 
 // NewDefault wires adapters and services for production runtime.
 func NewDefault() (*Application, error) {
-	sessionStore, err := configstore.NewDefaultSessionStore()
+	return NewDefaultWithOptions(Options{})
+}
+
+// NewDefaultWithOptions wires adapters and services for production runtime with run options.
+func NewDefaultWithOptions(options Options) (*Application, error) {
+	realClock := clock.Real{}
+	stores, err := newCredentialStores(options, realClock)
 	if err != nil {
-		return nil, fmt.Errorf("init session store: %w", err)
+		return nil, err
 	}
+	credentialStore, sessionStore := stores.credential, stores.session
 
-	credentialStore := secretstore.NewOSKeychainStore()
-	credentialVerifier := whitebit_credentials_adapters.NewDefaultCredentialVerifierAdapter()
-	collateralOrderExecutor := whitebit_collateral_adapters.NewDefaultCollateralOrderExecutorAdapter()
-	realClock := clock.Real{}
+	// external credential sources draw nonces in process instead of from ~/.wbcli/nonce
+	client := whitebit.NewDefaultClient()
+	if stores.external {
+		client = whitebit.NewDefaultClientWithNonceSource(&whitebit.MonotonicUnixMilliNonceSource{})
+	}
+
+	credentialVerifier := whitebit_credentials_adapters.NewCredentialVerifierAdapter(client)
+	collateralOrderManager := whitebit_collateral_adapters.NewCollateralOrderManagerAdapter(client)
+	collateralHistoryReader := whitebit_collateral_adapters.NewCollateralHistoryReaderAdapter(client)
+	collateralAccountReader := whitebit_collateral_adapters.NewCollateralAccountReaderAdapter(client)
+	collateralAccountSettings := whitebit_collateral_adapters.NewCollateralAccountSettingsAdapter(client)
+	collateralOrderExecutor := collateralservice.NewRetryingOrderExecutor(
+		whitebit_collateral_adapters.NewCollateralOrderExecutorAdapter(client),
+		collateralOrderManager,
+		collateralHistoryReader,
+		realClock,
+		collateralservice.DefaultRetryPolicy,
+	)
+	marketInfo, klineCache, err := newMarketCaches(stores.external, whitebit_markets_adapters.NewMarketInfoAdapter(client), realClock)
+	if err != nil {
+		return nil, err
+	}
 
-	return NewWithServices(
-		authservice.NewLoginService(credentialStore, sessionStore, realClock, credentialVerifier),
+	application := NewWithServices(
+		authservice.NewLoginService(credentialStore, stores.backends, sessionStore, realClock, credentialVerifier),
 		authservice.NewLogoutService(credentialStore, sessionStore),
-		authservice.NewStatusService(sessionStore),
-		collateralservice.NewPlaceOrderService(credentialStore, sessionStore, collateralOrderExecutor, realClock),
-	), nil
+		authservice.NewStatusService(sessionStore, stores.account),
+		authservice.NewRotateService(credentialStore, sessionStore, realClock, credentialVerifier, collateralAccountReader),
+		authservice.NewUnlockService(credentialStore, stores.agent),
+		authservice.NewLockService(stores.agent),
+		authservice.NewUseService(stores.accounts),
+		authservice.NewCheckPermissionsService(credentialStore, credentialVerifier),
+		collateralservice.NewPlaceOrderService(credentialStore, sessionStore, collateralOrderExecutor, marketInfo, realClock),
+		collateralservice.NewRangePlanService(sessionStore, marketInfo, realClock),
+		collateralservice.NewRangeSubmitService(credentialStore, sessionStore, collateralOrderExecutor, marketInfo, realClock),
+		collateralservice.NewCancelOrderService(credentialStore, collateralOrderManager, realClock),
+		collateralservice.NewListOrdersService(credentialStore, collateralOrderManager),
+		collateralservice.NewHistoryService(credentialStore, collateralHistoryReader),
+		collateralservice.NewAccountService(credentialStore, sessionStore, collateralOrderExecutor, collateralAccountReader, realClock),
+		collateralservice.NewAccountSettingsService(
+			credentialStore,
+			sessionStore,
+			collateralOrderExecutor,
+			collateralAccountReader,
+			collateralAccountSettings,
+			realClock,
+		),
+		collateralservice.NewClosePositionService(
+			credentialStore,
+			sessionStore,
+			collateralOrderExecutor,
+			collateralAccountReader,
+			marketInfo,
+			realClock,
+		),
+	)
+	marketData := whitebit_markets_adapters.NewMarketDataAdapter(client)
+	marketStream := whitebit_markets_adapters.NewMarketStreamAdapter(client, ws.Config{
+		Limiter: whitebit.DefaultRateLimiter().Group(whitebit.RateGroupWebSocket),
+	})
+	application.Market = NewMarketUseCases(
+		marketservice.NewDataService(marketData),
+		marketservice.NewKlineService(marketData, klineCache, realClock),
+		marketservice.NewWatchService(credentialStore, marketStream, realClock),
+	)
+
+	return application, nil
+}
+
+// newMarketCaches wraps source in the market rules cache and opens the kline cache; both live in
+// ~/.wbcli unless external is set, in which case they are kept in memory for the run.
+func newMarketCaches(
+	external bool,
+	source ports.MarketInfoProvider,
+	clock ports.Clock,
+) (ports.MarketInfoProvider, ports.KlineCache, error) {
+	if external {
+		return configstore.NewMemoryMarketInfoCache(source), configstore.NewMemoryKlineCache(), nil
+	}
+
+	marketInfo, err := configstore.NewDefaultMarketInfoCache(source, clock)
+	if err != nil {
+		return nil, nil, fmt.Errorf("init market info cache: %w", err)
+	}
+	klineCache, err := configstore.NewDefaultKlineCache()
+	if err != nil {
+		return nil, nil, fmt.Errorf("init kline cache: %w", err)
+	}
+
+	return marketInfo, klineCache, nil
+}
+
+// credentialStores groups the credential wiring selected by --credentials-from and --account.
+type credentialStores struct {
+	// external marks --credentials-from runs, which keep every piece of local state in memory
+	external   bool
+	account    string
+	credential ports.CredentialStore
+	backends   ports.CredentialBackendSelector
+	session    ports.SessionStore
+	accounts   ports.AccountRegistry
+	agent      ports.CredentialAgent
+}
+
+// newCredentialStores selects credential and session stores. External sources keep session state in memory,
+// and NewDefaultWithOptions keeps their nonces and market caches in memory too, so such runs never read or write ~/.wbcli.
+// Stored credentials come from the account slot named by --account or selected with auth use,
+// and are served from that account's unlocked credential agent when one is running.
+func newCredentialStores(options Options, clock ports.Clock) (credentialStores, error) {
+	switch options.Credentials.Kind {
+	case CredentialSourceEnv:
+		return newExternalCredentialStores(secretstore.NewEnvCredentialStore())
+	case CredentialSourceFD:
+		return newExternalCredentialStores(secretstore.NewFDCredentialStore(options.Credentials.FD))
+	case CredentialSourceStore:
+	default:
+		return credentialStores{}, fmt.Errorf("unsupported credential source %q", options.Credentials.Kind)
+	}
+
+	configStore, err := configstore.NewDefaultSessionStore()
+	if err != nil {
+		return credentialStores{}, fmt.Errorf("init session store: %w", err)
+	}
+	account := options.Account
+	if account == "" {
+		account, err = configStore.ActiveAccount(context.Background())
+		if err != nil {
+			return credentialStores{}, fmt.Errorf("read active account: %w", err)
+		}
+	}
+	if err := domainauth.ValidateAccountName(account); err != nil {
+		return credentialStores{}, fmt.Errorf("active account %q: %w", account, err)
+	}
+
+	sessionStore := configStore.ForAccount(account)
+	encryptedFileStore, err := secretstore.NewDefaultEncryptedFileStore(secretstore.PassphraseFromEnv(), clock)
+	if err != nil {
+		return credentialStores{}, fmt.Errorf("init encrypted credential store: %w", err)
+	}
+	backendStore := secretstore.NewSessionBackendStore(
+		sessionStore,
+		secretstore.NewOSKeychainStore().ForAccount(account),
+		encryptedFileStore.ForAccount(account),
+	)
+	agentClient, err := credentialagent.NewDefaultClient(account)
+	if err != nil {
+		return credentialStores{}, fmt.Errorf("init credential agent client: %w", err)
+	}
+
+	return credentialStores{
+		account:    account,
+		credential: credentialagent.NewAgentCredentialStore(backendStore, sessionStore, agentClient),
+		backends:   backendStore,
+		session:    sessionStore,
+		accounts:   configStore,
+		agent:      agentClient,
+	}, nil
+}
+
+// newExternalCredentialStores wires a read-only credential source; auth lock still reaches the default agent.
+func newExternalCredentialStores(credentialStore ports.CredentialStore) (credentialStores, error) {
+	agentClient, err := credentialagent.NewDefaultClient(domainauth.DefaultAccount)
+	if err != nil {
+		return credentialStores{}, fmt.Errorf("init credential agent client: %w", err)
+	}
+
+	return credentialStores{
+		external:   true,
+		credential: credentialStore,
+		session:    configstore.NewMemorySessionStore(),
+		agent:      agentClient,
+	}, nil
 }
 
 func (useCases *authUseCases) Login(ctx context.Context, request authservice.LoginRequest) (authservice.LoginResult, error) {
@@ -115,9 +382,132 @@ func (useCases *authUseCases) Status(ctx context.Context) (authservice.StatusRes
 	return useCases.status.Execute(ctx)
 }
 
+func (useCases *authUseCases) Rotate(ctx context.Context, request authservice.RotateRequest) (authservice.RotateResult, error) {
+	return useCases.rotate.Execute(ctx, request)
+}
+
+func (useCases *authUseCases) Unlock(ctx context.Context, request authservice.UnlockRequest) (authservice.UnlockResult, error) {
+	return useCases.unlock.Execute(ctx, request)
+}
+
+func (useCases *authUseCases) Lock(ctx context.Context) (authservice.LockResult, error) {
+	return useCases.lock.Execute(ctx)
+}
+
+func (useCases *authUseCases) Use(ctx context.Context, request authservice.UseRequest) (authservice.UseResult, error) {
+	return useCases.use.Execute(ctx, request)
+}
+
+func (useCases *authUseCases) CheckPermissions(
+	ctx context.Context,
+	request authservice.CheckPermissionsRequest,
+) (authservice.CheckPermissionsResult, error) {
+	return useCases.check.Execute(ctx, request)
+}
+
 func (useCases *collateralUseCases) PlaceOrder(
 	ctx context.Context,
 	request collateralservice.PlaceOrderRequest,
 ) (collateralservice.PlaceOrderResult, error) {
 	return useCases.placeOrder.Execute(ctx, request)
 }
+
+func (useCases *collateralUseCases) PlanRange(
+	ctx context.Context,
+	request collateralservice.RangePlanRequest,
+) (collateralservice.RangePlanResult, error) {
+	return useCases.planRange.Execute(ctx, request)
+}
+
+func (useCases *collateralUseCases) SubmitRange(
+	ctx context.Context,
+	request collateralservice.RangeSubmitRequest,
+) (collateralservice.RangePlanResult, error) {
+	return useCases.submitRange.Execute(ctx, request)
+}
+
+func (useCases *collateralUseCases) CancelOrders(
+	ctx context.Context,
+	request collateralservice.CancelOrderRequest,
+) (collateralservice.PlaceOrderResult, error) {
+	return useCases.cancelOrder.Execute(ctx, request)
+}
+
+func (useCases *collateralUseCases) ListOrders(
+	ctx context.Context,
+	request collateralservice.ListOrdersRequest,
+) (collateralservice.ListOrdersResult, error) {
+	return useCases.listOrders.Execute(ctx, request)
+}
+
+func (useCases *collateralUseCases) OrderHistory(
+	ctx context.Context,
+	request collateralservice.HistoryRequest,
+) (collateralservice.OrderHistoryResult, error) {
+	return useCases.history.OrderHistory(ctx, request)
+}
+
+func (useCases *collateralUseCases) Trades(
+	ctx context.Context,
+	request collateralservice.HistoryRequest,
+) (collateralservice.TradesResult, error) {
+	return useCases.history.Trades(ctx, request)
+}
+
+func (useCases *collateralUseCases) Positions(
+	ctx context.Context,
+	request collateralservice.PositionsRequest,
+) (collateralservice.PositionsResult, error) {
+	return useCases.account.Positions(ctx, request)
+}
+
+func (useCases *collateralUseCases) Account(ctx context.Context) (collateralservice.AccountResult, error) {
+	return useCases.account.Account(ctx)
+}
+
+func (useCases *collateralUseCases) GetLeverage(ctx context.Context) (collateralservice.LeverageResult, error) {
+	return useCases.settings.GetLeverage(ctx)
+}
+
+func (useCases *collateralUseCases) SetLeverage(ctx context.Context, leverage int) (collateralservice.LeverageResult, error) {
+	return useCases.settings.SetLeverage(ctx, leverage)
+}
+
+func (useCases *collateralUseCases) GetHedgeMode(ctx context.Context) (collateralservice.HedgeModeResult, error) {
+	return useCases.settings.GetHedgeMode(ctx)
+}
+
+func (useCases *collateralUseCases) SetHedgeMode(ctx context.Context, enabled bool) (collateralservice.HedgeModeResult, error) {
+	return useCases.settings.SetHedgeMode(ctx, enabled)
+}
+
+func (useCases *collateralUseCases) ClosePosition(
+	ctx context.Context,
+	request collateralservice.ClosePositionRequest,
+) (collateralservice.ClosePositionResult, error) {
+	return useCases.closeLeg.Execute(ctx, request)
+}
+
+func (useCases *marketUseCases) Ticker(ctx context.Context, request marketservice.TickerRequest) (marketservice.TickerResult, error) {
+	return useCases.data.Ticker(ctx, request)
+}
+
+func (useCases *marketUseCases) Depth(ctx context.Context, request marketservice.DepthRequest) (marketservice.DepthResult, error) {
+	return useCases.data.Depth(ctx, request)
+}
+
+func (useCases *marketUseCases) Trades(ctx context.Context, request marketservice.TradesRequest) (marketservice.TradesResult, error) {
+	return useCases.data.Trades(ctx, request)
+}
+
+func (useCases *marketUseCases) Klines(ctx context.Context, request marketservice.KlinesRequest) (marketservice.KlinesResult, error) {
+	return useCases.klines.Klines(ctx, request)
+}
+
+func (useCases *marketUseCases) Watch(
+	ctx context.Context,
+	request marketservice.WatchRequest,
+	emit func(marketservice.WatchEvent) error,
+) error {
+	return useCases.watch.Watch(ctx, request, emit)
+}
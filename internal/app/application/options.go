@@ -0,0 +1,67 @@
+package application
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// CredentialSourceKind names where commands read API credentials from.
+type CredentialSourceKind string
+
+const (
+	// CredentialSourceStore reads credentials saved by auth login (default).
+	CredentialSourceStore CredentialSourceKind = ""
+	// CredentialSourceEnv reads WBCLI_API_KEY and WBCLI_API_SECRET.
+	CredentialSourceEnv CredentialSourceKind = "env"
+	// CredentialSourceFD reads "key\nsecret\n" from an inherited file descriptor.
+	CredentialSourceFD CredentialSourceKind = "fd"
+)
+
+// CredentialSource selects the credential source for one run.
+type CredentialSource struct {
+	Kind CredentialSourceKind
+	FD   int
+}
+
+// Options configures NewDefaultWithOptions.
+type Options struct {
+	Credentials CredentialSource
+	// Account names the stored account slot; empty selects the account chosen with auth use.
+	Account string
+}
+
+// ParseCredentialSource parses a --credentials-from value: empty, "env" or "fd:N".
+func ParseCredentialSource(value string) (CredentialSource, error) {
+	value = strings.TrimSpace(value)
+	switch {
+	case value == "":
+		return CredentialSource{Kind: CredentialSourceStore}, nil
+	case value == string(CredentialSourceEnv):
+		return CredentialSource{Kind: CredentialSourceEnv}, nil
+	case strings.HasPrefix(value, string(CredentialSourceFD)+":"):
+		fd, err := strconv.Atoi(strings.TrimPrefix(value, string(CredentialSourceFD)+":"))
+		if err != nil || fd < 0 {
+			return CredentialSource{}, fmt.Errorf("invalid credential source %q: fd must be a non-negative number", value)
+		}
+
+		return CredentialSource{Kind: CredentialSourceFD, FD: fd}, nil
+	default:
+		return CredentialSource{}, fmt.Errorf("invalid credential source %q: use env or fd:N", value)
+	}
+}
+
+// ParseAccount parses an --account value; empty keeps the active account.
+func ParseAccount(value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", nil
+	}
+	if err := domainauth.ValidateAccountName(value); err != nil {
+		return "", fmt.Errorf("invalid account %q: use 1-32 characters of a-z, 0-9, '-' or '_'", value)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,18 @@
+package application
+
+import (
+	"context"
+
+	"github.com/ChewX3D/crypto/internal/adapters/secretstore"
+)
+
+// ReadPassphrase reads the encrypted-file passphrase from the first line of path, or of the inherited
+// file descriptor fd when path is empty, the same way later commands read WBCLI_PASSPHRASE_FILE and WBCLI_PASSPHRASE_FD.
+func ReadPassphrase(ctx context.Context, path string, fd int) ([]byte, error) {
+	source := secretstore.PassphraseFromFD(fd)
+	if path != "" {
+		source = secretstore.PassphraseFromFile(path)
+	}
+
+	return source.Passphrase(ctx)
+}
@@ -12,7 +12,9 @@ const (
 	CodeInvalidRequest ErrorCode = "invalid_request"
 	// CodeBusinessRule means the exchange rejected the request due to a trading constraint.
 	CodeBusinessRule ErrorCode = "business_rule"
-	// CodeUnavailable means the exchange or transport is temporarily unreachable.
+	// CodeTransport means a network failure, 429 or 5xx response; the request may succeed when repeated.
+	CodeTransport ErrorCode = "transport"
+	// CodeUnavailable means the exchange call failed for any other reason, such as an unreadable response.
 	CodeUnavailable ErrorCode = "unavailable"
 )
 
@@ -15,6 +15,16 @@ var (
 	ErrSecretStoreUnavailable = errors.New("secret store unavailable")
 	// ErrSecretStorePermissionDenied indicates denied backend access.
 	ErrSecretStorePermissionDenied = errors.New("secret store permission denied")
+	// ErrPassphraseRequired indicates an encrypted backend without a passphrase source.
+	ErrPassphraseRequired = errors.New("credential passphrase required")
+	// ErrPassphraseMismatch indicates a wrong passphrase or a modified encrypted credential file.
+	ErrPassphraseMismatch = errors.New("credential passphrase mismatch")
+	// ErrUnknownCredentialBackend indicates an unsupported credential backend name.
+	ErrUnknownCredentialBackend = errors.New("unknown credential backend")
+	// ErrCredentialStoreReadOnly indicates a credential source that cannot be written or deleted by wbcli.
+	ErrCredentialStoreReadOnly = errors.New("credential store is read-only")
+	// ErrCredentialAgentUnsupported indicates a platform without credential agent support.
+	ErrCredentialAgentUnsupported = errors.New("credential agent is not supported on this platform")
 )
 
 // SessionMetadata holds non-secret auth session information.
@@ -26,7 +36,7 @@ type SessionMetadata struct {
 	UpdatedAt  time.Time
 }
 
-// CredentialStore persists and retrieves secret credentials as a single slot.
+// CredentialStore persists and retrieves the secret credential of one account slot.
 type CredentialStore interface {
 	BackendName() string
 	Save(ctx context.Context, credential domainauth.Credential) error
@@ -35,18 +45,40 @@ type CredentialStore interface {
 	Delete(ctx context.Context) error
 }
 
-// SessionStore persists and retrieves non-secret session metadata.
+// CredentialBackendSelector opens the credential store of a named backend.
+// An empty name selects the active backend. passphrase unlocks encrypted backends;
+// when empty they fall back to their configured passphrase source.
+type CredentialBackendSelector interface {
+	SelectBackend(name string, passphrase []byte) (CredentialStore, error)
+}
+
+// SessionStore persists and retrieves non-secret session metadata of one account slot.
 type SessionStore interface {
 	SaveSession(ctx context.Context, session SessionMetadata) error
 	GetSession(ctx context.Context) (SessionMetadata, bool, error)
 	ClearSession(ctx context.Context) error
 }
 
+// AccountRegistry tracks named account slots and the one commands use when no account is given.
+type AccountRegistry interface {
+	ActiveAccount(ctx context.Context) (string, error)
+	SetActiveAccount(ctx context.Context, name string) error
+	AccountSession(ctx context.Context, name string) (SessionMetadata, bool, error)
+}
+
 // Clock supplies deterministic time in services.
 type Clock interface {
 	Now() time.Time
 }
 
+// CredentialAgent keeps an unlocked credential in memory of a per-user background process.
+// Unlock replaces any running agent and returns when the credential expires;
+// Lock reports whether an agent was running.
+type CredentialAgent interface {
+	Unlock(ctx context.Context, credential domainauth.Credential, ttl time.Duration) (time.Time, error)
+	Lock(ctx context.Context) (bool, error)
+}
+
 // CredentialVerificationResult contains non-secret verification metadata.
 type CredentialVerificationResult struct {
 	Endpoint  string
@@ -57,3 +89,31 @@ type CredentialVerificationResult struct {
 type CredentialVerifier interface {
 	Verify(ctx context.Context, credential domainauth.Credential) (CredentialVerificationResult, error)
 }
+
+// EndpointAccess classifies what a permission probe learned about one endpoint.
+type EndpointAccess string
+
+const (
+	// EndpointAccessAllowed means the exchange accepted the key for the endpoint.
+	EndpointAccessAllowed EndpointAccess = "allowed"
+	// EndpointAccessRejected means the exchange rejected the probe request as invalid; the key was not refused,
+	// but the rejection may have happened before the permission check.
+	EndpointAccessRejected EndpointAccess = "rejected"
+	// EndpointAccessForbidden means the key lacks the endpoint permission.
+	EndpointAccessForbidden EndpointAccess = "forbidden"
+	// EndpointAccessUnknown means the probe failed for another reason, such as an unavailable exchange.
+	EndpointAccessUnknown EndpointAccess = "unknown"
+)
+
+// EndpointPermission is the probe outcome of one endpoint.
+type EndpointPermission struct {
+	Endpoint string
+	Access   EndpointAccess
+	Reason   string
+}
+
+// EndpointPermissionProber checks which private endpoints a credential may call, using requests
+// the exchange rejects before they change account state. Market-scoped probes use market.
+type EndpointPermissionProber interface {
+	ProbePermissions(ctx context.Context, credential domainauth.Credential, market string) ([]EndpointPermission, error)
+}
@@ -3,6 +3,7 @@ package ports
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
 )
@@ -16,6 +17,45 @@ type CollateralLimitOrderRequest struct {
 	Price         string
 	ClientOrderID string
 	PostOnly      bool
+	StopLoss      string
+	TakeProfit    string
+}
+
+// Collateral order types that may take liquidity, placed through PlaceCollateralTakerOrder.
+const (
+	CollateralOrderTypeMarket     = "market"
+	CollateralOrderTypeStopMarket = "stop-market"
+	CollateralOrderTypeStopLimit  = "stop-limit"
+)
+
+// CollateralTakerOrderRequest defines market, stop-market and stop-limit collateral order fields.
+// Price is set only for stop-limit; ActivationPrice only for stop orders.
+type CollateralTakerOrderRequest struct {
+	Type            string
+	Market          string
+	Side            string
+	PositionSide    string
+	Amount          string
+	Price           string
+	ActivationPrice string
+	ClientOrderID   string
+}
+
+// CollateralPlacedOrder is the exchange acknowledgement of one placed order with its immediate fill.
+type CollateralPlacedOrder struct {
+	OrderID       int64
+	ClientOrderID string
+	Amount        string
+	DealStock     string
+	DealMoney     string
+}
+
+// CollateralBulkOrderResult is per-order outcome of a bulk collateral submission, aligned with request order.
+type CollateralBulkOrderResult struct {
+	Accepted      bool
+	OrderID       int64
+	ClientOrderID string
+	Error         string
 }
 
 // CollateralOrderExecutor submits collateral orders to external exchange APIs.
@@ -29,4 +69,115 @@ type CollateralOrderExecutor interface {
 		credential domainauth.Credential,
 		request CollateralLimitOrderRequest,
 	) (json.RawMessage, error)
+	PlaceCollateralBulkLimitOrder(
+		ctx context.Context,
+		credential domainauth.Credential,
+		orders []CollateralLimitOrderRequest,
+		stopOnFail bool,
+	) ([]CollateralBulkOrderResult, error)
+	PlaceCollateralTakerOrder(
+		ctx context.Context,
+		credential domainauth.Credential,
+		request CollateralTakerOrderRequest,
+	) (CollateralPlacedOrder, error)
+}
+
+// CollateralOrder is a collateral order snapshot reported by the exchange.
+type CollateralOrder struct {
+	OrderID       int64
+	ClientOrderID string
+	Market        string
+	Side          string
+	PositionSide  string
+	Type          string
+	Price         string
+	Amount        string
+	Left          string
+	DealStock     string
+	DealMoney     string
+	PostOnly      bool
+	CreatedAt     time.Time
+}
+
+// CollateralCancelOrderRequest identifies one order to cancel by exchange or client order id.
+type CollateralCancelOrderRequest struct {
+	Market        string
+	OrderID       int64
+	ClientOrderID string
+}
+
+// CollateralOrderManager queries and cancels collateral orders on external exchange APIs.
+type CollateralOrderManager interface {
+	CancelOrder(
+		ctx context.Context,
+		credential domainauth.Credential,
+		request CollateralCancelOrderRequest,
+	) error
+	CancelAllOrders(
+		ctx context.Context,
+		credential domainauth.Credential,
+		market string,
+	) error
+	ListActiveOrders(
+		ctx context.Context,
+		credential domainauth.Credential,
+		market string,
+	) ([]CollateralOrder, error)
+}
+
+// HistoryPageQuery selects one offset/limit page of exchange history, newest first, optionally of one client order id.
+type HistoryPageQuery struct {
+	Market        string
+	ClientOrderID string
+	Limit         int
+	Offset        int
+}
+
+// CollateralOrderHistoryEntry is a finished order reported by exchange order history.
+type CollateralOrderHistoryEntry struct {
+	OrderID       int64
+	ClientOrderID string
+	Market        string
+	Side          string
+	PositionSide  string
+	Type          string
+	Status        string
+	Price         string
+	Amount        string
+	DealStock     string
+	DealMoney     string
+	DealFee       string
+	PostOnly      bool
+	CreatedAt     time.Time
+	FinishedAt    time.Time
+}
+
+// CollateralTrade is one fill reported by exchange executed history.
+type CollateralTrade struct {
+	TradeID       int64
+	OrderID       int64
+	ClientOrderID string
+	Market        string
+	Side          string
+	Role          string
+	Price         string
+	Amount        string
+	Deal          string
+	Fee           string
+	FeeAsset      string
+	ExecutedAt    time.Time
+}
+
+// CollateralHistoryReader reads paged order and fill history from external exchange APIs.
+type CollateralHistoryReader interface {
+	OrderHistoryPage(
+		ctx context.Context,
+		credential domainauth.Credential,
+		query HistoryPageQuery,
+	) ([]CollateralOrderHistoryEntry, error)
+	TradesPage(
+		ctx context.Context,
+		credential domainauth.Credential,
+		query HistoryPageQuery,
+	) ([]CollateralTrade, error)
 }
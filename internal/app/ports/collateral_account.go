@@ -0,0 +1,57 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// CollateralBalance is one asset balance of collateral account.
+type CollateralBalance struct {
+	Asset  string
+	Amount string
+}
+
+// CollateralAccountSummary is collateral account equity and margin snapshot.
+type CollateralAccountSummary struct {
+	Equity            string
+	Margin            string
+	FreeMargin        string
+	UnrealizedFunding string
+	PnL               string
+	Leverage          string
+}
+
+// CollateralPosition is one open collateral position reported by the exchange.
+// Amount is signed in one-way mode; PositionSide is "long"/"short" for hedge mode legs and empty otherwise.
+type CollateralPosition struct {
+	PositionID        int64
+	Market            string
+	PositionSide      string
+	Amount            string
+	BasePrice         string
+	LiquidationPrice  string
+	LiquidationState  string
+	PnL               string
+	PnLPercent        string
+	Margin            string
+	FreeMargin        string
+	Funding           string
+	UnrealizedFunding string
+	OpenedAt          time.Time
+	UpdatedAt         time.Time
+}
+
+// CollateralAccountReader reads collateral account state from external exchange APIs.
+type CollateralAccountReader interface {
+	Balances(ctx context.Context, credential domainauth.Credential) ([]CollateralBalance, error)
+	Summary(ctx context.Context, credential domainauth.Credential) (CollateralAccountSummary, error)
+	OpenPositions(ctx context.Context, credential domainauth.Credential, market string) ([]CollateralPosition, error)
+}
+
+// CollateralAccountSettings changes collateral account-wide trading settings on external exchange APIs.
+type CollateralAccountSettings interface {
+	SetLeverage(ctx context.Context, credential domainauth.Credential, leverage int) (int, error)
+	SetHedgeMode(ctx context.Context, credential domainauth.Credential, enabled bool) (bool, error)
+}
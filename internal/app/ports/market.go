@@ -0,0 +1,149 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
+)
+
+// ErrMarketNotFound indicates a market name unknown to the exchange.
+var ErrMarketNotFound = errors.New("market not found")
+
+// MarketInfo holds exchange trading rules for one market.
+// Zero TickSize falls back to 10^-MoneyPrecision; zero MinAmount, MinTotal or MaxTotal means no limit.
+type MarketInfo struct {
+	Name           string
+	StockPrecision int
+	MoneyPrecision int
+	TickSize       decimal.Decimal
+	MinAmount      decimal.Decimal
+	MinTotal       decimal.Decimal
+	MaxTotal       decimal.Decimal
+	TradesEnabled  bool
+	IsCollateral   bool
+}
+
+// MarketInfoProvider lists trading rules for all exchange markets.
+type MarketInfoProvider interface {
+	ListMarkets(ctx context.Context) ([]MarketInfo, error)
+}
+
+// Ticker is a 24h market summary from public market data.
+type Ticker struct {
+	Market      string
+	LastPrice   string
+	Change      string
+	BaseVolume  string
+	QuoteVolume string
+	Frozen      bool
+}
+
+// OrderBookLevel is one aggregated price level of an order book side.
+type OrderBookLevel struct {
+	Price  string
+	Amount string
+}
+
+// OrderBook is an order book snapshot; asks ascend and bids descend from the best price.
+type OrderBook struct {
+	Market    string
+	Timestamp time.Time
+	Asks      []OrderBookLevel
+	Bids      []OrderBookLevel
+}
+
+// PublicTrade is one public market trade; Side is the taker side.
+type PublicTrade struct {
+	TradeID    int64
+	Side       string
+	Price      string
+	Amount     string
+	Total      string
+	ExecutedAt time.Time
+}
+
+// MarketDataReader reads unauthenticated public market data from external exchange APIs.
+type MarketDataReader interface {
+	Tickers(ctx context.Context) ([]Ticker, error)
+	OrderBook(ctx context.Context, market string, limit int) (OrderBook, error)
+	RecentTrades(ctx context.Context, market string, side string) ([]PublicTrade, error)
+}
+
+// Kline is one closed or forming OHLCV candle; OpenTime is the interval start.
+type Kline struct {
+	OpenTime    time.Time
+	Open        string
+	High        string
+	Low         string
+	Close       string
+	Volume      string
+	QuoteVolume string
+}
+
+// KlineReader reads historical candles from external exchange APIs.
+// Start and End bound candle open times inclusively; limit caps candles per call.
+type KlineReader interface {
+	Klines(ctx context.Context, market string, interval string, start time.Time, end time.Time, limit int) ([]Kline, error)
+}
+
+// KlineCache stores closed candles per market and interval in local storage.
+type KlineCache interface {
+	LoadKlines(ctx context.Context, market string, interval string, start time.Time, end time.Time) ([]Kline, error)
+	AppendKlines(ctx context.Context, market string, interval string, klines []Kline) error
+}
+
+// Market stream event kinds.
+const (
+	MarketStreamState     = "state"
+	MarketStreamLastPrice = "last_price"
+	MarketStreamDepth     = "depth"
+	MarketStreamOrder     = "order"
+	MarketStreamError     = "error"
+)
+
+// MarketStreamRequest selects channels of a live market stream.
+// Nil Credential streams public channels only; otherwise own order updates of Market are included.
+type MarketStreamRequest struct {
+	Market     string
+	DepthLimit int
+	Credential *domainauth.Credential
+}
+
+// OwnOrderUpdate is one own order change; DealStock, DealMoney and DealFee are cumulative.
+type OwnOrderUpdate struct {
+	OrderID       int64
+	ClientOrderID string
+	Market        string
+	Side          string
+	Price         string
+	Amount        string
+	Left          string
+	DealStock     string
+	DealMoney     string
+	DealFee       string
+	Finished      bool
+}
+
+// MarketStreamEvent is one live stream update; Kind selects the populated fields.
+// Depth events with FullReload replace the book, otherwise levels are deltas and zero Amount removes a level.
+type MarketStreamEvent struct {
+	Kind       string
+	Market     string
+	Connected  bool
+	RetryIn    time.Duration
+	Message    string
+	Price      string
+	FullReload bool
+	Asks       []OrderBookLevel
+	Bids       []OrderBookLevel
+	Order      OwnOrderUpdate
+}
+
+// MarketStreamer streams live market updates until ctx is cancelled, handle returns an error
+// or the stream fails permanently. Transient disconnects are reported as state events.
+type MarketStreamer interface {
+	StreamMarket(ctx context.Context, request MarketStreamRequest, handle func(MarketStreamEvent) error) error
+}
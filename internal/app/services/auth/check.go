@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// ErrCheckMarketRequired indicates a permission check without a probe market.
+var ErrCheckMarketRequired = errors.New("permission check market is required")
+
+// CheckPermissionsRequest is input for auth status --check use-case.
+// Market is used by market-scoped probes such as positions, history and order placement.
+type CheckPermissionsRequest struct {
+	Market string
+}
+
+// CheckPermissionsResult is safe output for auth status --check use-case.
+type CheckPermissionsResult struct {
+	Endpoints []ports.EndpointPermission
+}
+
+// CheckPermissionsService probes every private endpoint wbcli uses with the active credential.
+type CheckPermissionsService struct {
+	credentialStore ports.CredentialStore
+	prober          ports.EndpointPermissionProber
+}
+
+// NewCheckPermissionsService constructs CheckPermissionsService.
+func NewCheckPermissionsService(
+	credentialStore ports.CredentialStore,
+	prober ports.EndpointPermissionProber,
+) *CheckPermissionsService {
+	return &CheckPermissionsService{credentialStore: credentialStore, prober: prober}
+}
+
+// Execute loads the credential once and reports per-endpoint access.
+func (service *CheckPermissionsService) Execute(ctx context.Context, request CheckPermissionsRequest) (CheckPermissionsResult, error) {
+	market := strings.ToUpper(strings.TrimSpace(request.Market))
+	if market == "" {
+		return CheckPermissionsResult{}, ErrCheckMarketRequired
+	}
+
+	credential, err := service.credentialStore.Load(ctx)
+	if err != nil {
+		return CheckPermissionsResult{}, fmt.Errorf("load credential: %w", err)
+	}
+	defer domainauth.WipeBytes(credential.APISecret)
+
+	endpoints, err := service.prober.ProbePermissions(ctx, credential, market)
+	if err != nil {
+		return CheckPermissionsResult{}, fmt.Errorf("probe endpoint permissions: %w", err)
+	}
+
+	return CheckPermissionsResult{Endpoints: endpoints}, nil
+}
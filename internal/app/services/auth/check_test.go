@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+type fakePermissionProber struct {
+	credential  domainauth.Credential
+	market      string
+	permissions []ports.EndpointPermission
+	err         error
+}
+
+func (prober *fakePermissionProber) ProbePermissions(
+	_ context.Context,
+	credential domainauth.Credential,
+	market string,
+) ([]ports.EndpointPermission, error) {
+	prober.market = market
+	prober.credential = domainauth.Credential{APIKey: credential.APIKey, APISecret: append([]byte(nil), credential.APISecret...)}
+	return prober.permissions, prober.err
+}
+
+func TestCheckPermissionsServiceProbesStoredCredential(t *testing.T) {
+	credentialStore := &fakeCredentialStore{credential: &domainauth.Credential{APIKey: "key-1234", APISecret: []byte("secret")}}
+	prober := &fakePermissionProber{permissions: []ports.EndpointPermission{
+		{Endpoint: "/api/v4/collateral-account/balance", Access: ports.EndpointAccessAllowed},
+		{Endpoint: "/api/v4/order/collateral/limit", Access: ports.EndpointAccessForbidden, Reason: "enable access"},
+	}}
+
+	result, err := NewCheckPermissionsService(credentialStore, prober).Execute(context.Background(), CheckPermissionsRequest{Market: " eth_perp "})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if prober.credential.APIKey != "key-1234" || string(prober.credential.APISecret) != "secret" {
+		t.Fatalf("unexpected probed credential: %+v", prober.credential)
+	}
+	if prober.market != "ETH_PERP" {
+		t.Fatalf("expected normalized probe market, got %q", prober.market)
+	}
+	if len(result.Endpoints) != 2 || result.Endpoints[1].Access != ports.EndpointAccessForbidden {
+		t.Fatalf("unexpected endpoints: %+v", result.Endpoints)
+	}
+}
+
+func TestCheckPermissionsServiceReturnsErrors(t *testing.T) {
+	_, err := NewCheckPermissionsService(&fakeCredentialStore{}, &fakePermissionProber{}).Execute(context.Background(), CheckPermissionsRequest{})
+	if !errors.Is(err, ErrCheckMarketRequired) {
+		t.Fatalf("expected ErrCheckMarketRequired, got %v", err)
+	}
+
+	_, err = NewCheckPermissionsService(&fakeCredentialStore{}, &fakePermissionProber{}).Execute(context.Background(), CheckPermissionsRequest{Market: "BTC_PERP"})
+	if !errors.Is(err, ports.ErrCredentialNotFound) {
+		t.Fatalf("expected ErrCredentialNotFound, got %v", err)
+	}
+
+	probeErr := &ports.APIError{Code: ports.CodeUnauthorized, Message: "permission check failed: credentials are invalid"}
+	credentialStore := &fakeCredentialStore{credential: &domainauth.Credential{APIKey: "key-1234", APISecret: []byte("secret")}}
+	_, err = NewCheckPermissionsService(credentialStore, &fakePermissionProber{err: probeErr}).Execute(context.Background(), CheckPermissionsRequest{Market: "BTC_PERP"})
+	var apiErr *ports.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != ports.CodeUnauthorized {
+		t.Fatalf("expected unauthorized APIError, got %v", err)
+	}
+}
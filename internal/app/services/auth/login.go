@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -11,9 +12,13 @@ import (
 )
 
 // LoginRequest is input for auth login use-case.
+// Backend names the credential backend to store into; empty keeps the active one.
+// Passphrase unlocks encrypted backends and is wiped after use.
 type LoginRequest struct {
-	APIKey    string
-	APISecret []byte
+	APIKey     string
+	APISecret  []byte
+	Backend    string
+	Passphrase []byte
 }
 
 // LoginResult is safe output for auth login use-case.
@@ -26,20 +31,24 @@ type LoginResult struct {
 // LoginService stores credentials securely for single-session auth.
 type LoginService struct {
 	credentialStore    ports.CredentialStore
+	backends           ports.CredentialBackendSelector
 	sessionStore       ports.SessionStore
 	clock              ports.Clock
 	credentialVerifier ports.CredentialVerifier
 }
 
 // NewLoginService constructs LoginService.
+// backends may be nil, in which case credentials always go to credentialStore.
 func NewLoginService(
 	credentialStore ports.CredentialStore,
+	backends ports.CredentialBackendSelector,
 	sessionStore ports.SessionStore,
 	clock ports.Clock,
 	credentialVerifier ports.CredentialVerifier,
 ) *LoginService {
 	return &LoginService{
 		credentialStore:    credentialStore,
+		backends:           backends,
 		sessionStore:       sessionStore,
 		clock:              clock,
 		credentialVerifier: credentialVerifier,
@@ -56,6 +65,12 @@ func (service *LoginService) Execute(ctx context.Context, request LoginRequest)
 		return LoginResult{}, err
 	}
 	defer domainauth.WipeBytes(request.APISecret)
+	defer domainauth.WipeBytes(request.Passphrase)
+
+	credentialStore, err := service.selectStore(request)
+	if err != nil {
+		return LoginResult{}, err
+	}
 
 	if service.credentialVerifier == nil {
 		return LoginResult{}, &ports.APIError{
@@ -77,7 +92,7 @@ func (service *LoginService) Execute(ctx context.Context, request LoginRequest)
 
 	now := service.clock.Now().UTC()
 	session := ports.SessionMetadata{
-		Backend:    service.credentialStore.BackendName(),
+		Backend:    credentialStore.BackendName(),
 		APIKeyHint: domainauth.APIKeyHint(request.APIKey),
 		HedgeMode:  ptrutil.Ptr(verificationResult.HedgeMode),
 		CreatedAt:  now,
@@ -87,12 +102,15 @@ func (service *LoginService) Execute(ctx context.Context, request LoginRequest)
 		session.CreatedAt = previous.CreatedAt
 	}
 
-	if err := service.credentialStore.Save(ctx, credential); err != nil {
+	if err := credentialStore.Save(ctx, credential); err != nil {
 		return LoginResult{}, fmt.Errorf("save credential: %w", err)
 	}
 	if err := service.sessionStore.SaveSession(ctx, session); err != nil {
 		return LoginResult{}, fmt.Errorf("save session metadata: %w", err)
 	}
+	if found && previous.Backend != "" && previous.Backend != session.Backend {
+		service.deletePrevious(ctx, previous.Backend)
+	}
 
 	return LoginResult{
 		Backend:    session.Backend,
@@ -100,3 +118,30 @@ func (service *LoginService) Execute(ctx context.Context, request LoginRequest)
 		SavedAt:    now.Format("2006-01-02T15:04:05Z07:00"),
 	}, nil
 }
+
+func (service *LoginService) selectStore(request LoginRequest) (ports.CredentialStore, error) {
+	if service.backends == nil {
+		if request.Backend != "" && request.Backend != service.credentialStore.BackendName() {
+			return nil, fmt.Errorf("%w: %s", ports.ErrUnknownCredentialBackend, request.Backend)
+		}
+
+		return service.credentialStore, nil
+	}
+
+	return service.backends.SelectBackend(request.Backend, request.Passphrase)
+}
+
+// deletePrevious removes the credential left in the backend used before switching; failures only warn.
+func (service *LoginService) deletePrevious(ctx context.Context, backend string) {
+	if service.backends == nil {
+		return
+	}
+
+	previousStore, err := service.backends.SelectBackend(backend, nil)
+	if err == nil {
+		err = previousStore.Delete(ctx)
+	}
+	if err != nil && !errors.Is(err, ports.ErrCredentialNotFound) {
+		slog.Warn("previous credential backend was not cleared", "backend", backend, "error", err)
+	}
+}
@@ -101,6 +101,7 @@ func TestLoginServiceExecuteSuccess(t *testing.T) {
 	}
 	service := NewLoginService(
 		credentialStore,
+		nil,
 		sessionStore,
 		fixedClock{now: time.Date(2026, 2, 26, 12, 0, 0, 0, time.UTC)},
 		credentialVerifier,
@@ -156,7 +157,7 @@ func TestLoginServiceExecuteOverwritesExistingCredential(t *testing.T) {
 		endpoint:  "/api/v4/collateral-account/hedge-mode",
 		hedgeMode: false,
 	}
-	service := NewLoginService(credentialStore, sessionStore, fixedClock{now: time.Now()}, credentialVerifier)
+	service := NewLoginService(credentialStore, nil, sessionStore, fixedClock{now: time.Now()}, credentialVerifier)
 
 	result, err := service.Execute(context.Background(), LoginRequest{
 		APIKey:    "new",
@@ -184,7 +185,7 @@ func TestLoginServiceExecuteFailsWhenProbeFails(t *testing.T) {
 		Message: "credential verification failed: credentials are invalid",
 	}
 	credentialVerifier := &fakeCredentialVerifier{err: probeErr}
-	service := NewLoginService(credentialStore, sessionStore, fixedClock{now: time.Now()}, credentialVerifier)
+	service := NewLoginService(credentialStore, nil, sessionStore, fixedClock{now: time.Now()}, credentialVerifier)
 
 	_, err := service.Execute(context.Background(), LoginRequest{
 		APIKey:    "bad",
@@ -207,3 +208,51 @@ func TestLoginServiceExecuteFailsWhenProbeFails(t *testing.T) {
 		t.Fatalf("expected session metadata to stay unsaved on probe failure")
 	}
 }
+
+type fakeBackendSelector struct {
+	stores         map[string]*fakeCredentialStore
+	lastPassphrase string
+}
+
+func (selector *fakeBackendSelector) SelectBackend(name string, passphrase []byte) (ports.CredentialStore, error) {
+	store, ok := selector.stores[name]
+	if !ok {
+		return nil, ports.ErrUnknownCredentialBackend
+	}
+	if passphrase != nil {
+		selector.lastPassphrase = string(passphrase)
+	}
+
+	return store, nil
+}
+
+func TestLoginServiceSwitchesBackendAndClearsPrevious(t *testing.T) {
+	keychain := &fakeCredentialStore{backendName: "os-keychain", credential: &domainauth.Credential{APIKey: "old", APISecret: []byte("old")}}
+	encrypted := &fakeCredentialStore{backendName: "encrypted-file"}
+	selector := &fakeBackendSelector{stores: map[string]*fakeCredentialStore{"os-keychain": keychain, "encrypted-file": encrypted}}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{Backend: "os-keychain"}}
+	service := NewLoginService(keychain, selector, sessionStore, fixedClock{now: time.Now()}, &fakeCredentialVerifier{})
+
+	passphrase := []byte("pass phrase")
+	result, err := service.Execute(context.Background(), LoginRequest{
+		APIKey:     "api-key-2",
+		APISecret:  []byte("secret-2"),
+		Backend:    "encrypted-file",
+		Passphrase: passphrase,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Backend != "encrypted-file" || sessionStore.session.Backend != "encrypted-file" {
+		t.Fatalf("expected encrypted-file session, got %+v / %+v", result, sessionStore.session)
+	}
+	if encrypted.credential == nil || encrypted.credential.APIKey != "api-key-2" || selector.lastPassphrase != "pass phrase" {
+		t.Fatalf("expected credential saved with passphrase, got %+v (%q)", encrypted.credential, selector.lastPassphrase)
+	}
+	if keychain.credential != nil {
+		t.Fatalf("expected previous backend credential to be deleted")
+	}
+	if string(passphrase) != string(make([]byte, len(passphrase))) {
+		t.Fatalf("expected passphrase to be wiped")
+	}
+}
@@ -0,0 +1,247 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
+	"github.com/ChewX3D/crypto/internal/ptrutil"
+)
+
+var (
+	// ErrRotationSameKey indicates the new API key equals the stored one.
+	ErrRotationSameKey = errors.New("new api key equals the stored api key")
+	// ErrRotationHedgeModeMismatch indicates the new key reports a different hedge mode than the stored key.
+	ErrRotationHedgeModeMismatch = errors.New("new api key reports a different hedge mode")
+	// ErrRotationAccountMismatch indicates the new key sees different balances or positions than the stored key.
+	ErrRotationAccountMismatch = errors.New("new api key sees a different account")
+	// ErrRotationAccountUnverified indicates the account has no balances or positions to compare.
+	ErrRotationAccountUnverified = errors.New("account has no balances or open positions to compare")
+)
+
+// RotateRequest is input for auth rotate use-case.
+// AllowEmptyAccount accepts the new key when neither key sees balances or positions.
+type RotateRequest struct {
+	APIKey            string
+	APISecret         []byte
+	AllowEmptyAccount bool
+}
+
+// RotateResult is safe output for auth rotate use-case.
+type RotateResult struct {
+	Backend            string
+	APIKeyHint         string
+	PreviousAPIKeyHint string
+	HedgeMode          bool
+	SavedAt            string
+}
+
+// RotateService replaces the stored credential after checking that the new key is valid
+// and belongs to the same account. The stored credential is only replaced once every check passed.
+type RotateService struct {
+	credentialStore    ports.CredentialStore
+	sessionStore       ports.SessionStore
+	clock              ports.Clock
+	credentialVerifier ports.CredentialVerifier
+	accountReader      ports.CollateralAccountReader
+}
+
+// NewRotateService constructs RotateService.
+func NewRotateService(
+	credentialStore ports.CredentialStore,
+	sessionStore ports.SessionStore,
+	clock ports.Clock,
+	credentialVerifier ports.CredentialVerifier,
+	accountReader ports.CollateralAccountReader,
+) *RotateService {
+	return &RotateService{
+		credentialStore:    credentialStore,
+		sessionStore:       sessionStore,
+		clock:              clock,
+		credentialVerifier: credentialVerifier,
+		accountReader:      accountReader,
+	}
+}
+
+// Execute validates the new credential against the stored one and swaps them.
+func (service *RotateService) Execute(ctx context.Context, request RotateRequest) (RotateResult, error) {
+	next := domainauth.Credential{
+		APIKey:    request.APIKey,
+		APISecret: request.APISecret,
+	}
+	if err := next.Validate(); err != nil {
+		return RotateResult{}, err
+	}
+	defer domainauth.WipeBytes(request.APISecret)
+
+	session, found, err := service.sessionStore.GetSession(ctx)
+	if err != nil {
+		return RotateResult{}, fmt.Errorf("read session metadata: %w", err)
+	}
+	if !found {
+		return RotateResult{}, ports.ErrCredentialNotFound
+	}
+
+	current, err := service.credentialStore.Load(ctx)
+	if err != nil {
+		return RotateResult{}, fmt.Errorf("load credential: %w", err)
+	}
+	defer domainauth.WipeBytes(current.APISecret)
+	if current.APIKey == next.APIKey {
+		return RotateResult{}, ErrRotationSameKey
+	}
+
+	hedgeMode, err := service.crossCheck(ctx, current, next, request.AllowEmptyAccount)
+	if err != nil {
+		return RotateResult{}, err
+	}
+
+	if err := service.credentialStore.Save(ctx, next); err != nil {
+		return RotateResult{}, fmt.Errorf("save credential: %w", err)
+	}
+
+	now := service.clock.Now().UTC()
+	updated := session
+	updated.APIKeyHint = domainauth.APIKeyHint(next.APIKey)
+	updated.HedgeMode = ptrutil.Ptr(hedgeMode)
+	updated.UpdatedAt = now
+	if err := service.sessionStore.SaveSession(ctx, updated); err != nil {
+		if restoreErr := service.credentialStore.Save(ctx, current); restoreErr != nil {
+			slog.Error("previous credential was not restored after failed rotation", "error", restoreErr)
+			return RotateResult{}, fmt.Errorf("save session metadata: %w; restore previous credential: %v", err, restoreErr)
+		}
+
+		return RotateResult{}, fmt.Errorf("save session metadata: %w", err)
+	}
+
+	return RotateResult{
+		Backend:            service.credentialStore.BackendName(),
+		APIKeyHint:         updated.APIKeyHint,
+		PreviousAPIKeyHint: domainauth.APIKeyHint(current.APIKey),
+		HedgeMode:          hedgeMode,
+		SavedAt:            now.Format("2006-01-02T15:04:05Z07:00"),
+	}, nil
+}
+
+// crossCheck verifies both keys and compares what they see; it returns the shared hedge mode.
+func (service *RotateService) crossCheck(
+	ctx context.Context,
+	current domainauth.Credential,
+	next domainauth.Credential,
+	allowEmptyAccount bool,
+) (bool, error) {
+	if service.credentialVerifier == nil || service.accountReader == nil {
+		return false, &ports.APIError{
+			Code:    ports.CodeUnavailable,
+			Message: "credential verification failed: exchange unavailable",
+			Details: "credential verifier is not configured",
+		}
+	}
+
+	currentResult, err := service.credentialVerifier.Verify(ctx, current)
+	if err != nil {
+		return false, labelKeyError("current api key", fmt.Errorf("verify current credential: %w", err))
+	}
+	nextResult, err := service.credentialVerifier.Verify(ctx, next)
+	if err != nil {
+		return false, labelKeyError("new api key", fmt.Errorf("verify new credential: %w", err))
+	}
+	if currentResult.HedgeMode != nextResult.HedgeMode {
+		return false, fmt.Errorf(
+			"%w: current=%t new=%t",
+			ErrRotationHedgeModeMismatch,
+			currentResult.HedgeMode,
+			nextResult.HedgeMode,
+		)
+	}
+
+	currentSnapshot, err := service.snapshot(ctx, current)
+	if err != nil {
+		return false, labelKeyError("current api key", fmt.Errorf("read account with current credential: %w", err))
+	}
+	nextSnapshot, err := service.snapshot(ctx, next)
+	if err != nil {
+		return false, labelKeyError("new api key", fmt.Errorf("read account with new credential: %w", err))
+	}
+	if !currentSnapshot.equal(nextSnapshot) {
+		return false, ErrRotationAccountMismatch
+	}
+	if currentSnapshot.empty() && !allowEmptyAccount {
+		return false, ErrRotationAccountUnverified
+	}
+
+	return nextResult.HedgeMode, nil
+}
+
+// labelKeyError names the key an exchange error belongs to, since command adapters print APIError alone.
+func labelKeyError(label string, err error) error {
+	var apiErr *ports.APIError
+	if errors.As(err, &apiErr) {
+		return &ports.APIError{Code: apiErr.Code, Message: label + ": " + apiErr.Message, Details: apiErr.Details}
+	}
+
+	return err
+}
+
+// accountSnapshot identifies an account by its open position ids and non-zero collateral balances.
+type accountSnapshot struct {
+	positionIDs []int64
+	balances    map[string]decimal.Decimal
+}
+
+func (service *RotateService) snapshot(ctx context.Context, credential domainauth.Credential) (accountSnapshot, error) {
+	positions, err := service.accountReader.OpenPositions(ctx, credential, "")
+	if err != nil {
+		return accountSnapshot{}, err
+	}
+	balances, err := service.accountReader.Balances(ctx, credential)
+	if err != nil {
+		return accountSnapshot{}, err
+	}
+
+	snapshot := accountSnapshot{balances: map[string]decimal.Decimal{}}
+	for _, position := range positions {
+		snapshot.positionIDs = append(snapshot.positionIDs, position.PositionID)
+	}
+	slices.Sort(snapshot.positionIDs)
+	for _, balance := range balances {
+		amount, err := decimal.Parse(strings.TrimSpace(balance.Amount))
+		if err != nil {
+			return accountSnapshot{}, fmt.Errorf("parse %s balance: %w", balance.Asset, err)
+		}
+		if !amount.IsZero() {
+			snapshot.balances[strings.ToUpper(balance.Asset)] = amount
+		}
+	}
+
+	return snapshot, nil
+}
+
+func (snapshot accountSnapshot) empty() bool {
+	return len(snapshot.positionIDs) == 0 && len(snapshot.balances) == 0
+}
+
+// equal compares position ids when either side has positions, since balances move with funding and fills;
+// otherwise it compares balances.
+func (snapshot accountSnapshot) equal(other accountSnapshot) bool {
+	if len(snapshot.positionIDs) > 0 || len(other.positionIDs) > 0 {
+		return slices.Equal(snapshot.positionIDs, other.positionIDs)
+	}
+	if len(snapshot.balances) != len(other.balances) {
+		return false
+	}
+	for asset, amount := range snapshot.balances {
+		otherAmount, ok := other.balances[asset]
+		if !ok || amount.Cmp(otherAmount) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,210 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+	"github.com/ChewX3D/crypto/internal/ptrutil"
+)
+
+type keyedCredentialVerifier struct {
+	hedgeModes map[string]bool
+}
+
+func (verifier *keyedCredentialVerifier) Verify(_ context.Context, credential domainauth.Credential) (ports.CredentialVerificationResult, error) {
+	hedgeMode, ok := verifier.hedgeModes[credential.APIKey]
+	if !ok {
+		return ports.CredentialVerificationResult{}, &ports.APIError{Code: ports.CodeUnauthorized, Message: "unauthorized"}
+	}
+
+	return ports.CredentialVerificationResult{Endpoint: "/api/v4/collateral-account/hedge-mode", HedgeMode: hedgeMode}, nil
+}
+
+type keyedAccountReader struct {
+	positions map[string][]ports.CollateralPosition
+	balances  map[string][]ports.CollateralBalance
+}
+
+func (reader *keyedAccountReader) Balances(_ context.Context, credential domainauth.Credential) ([]ports.CollateralBalance, error) {
+	return reader.balances[credential.APIKey], nil
+}
+
+func (reader *keyedAccountReader) Summary(context.Context, domainauth.Credential) (ports.CollateralAccountSummary, error) {
+	return ports.CollateralAccountSummary{}, nil
+}
+
+func (reader *keyedAccountReader) OpenPositions(_ context.Context, credential domainauth.Credential, _ string) ([]ports.CollateralPosition, error) {
+	return reader.positions[credential.APIKey], nil
+}
+
+type failingSessionStore struct {
+	fakeSessionStore
+	saveErr error
+}
+
+func (store *failingSessionStore) SaveSession(context.Context, ports.SessionMetadata) error {
+	return store.saveErr
+}
+
+func newRotateFixture() (*fakeCredentialStore, *fakeSessionStore, *keyedCredentialVerifier, *keyedAccountReader) {
+	credentialStore := &fakeCredentialStore{
+		backendName: "os-keychain",
+		credential:  &domainauth.Credential{APIKey: "old-key-1234", APISecret: []byte("old-secret")},
+	}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{
+		Backend:    "os-keychain",
+		APIKeyHint: domainauth.APIKeyHint("old-key-1234"),
+		HedgeMode:  ptrutil.Ptr(true),
+		CreatedAt:  time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}}
+	verifier := &keyedCredentialVerifier{hedgeModes: map[string]bool{"old-key-1234": true, "new-key-5678": true}}
+	positions := []ports.CollateralPosition{{PositionID: 7, Market: "BTC_PERP"}, {PositionID: 3, Market: "ETH_PERP"}}
+	reader := &keyedAccountReader{
+		positions: map[string][]ports.CollateralPosition{
+			"old-key-1234": positions,
+			"new-key-5678": {positions[1], positions[0]},
+		},
+		balances: map[string][]ports.CollateralBalance{},
+	}
+
+	return credentialStore, sessionStore, verifier, reader
+}
+
+func TestRotateServiceSwapsCredentialAfterCrossCheck(t *testing.T) {
+	credentialStore, sessionStore, verifier, reader := newRotateFixture()
+	now := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	service := NewRotateService(credentialStore, sessionStore, fixedClock{now: now}, verifier, reader)
+
+	secret := []byte("new-secret")
+	result, err := service.Execute(context.Background(), RotateRequest{APIKey: "new-key-5678", APISecret: secret})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if credentialStore.credential.APIKey != "new-key-5678" || string(credentialStore.credential.APISecret) != "new-secret" {
+		t.Fatalf("expected new credential stored, got %q", credentialStore.credential.APIKey)
+	}
+	if result.PreviousAPIKeyHint != domainauth.APIKeyHint("old-key-1234") || result.APIKeyHint != domainauth.APIKeyHint("new-key-5678") {
+		t.Fatalf("unexpected result hints: %+v", result)
+	}
+	session := sessionStore.session
+	if session.APIKeyHint != result.APIKeyHint || session.HedgeMode == nil || !*session.HedgeMode {
+		t.Fatalf("unexpected session: %+v", session)
+	}
+	if !session.UpdatedAt.Equal(now) || session.CreatedAt.Equal(now) {
+		t.Fatalf("expected updated_at bumped and created_at kept, got %+v", session)
+	}
+	for _, value := range secret {
+		if value != 0 {
+			t.Fatalf("expected request secret wiped, got %q", secret)
+		}
+	}
+}
+
+func TestRotateServiceKeepsCredentialWhenChecksFail(t *testing.T) {
+	testCases := []struct {
+		name     string
+		mutate   func(*keyedCredentialVerifier, *keyedAccountReader)
+		expected error
+	}{
+		{
+			name: "hedge mode mismatch",
+			mutate: func(verifier *keyedCredentialVerifier, _ *keyedAccountReader) {
+				verifier.hedgeModes["new-key-5678"] = false
+			},
+			expected: ErrRotationHedgeModeMismatch,
+		},
+		{
+			name: "different positions",
+			mutate: func(_ *keyedCredentialVerifier, reader *keyedAccountReader) {
+				reader.positions["new-key-5678"] = []ports.CollateralPosition{{PositionID: 99}}
+			},
+			expected: ErrRotationAccountMismatch,
+		},
+		{
+			name: "different balances",
+			mutate: func(_ *keyedCredentialVerifier, reader *keyedAccountReader) {
+				reader.positions = map[string][]ports.CollateralPosition{}
+				reader.balances["old-key-1234"] = []ports.CollateralBalance{{Asset: "USDT", Amount: "100.50"}}
+				reader.balances["new-key-5678"] = []ports.CollateralBalance{{Asset: "USDT", Amount: "0"}}
+			},
+			expected: ErrRotationAccountMismatch,
+		},
+		{
+			name: "empty account",
+			mutate: func(_ *keyedCredentialVerifier, reader *keyedAccountReader) {
+				reader.positions = map[string][]ports.CollateralPosition{}
+			},
+			expected: ErrRotationAccountUnverified,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			credentialStore, sessionStore, verifier, reader := newRotateFixture()
+			testCase.mutate(verifier, reader)
+			before := *sessionStore.session
+			service := NewRotateService(credentialStore, sessionStore, fixedClock{now: time.Now()}, verifier, reader)
+
+			_, err := service.Execute(context.Background(), RotateRequest{APIKey: "new-key-5678", APISecret: []byte("new-secret")})
+			if !errors.Is(err, testCase.expected) {
+				t.Fatalf("expected %v, got %v", testCase.expected, err)
+			}
+			if credentialStore.credential.APIKey != "old-key-1234" || string(credentialStore.credential.APISecret) != "old-secret" {
+				t.Fatalf("expected old credential kept, got %q", credentialStore.credential.APIKey)
+			}
+			if *sessionStore.session != before {
+				t.Fatalf("expected session unchanged, got %+v", sessionStore.session)
+			}
+		})
+	}
+}
+
+func TestRotateServiceAllowsEmptyAccountWhenRequested(t *testing.T) {
+	credentialStore, sessionStore, verifier, reader := newRotateFixture()
+	reader.positions = map[string][]ports.CollateralPosition{}
+	reader.balances["old-key-1234"] = []ports.CollateralBalance{{Asset: "USDT", Amount: "0"}}
+	service := NewRotateService(credentialStore, sessionStore, fixedClock{now: time.Now()}, verifier, reader)
+
+	_, err := service.Execute(context.Background(), RotateRequest{
+		APIKey:            "new-key-5678",
+		APISecret:         []byte("new-secret"),
+		AllowEmptyAccount: true,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if credentialStore.credential.APIKey != "new-key-5678" {
+		t.Fatalf("expected new credential stored, got %q", credentialStore.credential.APIKey)
+	}
+}
+
+func TestRotateServiceRestoresCredentialWhenSessionSaveFails(t *testing.T) {
+	credentialStore, sessionStore, verifier, reader := newRotateFixture()
+	failing := &failingSessionStore{fakeSessionStore: *sessionStore, saveErr: errors.New("disk full")}
+	service := NewRotateService(credentialStore, failing, fixedClock{now: time.Now()}, verifier, reader)
+
+	_, err := service.Execute(context.Background(), RotateRequest{APIKey: "new-key-5678", APISecret: []byte("new-secret")})
+	if err == nil {
+		t.Fatal("expected session save error")
+	}
+	if credentialStore.credential.APIKey != "old-key-1234" || string(credentialStore.credential.APISecret) != "old-secret" {
+		t.Fatalf("expected old credential restored, got %q", credentialStore.credential.APIKey)
+	}
+}
+
+func TestRotateServiceLabelsNewKeyVerificationErrors(t *testing.T) {
+	credentialStore, sessionStore, verifier, reader := newRotateFixture()
+	delete(verifier.hedgeModes, "new-key-5678")
+	service := NewRotateService(credentialStore, sessionStore, fixedClock{now: time.Now()}, verifier, reader)
+
+	_, err := service.Execute(context.Background(), RotateRequest{APIKey: "new-key-5678", APISecret: []byte("new-secret")})
+	var apiErr *ports.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != ports.CodeUnauthorized || apiErr.Message != "new api key: unauthorized" {
+		t.Fatalf("expected labelled unauthorized error, got %v", err)
+	}
+}
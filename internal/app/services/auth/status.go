@@ -10,6 +10,7 @@ import (
 
 // StatusResult returns current auth session state.
 type StatusResult struct {
+	Account    string
 	LoggedIn   bool
 	Backend    string
 	APIKeyHint string
@@ -19,11 +20,13 @@ type StatusResult struct {
 // StatusService provides current auth status view.
 type StatusService struct {
 	sessionStore ports.SessionStore
+	account      string
 }
 
-// NewStatusService constructs StatusService.
-func NewStatusService(sessionStore ports.SessionStore) *StatusService {
-	return &StatusService{sessionStore: sessionStore}
+// NewStatusService constructs StatusService for the account sessionStore is bound to;
+// account is empty when credentials do not come from a stored account slot.
+func NewStatusService(sessionStore ports.SessionStore, account string) *StatusService {
+	return &StatusService{sessionStore: sessionStore, account: account}
 }
 
 // Execute returns logged-in/logged-out status with safe metadata.
@@ -33,10 +36,11 @@ func (service *StatusService) Execute(ctx context.Context) (StatusResult, error)
 		return StatusResult{}, fmt.Errorf("read session metadata: %w", err)
 	}
 	if !found {
-		return StatusResult{LoggedIn: false}, nil
+		return StatusResult{Account: service.account, LoggedIn: false}, nil
 	}
 
 	return StatusResult{
+		Account:    service.account,
 		LoggedIn:   true,
 		Backend:    session.Backend,
 		APIKeyHint: session.APIKeyHint,
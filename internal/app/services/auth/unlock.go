@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+const (
+	// DefaultUnlockTTL is how long auth unlock keeps the credential in the agent by default.
+	DefaultUnlockTTL = 15 * time.Minute
+	// MaxUnlockTTL bounds how long a credential may stay unlocked.
+	MaxUnlockTTL = 12 * time.Hour
+)
+
+// ErrUnlockTTLInvalid indicates a TTL outside (0, MaxUnlockTTL].
+var ErrUnlockTTLInvalid = errors.New("unlock ttl must be positive and at most 12h")
+
+// UnlockRequest is input for auth unlock use-case.
+type UnlockRequest struct {
+	TTL time.Duration
+}
+
+// UnlockResult is safe output for auth unlock use-case.
+type UnlockResult struct {
+	Backend    string
+	APIKeyHint string
+	ExpiresAt  time.Time
+}
+
+// LockResult is safe output for auth lock use-case.
+type LockResult struct {
+	WasUnlocked bool
+}
+
+// UnlockService loads the stored credential once and hands it to the credential agent for a TTL.
+type UnlockService struct {
+	credentialStore ports.CredentialStore
+	agent           ports.CredentialAgent
+}
+
+// NewUnlockService constructs UnlockService.
+func NewUnlockService(credentialStore ports.CredentialStore, agent ports.CredentialAgent) *UnlockService {
+	return &UnlockService{credentialStore: credentialStore, agent: agent}
+}
+
+// Execute starts or replaces the agent with the stored credential.
+func (service *UnlockService) Execute(ctx context.Context, request UnlockRequest) (UnlockResult, error) {
+	if request.TTL <= 0 || request.TTL > MaxUnlockTTL {
+		return UnlockResult{}, ErrUnlockTTLInvalid
+	}
+	if service.agent == nil {
+		return UnlockResult{}, ports.ErrCredentialAgentUnsupported
+	}
+
+	credential, err := service.credentialStore.Load(ctx)
+	if err != nil {
+		return UnlockResult{}, fmt.Errorf("load credential: %w", err)
+	}
+	defer domainauth.WipeBytes(credential.APISecret)
+
+	expiresAt, err := service.agent.Unlock(ctx, credential, request.TTL)
+	if err != nil {
+		return UnlockResult{}, fmt.Errorf("unlock credential agent: %w", err)
+	}
+
+	return UnlockResult{
+		Backend:    service.credentialStore.BackendName(),
+		APIKeyHint: domainauth.APIKeyHint(credential.APIKey),
+		ExpiresAt:  expiresAt,
+	}, nil
+}
+
+// LockService stops the credential agent.
+type LockService struct {
+	agent ports.CredentialAgent
+}
+
+// NewLockService constructs LockService.
+func NewLockService(agent ports.CredentialAgent) *LockService {
+	return &LockService{agent: agent}
+}
+
+// Execute stops a running agent, which wipes its credential.
+func (service *LockService) Execute(ctx context.Context) (LockResult, error) {
+	if service.agent == nil {
+		return LockResult{}, nil
+	}
+
+	wasUnlocked, err := service.agent.Lock(ctx)
+	if err != nil {
+		return LockResult{}, err
+	}
+
+	return LockResult{WasUnlocked: wasUnlocked}, nil
+}
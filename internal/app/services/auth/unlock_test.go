@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+type fakeCredentialAgent struct {
+	credential domainauth.Credential
+	ttl        time.Duration
+	unlocked   bool
+}
+
+func (agent *fakeCredentialAgent) Unlock(_ context.Context, credential domainauth.Credential, ttl time.Duration) (time.Time, error) {
+	agent.credential = domainauth.Credential{APIKey: credential.APIKey, APISecret: append([]byte(nil), credential.APISecret...)}
+	agent.ttl = ttl
+	agent.unlocked = true
+	return time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC), nil
+}
+
+func (agent *fakeCredentialAgent) Lock(context.Context) (bool, error) {
+	wasUnlocked := agent.unlocked
+	agent.unlocked = false
+	return wasUnlocked, nil
+}
+
+func TestUnlockServiceHandsStoredCredentialToAgent(t *testing.T) {
+	credentialStore := &fakeCredentialStore{
+		backendName: "os-keychain",
+		credential:  &domainauth.Credential{APIKey: "key-1234", APISecret: []byte("secret")},
+	}
+	agent := &fakeCredentialAgent{}
+	service := NewUnlockService(credentialStore, agent)
+
+	result, err := service.Execute(context.Background(), UnlockRequest{TTL: 15 * time.Minute})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if agent.credential.APIKey != "key-1234" || string(agent.credential.APISecret) != "secret" || agent.ttl != 15*time.Minute {
+		t.Fatalf("unexpected agent state: %+v", agent)
+	}
+	if result.Backend != "os-keychain" || result.APIKeyHint != domainauth.APIKeyHint("key-1234") {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if !result.ExpiresAt.Equal(time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected expiry: %s", result.ExpiresAt)
+	}
+}
+
+func TestUnlockServiceRejectsInvalidRequests(t *testing.T) {
+	credentialStore := &fakeCredentialStore{credential: &domainauth.Credential{APIKey: "key-1234", APISecret: []byte("secret")}}
+
+	for _, ttl := range []time.Duration{0, -time.Minute, MaxUnlockTTL + time.Second} {
+		_, err := NewUnlockService(credentialStore, &fakeCredentialAgent{}).Execute(context.Background(), UnlockRequest{TTL: ttl})
+		if !errors.Is(err, ErrUnlockTTLInvalid) {
+			t.Fatalf("ttl %s: expected ErrUnlockTTLInvalid, got %v", ttl, err)
+		}
+	}
+
+	_, err := NewUnlockService(credentialStore, nil).Execute(context.Background(), UnlockRequest{TTL: time.Minute})
+	if !errors.Is(err, ports.ErrCredentialAgentUnsupported) {
+		t.Fatalf("expected ErrCredentialAgentUnsupported, got %v", err)
+	}
+
+	_, err = NewUnlockService(&fakeCredentialStore{}, &fakeCredentialAgent{}).Execute(context.Background(), UnlockRequest{TTL: time.Minute})
+	if !errors.Is(err, ports.ErrCredentialNotFound) {
+		t.Fatalf("expected ErrCredentialNotFound, got %v", err)
+	}
+}
+
+func TestLockServiceReportsWhetherAgentWasUnlocked(t *testing.T) {
+	agent := &fakeCredentialAgent{unlocked: true}
+	service := NewLockService(agent)
+
+	for _, expected := range []bool{true, false} {
+		result, err := service.Execute(context.Background())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.WasUnlocked != expected {
+			t.Fatalf("expected was_unlocked=%t, got %t", expected, result.WasUnlocked)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// ErrAccountNotFound indicates a named account without a stored login.
+var ErrAccountNotFound = errors.New("account not found")
+
+// UseRequest is input for auth use use-case.
+type UseRequest struct {
+	Account string
+}
+
+// UseResult is safe output for auth use use-case.
+type UseResult struct {
+	Account    string
+	LoggedIn   bool
+	APIKeyHint string
+}
+
+// UseService selects the account commands use when no --account is given.
+type UseService struct {
+	accounts ports.AccountRegistry
+}
+
+// NewUseService constructs UseService.
+func NewUseService(accounts ports.AccountRegistry) *UseService {
+	return &UseService{accounts: accounts}
+}
+
+// Execute makes request.Account active; named accounts must be logged in first, the default account always exists.
+func (service *UseService) Execute(ctx context.Context, request UseRequest) (UseResult, error) {
+	if err := domainauth.ValidateAccountName(request.Account); err != nil {
+		return UseResult{}, err
+	}
+	if service.accounts == nil {
+		return UseResult{}, ports.ErrCredentialStoreReadOnly
+	}
+
+	session, found, err := service.accounts.AccountSession(ctx, request.Account)
+	if err != nil {
+		return UseResult{}, fmt.Errorf("read session metadata: %w", err)
+	}
+	if !found && request.Account != domainauth.DefaultAccount {
+		return UseResult{}, fmt.Errorf("%w: %s", ErrAccountNotFound, request.Account)
+	}
+
+	if err := service.accounts.SetActiveAccount(ctx, request.Account); err != nil {
+		return UseResult{}, fmt.Errorf("save active account: %w", err)
+	}
+
+	return UseResult{
+		Account:    request.Account,
+		LoggedIn:   found,
+		APIKeyHint: session.APIKeyHint,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+type fakeAccountRegistry struct {
+	active   string
+	sessions map[string]ports.SessionMetadata
+}
+
+func (registry *fakeAccountRegistry) ActiveAccount(context.Context) (string, error) {
+	return registry.active, nil
+}
+
+func (registry *fakeAccountRegistry) SetActiveAccount(_ context.Context, name string) error {
+	registry.active = name
+	return nil
+}
+
+func (registry *fakeAccountRegistry) AccountSession(_ context.Context, name string) (ports.SessionMetadata, bool, error) {
+	session, found := registry.sessions[name]
+	return session, found, nil
+}
+
+func TestUseServiceSelectsLoggedInAccount(t *testing.T) {
+	registry := &fakeAccountRegistry{
+		active:   domainauth.DefaultAccount,
+		sessions: map[string]ports.SessionMetadata{"grid": {Backend: "os-keychain", APIKeyHint: "gr***78"}},
+	}
+	service := NewUseService(registry)
+
+	result, err := service.Execute(context.Background(), UseRequest{Account: "grid"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if registry.active != "grid" || result != (UseResult{Account: "grid", LoggedIn: true, APIKeyHint: "gr***78"}) {
+		t.Fatalf("unexpected state: active=%q result=%+v", registry.active, result)
+	}
+
+	result, err = service.Execute(context.Background(), UseRequest{Account: domainauth.DefaultAccount})
+	if err != nil {
+		t.Fatalf("expected default account selectable without login, got %v", err)
+	}
+	if registry.active != domainauth.DefaultAccount || result.LoggedIn {
+		t.Fatalf("unexpected state: active=%q result=%+v", registry.active, result)
+	}
+}
+
+func TestUseServiceRejectsUnknownOrInvalidAccount(t *testing.T) {
+	registry := &fakeAccountRegistry{active: domainauth.DefaultAccount}
+	service := NewUseService(registry)
+
+	if _, err := service.Execute(context.Background(), UseRequest{Account: "grid"}); !errors.Is(err, ErrAccountNotFound) {
+		t.Fatalf("expected ErrAccountNotFound, got %v", err)
+	}
+	if _, err := service.Execute(context.Background(), UseRequest{Account: "../grid"}); !errors.Is(err, domainauth.ErrAccountNameInvalid) {
+		t.Fatalf("expected ErrAccountNameInvalid, got %v", err)
+	}
+	if registry.active != domainauth.DefaultAccount {
+		t.Fatalf("expected active account unchanged, got %q", registry.active)
+	}
+}
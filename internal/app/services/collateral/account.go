@@ -0,0 +1,218 @@
+package collateral
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
+)
+
+// PositionsRequest is input for open positions use-case. Empty Market lists all markets.
+type PositionsRequest struct {
+	Market string
+}
+
+// Position is normalized view of one open position leg.
+// Amount is always positive; Side tells the direction.
+type Position struct {
+	PositionID        int64     `json:"position_id"`
+	Market            string    `json:"market"`
+	Side              string    `json:"side"`
+	Amount            string    `json:"amount"`
+	BasePrice         string    `json:"base_price"`
+	LiquidationPrice  string    `json:"liquidation_price,omitempty"`
+	LiquidationState  string    `json:"liquidation_state,omitempty"`
+	UnrealizedPnL     string    `json:"unrealized_pnl"`
+	UnrealizedPnLPct  string    `json:"unrealized_pnl_percent"`
+	Margin            string    `json:"margin"`
+	Funding           string    `json:"funding"`
+	UnrealizedFunding string    `json:"unrealized_funding"`
+	OpenedAt          time.Time `json:"opened_at"`
+}
+
+// PositionsResult is normalized output for open positions use-case.
+type PositionsResult struct {
+	HedgeMode bool       `json:"hedge_mode"`
+	Positions []Position `json:"positions"`
+}
+
+// Balance is one non-zero collateral asset balance.
+type Balance struct {
+	Asset  string `json:"asset"`
+	Amount string `json:"amount"`
+}
+
+// AccountResult is normalized output for collateral account summary use-case.
+// MarginUsagePercent is margin/equity*100 and empty when equity is zero.
+type AccountResult struct {
+	HedgeMode          bool      `json:"hedge_mode"`
+	Leverage           string    `json:"leverage,omitempty"`
+	Equity             string    `json:"equity"`
+	Margin             string    `json:"margin"`
+	FreeMargin         string    `json:"free_margin"`
+	MarginUsagePercent string    `json:"margin_usage_percent"`
+	UnrealizedPnL      string    `json:"unrealized_pnl"`
+	UnrealizedFunding  string    `json:"unrealized_funding"`
+	Balances           []Balance `json:"balances"`
+}
+
+// AccountService reads collateral account balances, margin and open positions.
+type AccountService struct {
+	credentialStore ports.CredentialStore
+	accountReader   ports.CollateralAccountReader
+	hedgeMode       hedgeModeResolver
+}
+
+// NewAccountService constructs AccountService.
+func NewAccountService(
+	credentialStore ports.CredentialStore,
+	sessionStore ports.SessionStore,
+	orderExecutor ports.CollateralOrderExecutor,
+	accountReader ports.CollateralAccountReader,
+	clock ports.Clock,
+) *AccountService {
+	return &AccountService{
+		credentialStore: credentialStore,
+		accountReader:   accountReader,
+		hedgeMode:       newHedgeModeResolver(credentialStore, sessionStore, orderExecutor, clock),
+	}
+}
+
+// Positions lists open position legs sorted by market, long before short.
+func (service *AccountService) Positions(ctx context.Context, request PositionsRequest) (PositionsResult, error) {
+	credential, hedgeMode, err := service.prepare(ctx)
+	if err != nil {
+		return PositionsResult{}, err
+	}
+	defer domainauth.WipeBytes(credential.APISecret)
+
+	open, err := service.accountReader.OpenPositions(ctx, credential, strings.TrimSpace(request.Market))
+	if err != nil {
+		return PositionsResult{}, fmt.Errorf("list open positions: %w", err)
+	}
+
+	positions := make([]Position, 0, len(open))
+	for _, position := range open {
+		side, amount := positionDirection(position)
+		positions = append(positions, Position{
+			PositionID:        position.PositionID,
+			Market:            position.Market,
+			Side:              side,
+			Amount:            amount,
+			BasePrice:         position.BasePrice,
+			LiquidationPrice:  position.LiquidationPrice,
+			LiquidationState:  position.LiquidationState,
+			UnrealizedPnL:     position.PnL,
+			UnrealizedPnLPct:  position.PnLPercent,
+			Margin:            position.Margin,
+			Funding:           position.Funding,
+			UnrealizedFunding: position.UnrealizedFunding,
+			OpenedAt:          position.OpenedAt,
+		})
+	}
+
+	sort.SliceStable(positions, func(left int, right int) bool {
+		if positions[left].Market != positions[right].Market {
+			return positions[left].Market < positions[right].Market
+		}
+
+		return positions[left].Side < positions[right].Side
+	})
+
+	return PositionsResult{HedgeMode: hedgeMode, Positions: positions}, nil
+}
+
+// Account returns collateral equity, margin usage and non-zero balances.
+func (service *AccountService) Account(ctx context.Context) (AccountResult, error) {
+	credential, hedgeMode, err := service.prepare(ctx)
+	if err != nil {
+		return AccountResult{}, err
+	}
+	defer domainauth.WipeBytes(credential.APISecret)
+
+	summary, err := service.accountReader.Summary(ctx, credential)
+	if err != nil {
+		return AccountResult{}, fmt.Errorf("read collateral summary: %w", err)
+	}
+	balances, err := service.accountReader.Balances(ctx, credential)
+	if err != nil {
+		return AccountResult{}, fmt.Errorf("read collateral balances: %w", err)
+	}
+
+	result := AccountResult{
+		HedgeMode:          hedgeMode,
+		Leverage:           summary.Leverage,
+		Equity:             summary.Equity,
+		Margin:             summary.Margin,
+		FreeMargin:         summary.FreeMargin,
+		MarginUsagePercent: marginUsagePercent(summary.Margin, summary.Equity),
+		UnrealizedPnL:      summary.PnL,
+		UnrealizedFunding:  summary.UnrealizedFunding,
+		Balances:           make([]Balance, 0, len(balances)),
+	}
+	for _, balance := range balances {
+		if amount, err := decimal.Parse(balance.Amount); err == nil && amount.IsZero() {
+			continue
+		}
+		result.Balances = append(result.Balances, Balance{Asset: balance.Asset, Amount: balance.Amount})
+	}
+
+	return result, nil
+}
+
+func (service *AccountService) prepare(ctx context.Context) (domainauth.Credential, bool, error) {
+	credential, err := service.credentialStore.Load(ctx)
+	if err != nil {
+		return domainauth.Credential{}, false, fmt.Errorf("load credential: %w", err)
+	}
+
+	hedgeMode, err := service.hedgeMode.resolve(ctx, credential)
+	if err != nil {
+		domainauth.WipeBytes(credential.APISecret)
+		return domainauth.Credential{}, false, fmt.Errorf("resolve hedge mode: %w", err)
+	}
+
+	return credential, hedgeMode, nil
+}
+
+// positionDirection returns leg side and unsigned amount. Hedge mode legs carry their side;
+// one-way positions encode direction in the amount sign.
+func positionDirection(position ports.CollateralPosition) (string, string) {
+	amount, err := decimal.Parse(strings.TrimSpace(position.Amount))
+	if err != nil {
+		return position.PositionSide, position.Amount
+	}
+
+	side := position.PositionSide
+	if side == "" {
+		side = "long"
+		if amount.Sign() < 0 {
+			side = "short"
+		}
+	}
+
+	return side, amount.Abs().String()
+}
+
+func marginUsagePercent(margin string, equity string) string {
+	marginValue, err := decimal.Parse(margin)
+	if err != nil {
+		return ""
+	}
+	equityValue, err := decimal.Parse(equity)
+	if err != nil || equityValue.Sign() <= 0 {
+		return ""
+	}
+
+	usage, err := marginValue.Mul(decimal.FromInt(100)).Quo(equityValue, 2)
+	if err != nil {
+		return ""
+	}
+
+	return usage.String()
+}
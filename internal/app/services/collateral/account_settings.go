@@ -0,0 +1,121 @@
+package collateral
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// ErrLeverageUnavailable indicates exchange summary without leverage value.
+var ErrLeverageUnavailable = errors.New("exchange did not report account leverage")
+
+// LeverageResult is normalized output for leverage use-cases.
+type LeverageResult struct {
+	Leverage string `json:"leverage"`
+}
+
+// HedgeModeResult is normalized output for hedge mode use-cases.
+type HedgeModeResult struct {
+	HedgeMode bool `json:"hedge_mode"`
+}
+
+// AccountSettingsService reads and changes account leverage and hedge mode,
+// keeping the session hedge mode cache in sync with the exchange.
+type AccountSettingsService struct {
+	credentialStore ports.CredentialStore
+	accountReader   ports.CollateralAccountReader
+	settings        ports.CollateralAccountSettings
+	hedgeMode       hedgeModeResolver
+}
+
+// NewAccountSettingsService constructs AccountSettingsService.
+func NewAccountSettingsService(
+	credentialStore ports.CredentialStore,
+	sessionStore ports.SessionStore,
+	orderExecutor ports.CollateralOrderExecutor,
+	accountReader ports.CollateralAccountReader,
+	settings ports.CollateralAccountSettings,
+	clock ports.Clock,
+) *AccountSettingsService {
+	return &AccountSettingsService{
+		credentialStore: credentialStore,
+		accountReader:   accountReader,
+		settings:        settings,
+		hedgeMode:       newHedgeModeResolver(credentialStore, sessionStore, orderExecutor, clock),
+	}
+}
+
+// GetLeverage returns current account leverage.
+func (service *AccountSettingsService) GetLeverage(ctx context.Context) (LeverageResult, error) {
+	credential, err := service.credentialStore.Load(ctx)
+	if err != nil {
+		return LeverageResult{}, fmt.Errorf("load credential: %w", err)
+	}
+	defer domainauth.WipeBytes(credential.APISecret)
+
+	summary, err := service.accountReader.Summary(ctx, credential)
+	if err != nil {
+		return LeverageResult{}, fmt.Errorf("read collateral summary: %w", err)
+	}
+	if strings.TrimSpace(summary.Leverage) == "" {
+		return LeverageResult{}, ErrLeverageUnavailable
+	}
+
+	return LeverageResult{Leverage: summary.Leverage}, nil
+}
+
+// SetLeverage changes account leverage.
+func (service *AccountSettingsService) SetLeverage(ctx context.Context, leverage int) (LeverageResult, error) {
+	credential, err := service.credentialStore.Load(ctx)
+	if err != nil {
+		return LeverageResult{}, fmt.Errorf("load credential: %w", err)
+	}
+	defer domainauth.WipeBytes(credential.APISecret)
+
+	applied, err := service.settings.SetLeverage(ctx, credential, leverage)
+	if err != nil {
+		return LeverageResult{}, fmt.Errorf("set leverage: %w", err)
+	}
+
+	return LeverageResult{Leverage: strconv.Itoa(applied)}, nil
+}
+
+// GetHedgeMode reads hedge mode from the exchange and refreshes the session cache.
+func (service *AccountSettingsService) GetHedgeMode(ctx context.Context) (HedgeModeResult, error) {
+	credential, err := service.credentialStore.Load(ctx)
+	if err != nil {
+		return HedgeModeResult{}, fmt.Errorf("load credential: %w", err)
+	}
+	defer domainauth.WipeBytes(credential.APISecret)
+
+	value, err := service.hedgeMode.refresh(ctx, credential)
+	if err != nil {
+		return HedgeModeResult{}, fmt.Errorf("resolve hedge mode: %w", err)
+	}
+
+	return HedgeModeResult{HedgeMode: value}, nil
+}
+
+// SetHedgeMode changes hedge mode on the exchange and stores the applied value in the session cache.
+func (service *AccountSettingsService) SetHedgeMode(ctx context.Context, enabled bool) (HedgeModeResult, error) {
+	credential, err := service.credentialStore.Load(ctx)
+	if err != nil {
+		return HedgeModeResult{}, fmt.Errorf("load credential: %w", err)
+	}
+	defer domainauth.WipeBytes(credential.APISecret)
+
+	applied, err := service.settings.SetHedgeMode(ctx, credential, enabled)
+	if err != nil {
+		return HedgeModeResult{}, fmt.Errorf("set hedge mode: %w", err)
+	}
+	if err := service.hedgeMode.persist(ctx, applied); err != nil {
+		return HedgeModeResult{}, err
+	}
+
+	return HedgeModeResult{HedgeMode: applied}, nil
+}
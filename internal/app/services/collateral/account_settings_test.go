@@ -0,0 +1,128 @@
+package collateral
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+	"github.com/ChewX3D/crypto/internal/ptrutil"
+)
+
+type fakeAccountSettings struct {
+	leverageErr   error
+	hedgeModeErr  error
+	leverageCalls []int
+	hedgeCalls    []bool
+}
+
+func (settings *fakeAccountSettings) SetLeverage(_ context.Context, _ domainauth.Credential, leverage int) (int, error) {
+	settings.leverageCalls = append(settings.leverageCalls, leverage)
+	return leverage, settings.leverageErr
+}
+
+func (settings *fakeAccountSettings) SetHedgeMode(_ context.Context, _ domainauth.Credential, enabled bool) (bool, error) {
+	settings.hedgeCalls = append(settings.hedgeCalls, enabled)
+	return enabled, settings.hedgeModeErr
+}
+
+func TestAccountSettingsServiceSetHedgeModeUpdatesSessionCache(t *testing.T) {
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: ptrutil.Ptr(false)}}
+	settings := &fakeAccountSettings{}
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	service := NewAccountSettingsService(
+		credentialStore,
+		sessionStore,
+		&fakeOrderExecutor{},
+		&fakeAccountReader{},
+		settings,
+		fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)},
+	)
+
+	result, err := service.SetHedgeMode(context.Background(), true)
+	if err != nil {
+		t.Fatalf("set hedge mode failed: %v", err)
+	}
+	if !result.HedgeMode || len(settings.hedgeCalls) != 1 || !settings.hedgeCalls[0] {
+		t.Fatalf("unexpected hedge mode result=%+v calls=%v", result, settings.hedgeCalls)
+	}
+	if sessionStore.session.HedgeMode == nil || !*sessionStore.session.HedgeMode {
+		t.Fatalf("expected cached hedge mode true, got %+v", sessionStore.session)
+	}
+}
+
+func TestAccountSettingsServiceSetHedgeModeKeepsCacheOnFailure(t *testing.T) {
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: ptrutil.Ptr(false)}}
+	settings := &fakeAccountSettings{hedgeModeErr: errors.New("open positions exist")}
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	service := NewAccountSettingsService(
+		credentialStore,
+		sessionStore,
+		&fakeOrderExecutor{},
+		&fakeAccountReader{},
+		settings,
+		fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)},
+	)
+
+	if _, err := service.SetHedgeMode(context.Background(), true); err == nil {
+		t.Fatalf("expected error")
+	}
+	if *sessionStore.session.HedgeMode {
+		t.Fatalf("expected cached hedge mode to stay false")
+	}
+}
+
+func TestAccountSettingsServiceGetHedgeModeRefreshesCache(t *testing.T) {
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: ptrutil.Ptr(false)}}
+	executor := &fakeOrderExecutor{getHedgeModeValue: true}
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	service := NewAccountSettingsService(
+		credentialStore,
+		sessionStore,
+		executor,
+		&fakeAccountReader{},
+		&fakeAccountSettings{},
+		fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)},
+	)
+
+	result, err := service.GetHedgeMode(context.Background())
+	if err != nil {
+		t.Fatalf("get hedge mode failed: %v", err)
+	}
+	if !result.HedgeMode || executor.getHedgeModeCalls != 1 || !*sessionStore.session.HedgeMode {
+		t.Fatalf("expected exchange value cached, got result=%+v calls=%d", result, executor.getHedgeModeCalls)
+	}
+}
+
+func TestAccountSettingsServiceGetLeverage(t *testing.T) {
+	reader := &fakeAccountReader{summary: ports.CollateralAccountSummary{Leverage: "10"}}
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	service := NewAccountSettingsService(
+		credentialStore,
+		&fakeSessionStore{},
+		&fakeOrderExecutor{},
+		reader,
+		&fakeAccountSettings{},
+		fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)},
+	)
+
+	result, err := service.GetLeverage(context.Background())
+	if err != nil || result.Leverage != "10" {
+		t.Fatalf("expected leverage 10, got %+v err=%v", result, err)
+	}
+
+	reader.summary.Leverage = ""
+	if _, err := service.GetLeverage(context.Background()); !errors.Is(err, ErrLeverageUnavailable) {
+		t.Fatalf("expected ErrLeverageUnavailable, got %v", err)
+	}
+}
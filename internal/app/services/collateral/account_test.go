@@ -0,0 +1,127 @@
+package collateral
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+	"github.com/ChewX3D/crypto/internal/ptrutil"
+)
+
+type fakeAccountReader struct {
+	balances  []ports.CollateralBalance
+	summary   ports.CollateralAccountSummary
+	positions []ports.CollateralPosition
+	markets   []string
+}
+
+func (reader *fakeAccountReader) Balances(context.Context, domainauth.Credential) ([]ports.CollateralBalance, error) {
+	return reader.balances, nil
+}
+
+func (reader *fakeAccountReader) Summary(context.Context, domainauth.Credential) (ports.CollateralAccountSummary, error) {
+	return reader.summary, nil
+}
+
+func (reader *fakeAccountReader) OpenPositions(
+	_ context.Context,
+	_ domainauth.Credential,
+	market string,
+) ([]ports.CollateralPosition, error) {
+	reader.markets = append(reader.markets, market)
+	return reader.positions, nil
+}
+
+func TestAccountServicePositionsShowsHedgeLegsSeparately(t *testing.T) {
+	reader := &fakeAccountReader{
+		positions: []ports.CollateralPosition{
+			{PositionID: 2, Market: "BTC_PERP", PositionSide: "short", Amount: "-0.02", PnL: "-1.5"},
+			{PositionID: 3, Market: "ETH_PERP", PositionSide: "long", Amount: "1"},
+			{PositionID: 1, Market: "BTC_PERP", PositionSide: "long", Amount: "0.05", PnL: "12.3"},
+		},
+	}
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: ptrutil.Ptr(true)}}
+	executor := &fakeOrderExecutor{}
+	service := NewAccountService(credentialStore, sessionStore, executor, reader, fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)})
+
+	result, err := service.Positions(context.Background(), PositionsRequest{Market: " BTC_PERP "})
+	if err != nil {
+		t.Fatalf("positions failed: %v", err)
+	}
+	if !result.HedgeMode || executor.getHedgeModeCalls != 0 {
+		t.Fatalf("expected cached hedge mode, got hedge=%t calls=%d", result.HedgeMode, executor.getHedgeModeCalls)
+	}
+	if reader.markets[0] != "BTC_PERP" {
+		t.Fatalf("expected trimmed market filter, got %q", reader.markets[0])
+	}
+	if len(result.Positions) != 3 {
+		t.Fatalf("expected 3 legs, got %+v", result.Positions)
+	}
+
+	first, second := result.Positions[0], result.Positions[1]
+	if first.PositionID != 1 || first.Side != "long" || first.Amount != "0.05" || first.UnrealizedPnL != "12.3" {
+		t.Fatalf("unexpected long leg: %+v", first)
+	}
+	if second.PositionID != 2 || second.Side != "short" || second.Amount != "0.02" {
+		t.Fatalf("unexpected short leg: %+v", second)
+	}
+}
+
+func TestAccountServicePositionsDerivesOneWaySideFromAmountSign(t *testing.T) {
+	reader := &fakeAccountReader{
+		positions: []ports.CollateralPosition{{PositionID: 7, Market: "BTC_PERP", Amount: "-0.3"}},
+	}
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: ptrutil.Ptr(false)}}
+	service := NewAccountService(credentialStore, sessionStore, &fakeOrderExecutor{}, reader, fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)})
+
+	result, err := service.Positions(context.Background(), PositionsRequest{})
+	if err != nil {
+		t.Fatalf("positions failed: %v", err)
+	}
+	if result.HedgeMode || result.Positions[0].Side != "short" || result.Positions[0].Amount != "0.3" {
+		t.Fatalf("unexpected one-way position: %+v", result)
+	}
+}
+
+func TestAccountServiceAccountComputesMarginUsage(t *testing.T) {
+	reader := &fakeAccountReader{
+		summary: ports.CollateralAccountSummary{Equity: "1500", Margin: "250", FreeMargin: "1250", PnL: "12.5"},
+		balances: []ports.CollateralBalance{
+			{Asset: "BTC", Amount: "0"},
+			{Asset: "USDT", Amount: "1487.5"},
+		},
+	}
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: ptrutil.Ptr(true)}}
+	service := NewAccountService(credentialStore, sessionStore, &fakeOrderExecutor{}, reader, fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)})
+
+	result, err := service.Account(context.Background())
+	if err != nil {
+		t.Fatalf("account failed: %v", err)
+	}
+	if result.MarginUsagePercent != "16.67" {
+		t.Fatalf("expected margin usage 16.67, got %q", result.MarginUsagePercent)
+	}
+	if len(result.Balances) != 1 || result.Balances[0].Asset != "USDT" {
+		t.Fatalf("expected only non-zero balances, got %+v", result.Balances)
+	}
+	if !result.HedgeMode || result.UnrealizedPnL != "12.5" {
+		t.Fatalf("unexpected account result: %+v", result)
+	}
+}
+
+func TestMarginUsagePercentEmptyWithoutEquity(t *testing.T) {
+	if got := marginUsagePercent("10", "0"); got != "" {
+		t.Fatalf("expected empty usage for zero equity, got %q", got)
+	}
+}
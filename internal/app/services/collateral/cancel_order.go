@@ -0,0 +1,180 @@
+package collateral
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+const (
+	cancelOrderMode        = "cancel"
+	collateralCancelPrefix = "cancel"
+)
+
+var (
+	// ErrCancelMarketRequired indicates cancel request without market.
+	ErrCancelMarketRequired = errors.New("market is required to cancel orders")
+	// ErrCancelTargetRequired indicates cancel request without any selection mode.
+	ErrCancelTargetRequired = errors.New("select orders by order id, client order id, client order id prefix or all")
+	// ErrCancelTargetConflict indicates cancel request combining selection modes.
+	ErrCancelTargetConflict = errors.New("order ids, client order id prefix and all cannot be combined")
+)
+
+// CancelOrderRequest is input for collateral order cancellation use-case.
+// Exactly one mode is used: explicit OrderIDs/ClientOrderIDs, ClientOrderIDPrefix, or All.
+type CancelOrderRequest struct {
+	Market              string
+	OrderIDs            []int64
+	ClientOrderIDs      []string
+	ClientOrderIDPrefix string
+	All                 bool
+}
+
+// CancelOrderService cancels collateral orders and reports per-order outcomes.
+type CancelOrderService struct {
+	credentialStore ports.CredentialStore
+	orderManager    ports.CollateralOrderManager
+	clock           ports.Clock
+}
+
+// NewCancelOrderService constructs CancelOrderService.
+func NewCancelOrderService(
+	credentialStore ports.CredentialStore,
+	orderManager ports.CollateralOrderManager,
+	clock ports.Clock,
+) *CancelOrderService {
+	return &CancelOrderService{
+		credentialStore: credentialStore,
+		orderManager:    orderManager,
+		clock:           clock,
+	}
+}
+
+// Execute cancels selected orders. Per-order failures are reported in result errors;
+// an error is returned only when nothing could be attempted.
+func (service *CancelOrderService) Execute(ctx context.Context, request CancelOrderRequest) (PlaceOrderResult, error) {
+	market := strings.TrimSpace(request.Market)
+	if market == "" {
+		return PlaceOrderResult{}, ErrCancelMarketRequired
+	}
+	if err := validateCancelTargets(request); err != nil {
+		return PlaceOrderResult{}, err
+	}
+
+	credential, err := service.credentialStore.Load(ctx)
+	if err != nil {
+		return PlaceOrderResult{}, fmt.Errorf("load credential: %w", err)
+	}
+	defer domainauth.WipeBytes(credential.APISecret)
+
+	result := PlaceOrderResult{
+		RequestID: fmt.Sprintf("%s-%d", collateralCancelPrefix, service.clock.Now().UTC().UnixNano()),
+		Mode:      cancelOrderMode,
+		Errors:    []string{},
+	}
+
+	if request.All {
+		active, err := service.orderManager.ListActiveOrders(ctx, credential, market)
+		if err != nil {
+			return PlaceOrderResult{}, fmt.Errorf("list active orders: %w", err)
+		}
+		if err := service.orderManager.CancelAllOrders(ctx, credential, market); err != nil {
+			return PlaceOrderResult{}, fmt.Errorf("cancel all orders: %w", err)
+		}
+
+		result.OrdersPlanned = len(active)
+		result.OrdersSubmitted = len(active)
+		return result, nil
+	}
+
+	targets, err := service.cancelTargets(ctx, credential, market, request)
+	if err != nil {
+		return PlaceOrderResult{}, err
+	}
+
+	result.OrdersPlanned = len(targets)
+	for _, target := range targets {
+		if err := service.orderManager.CancelOrder(ctx, credential, target); err != nil {
+			result.OrdersFailed++
+			result.Errors = append(result.Errors, fmt.Sprintf("%s: %s", describeCancelTarget(target), singleLineError(err)))
+			continue
+		}
+		result.OrdersSubmitted++
+	}
+
+	return result, nil
+}
+
+func (service *CancelOrderService) cancelTargets(
+	ctx context.Context,
+	credential domainauth.Credential,
+	market string,
+	request CancelOrderRequest,
+) ([]ports.CollateralCancelOrderRequest, error) {
+	prefix := strings.TrimSpace(request.ClientOrderIDPrefix)
+	if prefix == "" {
+		targets := make([]ports.CollateralCancelOrderRequest, 0, len(request.OrderIDs)+len(request.ClientOrderIDs))
+		for _, orderID := range request.OrderIDs {
+			targets = append(targets, ports.CollateralCancelOrderRequest{Market: market, OrderID: orderID})
+		}
+		for _, clientOrderID := range request.ClientOrderIDs {
+			targets = append(targets, ports.CollateralCancelOrderRequest{Market: market, ClientOrderID: clientOrderID})
+		}
+
+		return targets, nil
+	}
+
+	active, err := service.orderManager.ListActiveOrders(ctx, credential, market)
+	if err != nil {
+		return nil, fmt.Errorf("list active orders: %w", err)
+	}
+
+	targets := make([]ports.CollateralCancelOrderRequest, 0, len(active))
+	for _, order := range active {
+		if strings.HasPrefix(order.ClientOrderID, prefix) {
+			targets = append(targets, ports.CollateralCancelOrderRequest{Market: market, OrderID: order.OrderID})
+		}
+	}
+
+	return targets, nil
+}
+
+func validateCancelTargets(request CancelOrderRequest) error {
+	modes := 0
+	if len(request.OrderIDs) > 0 || len(request.ClientOrderIDs) > 0 {
+		modes++
+	}
+	if strings.TrimSpace(request.ClientOrderIDPrefix) != "" {
+		modes++
+	}
+	if request.All {
+		modes++
+	}
+
+	switch {
+	case modes == 0:
+		return ErrCancelTargetRequired
+	case modes > 1:
+		return ErrCancelTargetConflict
+	default:
+		return nil
+	}
+}
+
+func describeCancelTarget(target ports.CollateralCancelOrderRequest) string {
+	if target.ClientOrderID != "" {
+		return "client_order_id " + target.ClientOrderID
+	}
+
+	return "order_id " + strconv.FormatInt(target.OrderID, 10)
+}
+
+// singleLineError keeps multi-line API errors readable inside errors[] output.
+func singleLineError(err error) string {
+	return strings.ReplaceAll(err.Error(), "\n", ": ")
+}
@@ -0,0 +1,150 @@
+package collateral
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+type fakeOrderManager struct {
+	activeOrders   []ports.CollateralOrder
+	listErr        error
+	cancelErrs     map[int64]error
+	cancelAllErr   error
+	cancelled      []ports.CollateralCancelOrderRequest
+	cancelAllCalls []string
+	listCalls      int
+}
+
+func (manager *fakeOrderManager) CancelOrder(
+	_ context.Context,
+	_ domainauth.Credential,
+	request ports.CollateralCancelOrderRequest,
+) error {
+	manager.cancelled = append(manager.cancelled, request)
+	return manager.cancelErrs[request.OrderID]
+}
+
+func (manager *fakeOrderManager) CancelAllOrders(_ context.Context, _ domainauth.Credential, market string) error {
+	manager.cancelAllCalls = append(manager.cancelAllCalls, market)
+	return manager.cancelAllErr
+}
+
+func (manager *fakeOrderManager) ListActiveOrders(
+	_ context.Context,
+	_ domainauth.Credential,
+	_ string,
+) ([]ports.CollateralOrder, error) {
+	manager.listCalls++
+	return manager.activeOrders, manager.listErr
+}
+
+func TestCancelOrderServiceExecuteByIDsReportsPerOrderFailures(t *testing.T) {
+	orderManager := &fakeOrderManager{
+		cancelErrs: map[int64]error{
+			11: &ports.APIError{Code: ports.CodeUnavailable, Message: "order cancellation failed: exchange unavailable", Details: "order not found"},
+		},
+	}
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	service := NewCancelOrderService(credentialStore, orderManager, fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)})
+
+	result, err := service.Execute(context.Background(), CancelOrderRequest{
+		Market:         "BTC_PERP",
+		OrderIDs:       []int64{10, 11},
+		ClientOrderIDs: []string{"run42-3"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Mode != "cancel" || result.OrdersPlanned != 3 || result.OrdersSubmitted != 2 || result.OrdersFailed != 1 {
+		t.Fatalf("unexpected result counters: %+v", result)
+	}
+	if len(result.Errors) != 1 || result.Errors[0] != "order_id 11: order cancellation failed: exchange unavailable: order not found" {
+		t.Fatalf("unexpected errors: %#v", result.Errors)
+	}
+	if got := orderManager.cancelled[2]; got.ClientOrderID != "run42-3" || got.Market != "BTC_PERP" {
+		t.Fatalf("unexpected client order id target: %+v", got)
+	}
+}
+
+func TestCancelOrderServiceExecuteByPrefixCancelsMatchingActiveOrders(t *testing.T) {
+	orderManager := &fakeOrderManager{
+		activeOrders: []ports.CollateralOrder{
+			{OrderID: 1, ClientOrderID: "run42-0"},
+			{OrderID: 2, ClientOrderID: "run43-0"},
+			{OrderID: 3, ClientOrderID: "run42-1"},
+			{OrderID: 4},
+		},
+	}
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	service := NewCancelOrderService(credentialStore, orderManager, fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)})
+
+	result, err := service.Execute(context.Background(), CancelOrderRequest{Market: "BTC_PERP", ClientOrderIDPrefix: "run42-"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.OrdersPlanned != 2 || result.OrdersSubmitted != 2 {
+		t.Fatalf("unexpected result counters: %+v", result)
+	}
+	if len(orderManager.cancelled) != 2 || orderManager.cancelled[0].OrderID != 1 || orderManager.cancelled[1].OrderID != 3 {
+		t.Fatalf("unexpected cancelled orders: %+v", orderManager.cancelled)
+	}
+}
+
+func TestCancelOrderServiceExecuteAllCountsActiveOrders(t *testing.T) {
+	orderManager := &fakeOrderManager{activeOrders: []ports.CollateralOrder{{OrderID: 1}, {OrderID: 2}}}
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	service := NewCancelOrderService(credentialStore, orderManager, fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)})
+
+	result, err := service.Execute(context.Background(), CancelOrderRequest{Market: "BTC_PERP", All: true})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(orderManager.cancelAllCalls) != 1 || orderManager.cancelAllCalls[0] != "BTC_PERP" {
+		t.Fatalf("expected cancel all for BTC_PERP, got %v", orderManager.cancelAllCalls)
+	}
+	if result.OrdersPlanned != 2 || result.OrdersSubmitted != 2 || !strings.HasPrefix(result.RequestID, "cancel-") {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+}
+
+func TestCancelOrderServiceExecuteValidatesModes(t *testing.T) {
+	testCases := []struct {
+		name    string
+		request CancelOrderRequest
+		wantErr error
+	}{
+		{name: "missing market", request: CancelOrderRequest{All: true}, wantErr: ErrCancelMarketRequired},
+		{name: "no mode", request: CancelOrderRequest{Market: "BTC_PERP"}, wantErr: ErrCancelTargetRequired},
+		{name: "ids and all", request: CancelOrderRequest{Market: "BTC_PERP", OrderIDs: []int64{1}, All: true}, wantErr: ErrCancelTargetConflict},
+		{name: "prefix and all", request: CancelOrderRequest{Market: "BTC_PERP", ClientOrderIDPrefix: "run", All: true}, wantErr: ErrCancelTargetConflict},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			orderManager := &fakeOrderManager{}
+			credentialStore := &fakeCredentialStore{
+				loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+			}
+			service := NewCancelOrderService(credentialStore, orderManager, fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)})
+			_, err := service.Execute(context.Background(), testCase.request)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("expected %v, got %v", testCase.wantErr, err)
+			}
+			if orderManager.listCalls != 0 || len(orderManager.cancelled) != 0 {
+				t.Fatalf("expected no exchange calls")
+			}
+		})
+	}
+}
@@ -0,0 +1,345 @@
+package collateral
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
+)
+
+const (
+	closeStatusOpen            = "open"
+	closeStatusPartiallyFilled = "partially_filled"
+	closeStatusFilled          = "filled"
+)
+
+var (
+	// ErrClosePositionSideInvalid indicates a position side other than long or short.
+	ErrClosePositionSideInvalid = errors.New("position side must be long or short")
+	// ErrCloseSizeConflict indicates both amount and percent set for one close.
+	ErrCloseSizeConflict = errors.New("set either close amount or close percent, not both")
+	// ErrClosePercentInvalid indicates a close percent outside (0, 100].
+	ErrClosePercentInvalid = errors.New("close percent must be greater than 0 and at most 100")
+	// ErrCloseAmountExceedsPosition indicates a close amount larger than the open position.
+	ErrCloseAmountExceedsPosition = errors.New("close amount exceeds open position")
+	// ErrClosePriceRequired indicates a maker close without a limit price.
+	ErrClosePriceRequired = errors.New("maker close requires a limit price; allow taker to close at market")
+	// ErrPositionNotFound indicates no open position leg matching market and side.
+	ErrPositionNotFound = errors.New("no open position")
+)
+
+// ClosePositionRequest is input for close position use-case.
+// Empty Amount and Percent close the whole leg. Without AllowTaker the close is a post-only limit at Price;
+// with AllowTaker it is a market order and Price is ignored.
+type ClosePositionRequest struct {
+	Market        string
+	PositionSide  string
+	Amount        string
+	Percent       string
+	Price         string
+	ClientOrderID string
+	AllowTaker    bool
+	SnapToMarket  bool
+}
+
+// ClosePositionResult reports the reducing order and the realized result of the close.
+// For maker closes nothing is realized yet: ExitPrice is the limit price and ExpectedPnL is what a full fill
+// at that price would realize. For taker closes ExitPrice and RealizedPnL come from the immediate fill
+// and stay empty when the exchange reports none.
+type ClosePositionResult struct {
+	Market          string `json:"market"`
+	PositionSide    string `json:"position_side"`
+	OrderSide       string `json:"order_side"`
+	OrderType       string `json:"order_type"`
+	OrderID         int64  `json:"order_id,omitempty"`
+	ClientOrderID   string `json:"client_order_id,omitempty"`
+	Status          string `json:"status"`
+	PositionAmount  string `json:"position_amount"`
+	CloseAmount     string `json:"close_amount"`
+	FilledAmount    string `json:"filled_amount"`
+	RemainingAmount string `json:"remaining_amount"`
+	BasePrice       string `json:"base_price"`
+	ExitPrice       string `json:"exit_price,omitempty"`
+	RealizedPnL     string `json:"realized_pnl,omitempty"`
+	ExpectedPnL     string `json:"expected_pnl,omitempty"`
+}
+
+// ClosePositionService flattens an open position leg with one reducing order.
+type ClosePositionService struct {
+	credentialStore ports.CredentialStore
+	orderExecutor   ports.CollateralOrderExecutor
+	accountReader   ports.CollateralAccountReader
+	marketInfo      ports.MarketInfoProvider
+	hedgeMode       hedgeModeResolver
+}
+
+// NewClosePositionService constructs ClosePositionService.
+func NewClosePositionService(
+	credentialStore ports.CredentialStore,
+	sessionStore ports.SessionStore,
+	orderExecutor ports.CollateralOrderExecutor,
+	accountReader ports.CollateralAccountReader,
+	marketInfo ports.MarketInfoProvider,
+	clock ports.Clock,
+) *ClosePositionService {
+	return &ClosePositionService{
+		credentialStore: credentialStore,
+		orderExecutor:   orderExecutor,
+		accountReader:   accountReader,
+		marketInfo:      marketInfo,
+		hedgeMode:       newHedgeModeResolver(credentialStore, sessionStore, orderExecutor, clock),
+	}
+}
+
+// Execute closes all or part of the open leg selected by market and position side.
+// The reducing order uses the opposite order side; in hedge mode it keeps the leg's position side.
+func (service *ClosePositionService) Execute(ctx context.Context, request ClosePositionRequest) (ClosePositionResult, error) {
+	positionSide := strings.ToLower(strings.TrimSpace(request.PositionSide))
+	if positionSide != "long" && positionSide != "short" {
+		return ClosePositionResult{}, fmt.Errorf("%w: %q", ErrClosePositionSideInvalid, request.PositionSide)
+	}
+	if strings.TrimSpace(request.Amount) != "" && strings.TrimSpace(request.Percent) != "" {
+		return ClosePositionResult{}, ErrCloseSizeConflict
+	}
+	if !request.AllowTaker && strings.TrimSpace(request.Price) == "" {
+		return ClosePositionResult{}, ErrClosePriceRequired
+	}
+
+	market, err := lookupMarket(ctx, service.marketInfo, request.Market)
+	if err != nil {
+		return ClosePositionResult{}, err
+	}
+
+	credential, err := service.credentialStore.Load(ctx)
+	if err != nil {
+		return ClosePositionResult{}, fmt.Errorf("load credential: %w", err)
+	}
+	defer domainauth.WipeBytes(credential.APISecret)
+	hedgeMode, err := service.hedgeMode.resolve(ctx, credential)
+	if err != nil {
+		return ClosePositionResult{}, fmt.Errorf("resolve hedge mode: %w", err)
+	}
+
+	position, err := service.findPosition(ctx, credential, market.Name, positionSide)
+	if err != nil {
+		return ClosePositionResult{}, err
+	}
+	_, openAmountValue := positionDirection(position)
+	openAmount, err := decimal.Parse(openAmountValue)
+	if err != nil {
+		return ClosePositionResult{}, fmt.Errorf("parse position amount %q: %w", position.Amount, err)
+	}
+
+	closeAmount, err := closeSize(request, openAmount, market.StockPrecision)
+	if err != nil {
+		return ClosePositionResult{}, err
+	}
+
+	orderSide := "sell"
+	if positionSide == "short" {
+		orderSide = "buy"
+	}
+	reducingPositionSide := ""
+	if hedgeMode {
+		reducingPositionSide = positionSide
+	}
+
+	result := ClosePositionResult{
+		Market:         market.Name,
+		PositionSide:   positionSide,
+		OrderSide:      orderSide,
+		ClientOrderID:  request.ClientOrderID,
+		Status:         closeStatusOpen,
+		PositionAmount: openAmount.String(),
+		BasePrice:      position.BasePrice,
+	}
+
+	var filled decimal.Decimal
+	if request.AllowTaker {
+		order := buildTakerOrderRequest(ports.CollateralOrderTypeMarket, PlaceOrderRequest{
+			Market:        market.Name,
+			Side:          orderSide,
+			Amount:        closeAmount.String(),
+			ClientOrderID: request.ClientOrderID,
+		}, false)
+		order.PositionSide = reducingPositionSide
+		if err := applyTakerMarketRules(&order, market, request.SnapToMarket); err != nil {
+			return ClosePositionResult{}, err
+		}
+
+		placed, err := service.orderExecutor.PlaceCollateralTakerOrder(ctx, credential, order)
+		if err != nil {
+			return ClosePositionResult{}, fmt.Errorf("place collateral market close: %w", err)
+		}
+
+		result.OrderType = ports.CollateralOrderTypeMarket
+		result.CloseAmount = order.Amount
+		result.OrderID = placed.OrderID
+		if placed.ClientOrderID != "" {
+			result.ClientOrderID = placed.ClientOrderID
+		}
+		filled, result.ExitPrice = fillAverage(placed)
+	} else {
+		order := buildOrderRequest(PlaceOrderRequest{
+			Market:        market.Name,
+			Side:          orderSide,
+			Amount:        closeAmount.String(),
+			Price:         request.Price,
+			ClientOrderID: request.ClientOrderID,
+		}, false)
+		order.PositionSide = reducingPositionSide
+		if err := applyMarketRules(&order, market, request.SnapToMarket); err != nil {
+			return ClosePositionResult{}, err
+		}
+
+		acknowledgement, err := service.orderExecutor.PlaceCollateralLimitOrder(ctx, credential, order)
+		if err != nil {
+			return ClosePositionResult{}, fmt.Errorf("place collateral limit close: %w", err)
+		}
+
+		placed := toLimitPlacedOrder(acknowledgement, order.ClientOrderID)
+		result.OrderType = OrderTypeLimit
+		result.CloseAmount = order.Amount
+		result.OrderID = placed.OrderID
+		result.ClientOrderID = placed.ClientOrderID
+		result.ExitPrice = order.Price
+	}
+
+	closed := decimal.MustParse(result.CloseAmount)
+	result.FilledAmount = filled.Normalize().String()
+	result.RemainingAmount = openAmount.Sub(filled).Normalize().String()
+	switch {
+	case filled.Sign() > 0 && filled.Cmp(closed) >= 0:
+		result.Status = closeStatusFilled
+	case filled.Sign() > 0:
+		result.Status = closeStatusPartiallyFilled
+	}
+
+	if result.OrderType == OrderTypeLimit {
+		result.ExpectedPnL = realizedPnL(positionSide, position.BasePrice, result.ExitPrice, closed)
+	} else {
+		result.RealizedPnL = realizedPnL(positionSide, position.BasePrice, result.ExitPrice, filled)
+	}
+
+	return result, nil
+}
+
+func (service *ClosePositionService) findPosition(
+	ctx context.Context,
+	credential domainauth.Credential,
+	market string,
+	positionSide string,
+) (ports.CollateralPosition, error) {
+	positions, err := service.accountReader.OpenPositions(ctx, credential, market)
+	if err != nil {
+		return ports.CollateralPosition{}, fmt.Errorf("list open positions: %w", err)
+	}
+
+	for _, position := range positions {
+		if !strings.EqualFold(position.Market, market) {
+			continue
+		}
+		if side, _ := positionDirection(position); side == positionSide {
+			return position, nil
+		}
+	}
+
+	return ports.CollateralPosition{}, fmt.Errorf("%w: %s %s", ErrPositionNotFound, market, positionSide)
+}
+
+// closeSize resolves the amount to close from request amount, percent or the whole leg.
+// Percent sizes are truncated to stock precision so a close never exceeds the requested share.
+func closeSize(request ClosePositionRequest, openAmount decimal.Decimal, stockPrecision int) (decimal.Decimal, error) {
+	if value := strings.TrimSpace(request.Amount); value != "" {
+		amount, err := decimal.Parse(value)
+		if err != nil || amount.Sign() <= 0 {
+			return decimal.Decimal{}, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+		}
+		if amount.Cmp(openAmount) > 0 {
+			return decimal.Decimal{}, fmt.Errorf("%w: open %s, requested %s", ErrCloseAmountExceedsPosition, openAmount, amount.Normalize())
+		}
+
+		return amount, nil
+	}
+
+	if value := strings.TrimSpace(request.Percent); value != "" {
+		percent, err := decimal.Parse(value)
+		if err != nil || percent.Sign() <= 0 || percent.Cmp(decimal.FromInt(100)) > 0 {
+			return decimal.Decimal{}, fmt.Errorf("%w: %q", ErrClosePercentInvalid, value)
+		}
+
+		return openAmount.Mul(percent).Mul(decimal.New(1, 2)).Truncate(stockPrecision), nil
+	}
+
+	return openAmount, nil
+}
+
+// limitOrderAcknowledgement is the part of a limit order acknowledgement the close result reports.
+type limitOrderAcknowledgement struct {
+	OrderID       int64  `json:"orderId"`
+	ClientOrderID string `json:"clientOrderId"`
+}
+
+// toLimitPlacedOrder decodes the order ids of a limit order acknowledgement. An empty or undecodable body
+// still means the order was placed, so it keeps the requested client order id instead of failing.
+func toLimitPlacedOrder(raw json.RawMessage, clientOrderID string) ports.CollateralPlacedOrder {
+	placed := ports.CollateralPlacedOrder{ClientOrderID: clientOrderID}
+
+	var acknowledgement limitOrderAcknowledgement
+	if len(raw) == 0 || json.Unmarshal(raw, &acknowledgement) != nil {
+		return placed
+	}
+
+	placed.OrderID = acknowledgement.OrderID
+	if acknowledgement.ClientOrderID != "" {
+		placed.ClientOrderID = acknowledgement.ClientOrderID
+	}
+
+	return placed
+}
+
+// fillAverage returns filled stock and average fill price, or an empty price without fills.
+func fillAverage(placed ports.CollateralPlacedOrder) (decimal.Decimal, string) {
+	stock, err := decimal.Parse(placed.DealStock)
+	if err != nil || stock.Sign() <= 0 {
+		return decimal.Decimal{}, ""
+	}
+	money, err := decimal.Parse(placed.DealMoney)
+	if err != nil {
+		return stock, ""
+	}
+
+	average, err := money.Quo(stock, 8)
+	if err != nil {
+		return stock, ""
+	}
+
+	return stock, average.Normalize().String()
+}
+
+// realizedPnL is (exit-base)*amount for long legs and (base-exit)*amount for short legs, before fees.
+func realizedPnL(positionSide string, basePrice string, exitPrice string, amount decimal.Decimal) string {
+	if exitPrice == "" || amount.Sign() <= 0 {
+		return ""
+	}
+	base, err := decimal.Parse(basePrice)
+	if err != nil {
+		return ""
+	}
+	exit, err := decimal.Parse(exitPrice)
+	if err != nil {
+		return ""
+	}
+
+	pnl := exit.Sub(base).Mul(amount)
+	if positionSide == "short" {
+		pnl = pnl.Neg()
+	}
+
+	return pnl.Normalize().String()
+}
@@ -0,0 +1,185 @@
+package collateral
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+func hedgedBTCPositions() []ports.CollateralPosition {
+	return []ports.CollateralPosition{
+		{PositionID: 1, Market: "BTC_PERP", PositionSide: "long", Amount: "0.05", BasePrice: "60000"},
+		{PositionID: 2, Market: "BTC_PERP", PositionSide: "short", Amount: "-0.02", BasePrice: "61000"},
+	}
+}
+
+func TestClosePositionServiceMakerPartialCloseInHedgeMode(t *testing.T) {
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(true)}}
+	executor := &fakeOrderExecutor{}
+	service := NewClosePositionService(
+		credentialStore,
+		sessionStore,
+		executor,
+		&fakeAccountReader{positions: hedgedBTCPositions()},
+		testMarketInfo(),
+		fakeClock{now: time.Date(2026, 3, 5, 8, 0, 0, 0, time.UTC)},
+	)
+	executor.limitAck = json.RawMessage(`{"orderId":4242,"clientOrderId":"close-1","amount":"0.025","dealStock":"0"}`)
+
+	result, err := service.Execute(context.Background(), ClosePositionRequest{
+		Market:        "BTC_PERP",
+		PositionSide:  "long",
+		Percent:       "50",
+		Price:         "60500",
+		ClientOrderID: "close-1",
+	})
+	if err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+
+	if len(executor.requests) != 1 {
+		t.Fatalf("expected one limit order, got %d", len(executor.requests))
+	}
+	order := executor.requests[0]
+	if order.Side != "sell" || order.PositionSide != "long" || order.Amount != "0.025" || order.Price != "60500" || !order.PostOnly {
+		t.Fatalf("unexpected reducing order: %+v", order)
+	}
+	if result.Status != closeStatusOpen || result.CloseAmount != "0.025" || result.RemainingAmount != "0.05" {
+		t.Fatalf("unexpected close result: %+v", result)
+	}
+	if result.OrderID != 4242 || result.ClientOrderID != "close-1" {
+		t.Fatalf("expected acknowledged order ids, got order_id=%d client_order_id=%q", result.OrderID, result.ClientOrderID)
+	}
+	if result.RealizedPnL != "" || result.ExpectedPnL != "12.5" {
+		t.Fatalf("expected no realized pnl and expected pnl 12.5, got realized=%q expected=%q", result.RealizedPnL, result.ExpectedPnL)
+	}
+}
+
+func TestClosePositionServiceTakerFullCloseReportsFill(t *testing.T) {
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(true)}}
+	executor := &fakeOrderExecutor{}
+	service := NewClosePositionService(
+		credentialStore,
+		sessionStore,
+		executor,
+		&fakeAccountReader{positions: hedgedBTCPositions()},
+		testMarketInfo(),
+		fakeClock{now: time.Date(2026, 3, 5, 8, 0, 0, 0, time.UTC)},
+	)
+	executor.takerFill = func(request ports.CollateralTakerOrderRequest) ports.CollateralPlacedOrder {
+		return ports.CollateralPlacedOrder{OrderID: 77, Amount: request.Amount, DealStock: "0.02", DealMoney: "1210"}
+	}
+
+	result, err := service.Execute(context.Background(), ClosePositionRequest{
+		Market:       "BTC_PERP",
+		PositionSide: "short",
+		AllowTaker:   true,
+	})
+	if err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+
+	order := executor.takerRequests[0]
+	if order.Type != ports.CollateralOrderTypeMarket || order.Side != "buy" || order.PositionSide != "short" || order.Amount != "0.02" {
+		t.Fatalf("unexpected reducing order: %+v", order)
+	}
+	if result.Status != closeStatusFilled || result.OrderID != 77 || result.ExitPrice != "60500" || result.RemainingAmount != "0" {
+		t.Fatalf("unexpected close result: %+v", result)
+	}
+	if result.RealizedPnL != "10" {
+		t.Fatalf("expected short realized pnl 10, got %q", result.RealizedPnL)
+	}
+}
+
+func TestClosePositionServiceOneWayOmitsPositionSide(t *testing.T) {
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(false)}}
+	executor := &fakeOrderExecutor{}
+	positions := []ports.CollateralPosition{
+		{PositionID: 3, Market: "BTC_PERP", Amount: "-0.3", BasePrice: "60000"},
+	}
+	service := NewClosePositionService(
+		credentialStore,
+		sessionStore,
+		executor,
+		&fakeAccountReader{positions: positions},
+		testMarketInfo(),
+		fakeClock{now: time.Date(2026, 3, 5, 8, 0, 0, 0, time.UTC)},
+	)
+
+	_, err := service.Execute(context.Background(), ClosePositionRequest{
+		Market:       "BTC_PERP",
+		PositionSide: "short",
+		Amount:       "0.1",
+		AllowTaker:   true,
+	})
+	if err != nil {
+		t.Fatalf("close failed: %v", err)
+	}
+
+	order := executor.takerRequests[0]
+	if order.Side != "buy" || order.PositionSide != "" || order.Amount != "0.1" {
+		t.Fatalf("unexpected one-way reducing order: %+v", order)
+	}
+}
+
+func TestClosePositionServiceRejectsInvalidRequests(t *testing.T) {
+	testCases := []struct {
+		name    string
+		request ClosePositionRequest
+		wantErr error
+	}{
+		{name: "bad side", request: ClosePositionRequest{PositionSide: "both", AllowTaker: true}, wantErr: ErrClosePositionSideInvalid},
+		{name: "amount and percent", request: ClosePositionRequest{PositionSide: "long", Amount: "0.01", Percent: "10", AllowTaker: true}, wantErr: ErrCloseSizeConflict},
+		{name: "maker without price", request: ClosePositionRequest{PositionSide: "long"}, wantErr: ErrClosePriceRequired},
+		{name: "percent above 100", request: ClosePositionRequest{PositionSide: "long", Percent: "150", AllowTaker: true}, wantErr: ErrClosePercentInvalid},
+		{name: "amount above position", request: ClosePositionRequest{PositionSide: "long", Amount: "0.06", AllowTaker: true}, wantErr: ErrCloseAmountExceedsPosition},
+		{name: "missing leg", request: ClosePositionRequest{Market: "ETH_PERP", PositionSide: "long", AllowTaker: true}, wantErr: ErrPositionNotFound},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			credentialStore := &fakeCredentialStore{
+				loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+			}
+			sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(true)}}
+			executor := &fakeOrderExecutor{}
+			service := NewClosePositionService(
+				credentialStore,
+				sessionStore,
+				executor,
+				&fakeAccountReader{positions: hedgedBTCPositions()},
+				testMarketInfo(),
+				fakeClock{now: time.Date(2026, 3, 5, 8, 0, 0, 0, time.UTC)},
+			)
+			request := testCase.request
+			if request.Market == "" {
+				request.Market = "BTC_PERP"
+			}
+			if request.Market == "ETH_PERP" {
+				service.marketInfo = &fakeMarketInfo{markets: []ports.MarketInfo{{Name: "ETH_PERP", StockPrecision: 8, MoneyPrecision: 2, TradesEnabled: true, IsCollateral: true}}}
+			}
+
+			_, err := service.Execute(context.Background(), request)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("expected %v, got %v", testCase.wantErr, err)
+			}
+			if len(executor.requests) != 0 || len(executor.takerRequests) != 0 {
+				t.Fatalf("expected no order submission")
+			}
+		})
+	}
+}
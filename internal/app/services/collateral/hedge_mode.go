@@ -0,0 +1,82 @@
+package collateral
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+	"github.com/ChewX3D/crypto/internal/ptrutil"
+)
+
+// hedgeModeResolver reads account hedge mode from session cache and refreshes it from the exchange when needed.
+type hedgeModeResolver struct {
+	credentialStore ports.CredentialStore
+	sessionStore    ports.SessionStore
+	orderExecutor   ports.CollateralOrderExecutor
+	clock           ports.Clock
+}
+
+func newHedgeModeResolver(
+	credentialStore ports.CredentialStore,
+	sessionStore ports.SessionStore,
+	orderExecutor ports.CollateralOrderExecutor,
+	clock ports.Clock,
+) hedgeModeResolver {
+	return hedgeModeResolver{
+		credentialStore: credentialStore,
+		sessionStore:    sessionStore,
+		orderExecutor:   orderExecutor,
+		clock:           clock,
+	}
+}
+
+func (resolver hedgeModeResolver) resolve(ctx context.Context, credential domainauth.Credential) (bool, error) {
+	session, found, err := resolver.sessionStore.GetSession(ctx)
+	if err != nil {
+		return false, fmt.Errorf("read session metadata: %w", err)
+	}
+	if found && session.HedgeMode != nil {
+		return *session.HedgeMode, nil
+	}
+
+	return resolver.refresh(ctx, credential)
+}
+
+func (resolver hedgeModeResolver) refresh(ctx context.Context, credential domainauth.Credential) (bool, error) {
+	value, err := resolver.orderExecutor.GetCollateralAccountHedgeMode(ctx, credential)
+	if err != nil {
+		return false, err
+	}
+	if err := resolver.persist(ctx, value); err != nil {
+		return false, err
+	}
+
+	return value, nil
+}
+
+func (resolver hedgeModeResolver) persist(ctx context.Context, hedgeMode bool) error {
+	session, found, err := resolver.sessionStore.GetSession(ctx)
+	if err != nil {
+		return fmt.Errorf("read session metadata: %w", err)
+	}
+
+	now := resolver.clock.Now().UTC()
+	if !found {
+		session = ports.SessionMetadata{
+			Backend:   resolver.credentialStore.BackendName(),
+			CreatedAt: now,
+		}
+	}
+	if session.CreatedAt.IsZero() {
+		session.CreatedAt = now
+	}
+
+	session.UpdatedAt = now
+	session.HedgeMode = ptrutil.Ptr(hedgeMode)
+	if err := resolver.sessionStore.SaveSession(ctx, session); err != nil {
+		return fmt.Errorf("save session metadata: %w", err)
+	}
+
+	return nil
+}
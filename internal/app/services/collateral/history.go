@@ -0,0 +1,240 @@
+package collateral
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+const (
+	// historyPageSize and historyMaxOffset follow WhiteBIT history endpoint limits.
+	historyPageSize  = 100
+	historyMaxOffset = 10000
+)
+
+// ErrHistoryWindowInvalid indicates history window whose end is not after its start.
+var ErrHistoryWindowInvalid = errors.New("history window end must be after start")
+
+// HistoryRequest is input for order history and trades use-cases.
+// Zero Since/Until leave the window open on that side; Since is inclusive and Until exclusive.
+type HistoryRequest struct {
+	Market string
+	Since  time.Time
+	Until  time.Time
+}
+
+// HistoryOrder is normalized view of one finished order.
+type HistoryOrder struct {
+	OrderID       int64     `json:"order_id"`
+	ClientOrderID string    `json:"client_order_id,omitempty"`
+	Market        string    `json:"market"`
+	Side          string    `json:"side"`
+	PositionSide  string    `json:"position_side,omitempty"`
+	Type          string    `json:"type"`
+	Status        string    `json:"status"`
+	Price         string    `json:"price"`
+	Amount        string    `json:"amount"`
+	DealStock     string    `json:"deal_stock"`
+	DealMoney     string    `json:"deal_money"`
+	DealFee       string    `json:"deal_fee"`
+	PostOnly      bool      `json:"post_only"`
+	CreatedAt     time.Time `json:"created_at"`
+	FinishedAt    time.Time `json:"finished_at"`
+}
+
+// OrderHistoryResult is normalized output for order history use-case.
+// Truncated is set when the exchange offset limit stopped pagination before the window start.
+type OrderHistoryResult struct {
+	Orders    []HistoryOrder `json:"orders"`
+	Truncated bool           `json:"truncated"`
+}
+
+// Trade is normalized view of one fill.
+type Trade struct {
+	TradeID       int64     `json:"trade_id"`
+	OrderID       int64     `json:"order_id"`
+	ClientOrderID string    `json:"client_order_id,omitempty"`
+	Market        string    `json:"market"`
+	Side          string    `json:"side"`
+	Role          string    `json:"role"`
+	Price         string    `json:"price"`
+	Amount        string    `json:"amount"`
+	Deal          string    `json:"deal"`
+	Fee           string    `json:"fee"`
+	FeeAsset      string    `json:"fee_asset"`
+	ExecutedAt    time.Time `json:"executed_at"`
+}
+
+// TradesResult is normalized output for trades use-case.
+// Truncated is set when the exchange offset limit stopped pagination before the window start.
+type TradesResult struct {
+	Trades    []Trade `json:"trades"`
+	Truncated bool    `json:"truncated"`
+}
+
+// HistoryService reads finished orders and fills over a time window.
+type HistoryService struct {
+	credentialStore ports.CredentialStore
+	historyReader   ports.CollateralHistoryReader
+}
+
+// NewHistoryService constructs HistoryService.
+func NewHistoryService(
+	credentialStore ports.CredentialStore,
+	historyReader ports.CollateralHistoryReader,
+) *HistoryService {
+	return &HistoryService{
+		credentialStore: credentialStore,
+		historyReader:   historyReader,
+	}
+}
+
+// OrderHistory returns finished orders inside window, oldest first.
+func (service *HistoryService) OrderHistory(ctx context.Context, request HistoryRequest) (OrderHistoryResult, error) {
+	credential, err := service.prepare(ctx, request)
+	if err != nil {
+		return OrderHistoryResult{}, err
+	}
+	defer domainauth.WipeBytes(credential.APISecret)
+
+	entries, truncated, err := collectHistory(
+		request,
+		func(offset int) ([]ports.CollateralOrderHistoryEntry, error) {
+			return service.historyReader.OrderHistoryPage(ctx, credential, historyPage(request, offset))
+		},
+		func(entry ports.CollateralOrderHistoryEntry) time.Time { return entry.FinishedAt },
+	)
+	if err != nil {
+		return OrderHistoryResult{}, fmt.Errorf("query order history: %w", err)
+	}
+
+	orders := make([]HistoryOrder, 0, len(entries))
+	for _, entry := range entries {
+		orders = append(orders, HistoryOrder{
+			OrderID:       entry.OrderID,
+			ClientOrderID: entry.ClientOrderID,
+			Market:        entry.Market,
+			Side:          entry.Side,
+			PositionSide:  entry.PositionSide,
+			Type:          entry.Type,
+			Status:        entry.Status,
+			Price:         entry.Price,
+			Amount:        entry.Amount,
+			DealStock:     entry.DealStock,
+			DealMoney:     entry.DealMoney,
+			DealFee:       entry.DealFee,
+			PostOnly:      entry.PostOnly,
+			CreatedAt:     entry.CreatedAt,
+			FinishedAt:    entry.FinishedAt,
+		})
+	}
+
+	return OrderHistoryResult{Orders: orders, Truncated: truncated}, nil
+}
+
+// Trades returns fills inside window, oldest first.
+func (service *HistoryService) Trades(ctx context.Context, request HistoryRequest) (TradesResult, error) {
+	credential, err := service.prepare(ctx, request)
+	if err != nil {
+		return TradesResult{}, err
+	}
+	defer domainauth.WipeBytes(credential.APISecret)
+
+	entries, truncated, err := collectHistory(
+		request,
+		func(offset int) ([]ports.CollateralTrade, error) {
+			return service.historyReader.TradesPage(ctx, credential, historyPage(request, offset))
+		},
+		func(entry ports.CollateralTrade) time.Time { return entry.ExecutedAt },
+	)
+	if err != nil {
+		return TradesResult{}, fmt.Errorf("query trades: %w", err)
+	}
+
+	trades := make([]Trade, 0, len(entries))
+	for _, entry := range entries {
+		trades = append(trades, Trade{
+			TradeID:       entry.TradeID,
+			OrderID:       entry.OrderID,
+			ClientOrderID: entry.ClientOrderID,
+			Market:        entry.Market,
+			Side:          entry.Side,
+			Role:          entry.Role,
+			Price:         entry.Price,
+			Amount:        entry.Amount,
+			Deal:          entry.Deal,
+			Fee:           entry.Fee,
+			FeeAsset:      entry.FeeAsset,
+			ExecutedAt:    entry.ExecutedAt,
+		})
+	}
+
+	return TradesResult{Trades: trades, Truncated: truncated}, nil
+}
+
+func (service *HistoryService) prepare(ctx context.Context, request HistoryRequest) (domainauth.Credential, error) {
+	if !request.Since.IsZero() && !request.Until.IsZero() && !request.Until.After(request.Since) {
+		return domainauth.Credential{}, ErrHistoryWindowInvalid
+	}
+
+	credential, err := service.credentialStore.Load(ctx)
+	if err != nil {
+		return domainauth.Credential{}, fmt.Errorf("load credential: %w", err)
+	}
+
+	return credential, nil
+}
+
+func historyPage(request HistoryRequest, offset int) ports.HistoryPageQuery {
+	return ports.HistoryPageQuery{
+		Market: strings.TrimSpace(request.Market),
+		Limit:  historyPageSize,
+		Offset: offset,
+	}
+}
+
+// collectHistory walks newest-first pages until a short page, a row older than the window start,
+// or the exchange offset limit, and returns rows inside the window oldest first.
+func collectHistory[T any](
+	request HistoryRequest,
+	fetch func(offset int) ([]T, error),
+	timestamp func(T) time.Time,
+) ([]T, bool, error) {
+	rows := make([]T, 0)
+	for offset := 0; ; offset += historyPageSize {
+		page, err := fetch(offset)
+		if err != nil {
+			return nil, false, err
+		}
+
+		reachedStart := false
+		for _, row := range page {
+			at := timestamp(row)
+			if !request.Since.IsZero() && at.Before(request.Since) {
+				reachedStart = true
+				continue
+			}
+			if !request.Until.IsZero() && !at.Before(request.Until) {
+				continue
+			}
+			rows = append(rows, row)
+		}
+
+		if reachedStart || len(page) < historyPageSize {
+			break
+		}
+		if offset+historyPageSize > historyMaxOffset {
+			slices.Reverse(rows)
+			return rows, true, nil
+		}
+	}
+
+	slices.Reverse(rows)
+	return rows, false, nil
+}
@@ -0,0 +1,161 @@
+package collateral
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+type fakeHistoryReader struct {
+	orders  []ports.CollateralOrderHistoryEntry
+	trades  []ports.CollateralTrade
+	queries []ports.HistoryPageQuery
+}
+
+func (reader *fakeHistoryReader) OrderHistoryPage(
+	_ context.Context,
+	_ domainauth.Credential,
+	query ports.HistoryPageQuery,
+) ([]ports.CollateralOrderHistoryEntry, error) {
+	reader.queries = append(reader.queries, query)
+	if query.ClientOrderID == "" {
+		return pageOf(reader.orders, query), nil
+	}
+
+	matching := []ports.CollateralOrderHistoryEntry{}
+	for _, order := range reader.orders {
+		if order.ClientOrderID == query.ClientOrderID {
+			matching = append(matching, order)
+		}
+	}
+
+	return pageOf(matching, query), nil
+}
+
+func (reader *fakeHistoryReader) TradesPage(
+	_ context.Context,
+	_ domainauth.Credential,
+	query ports.HistoryPageQuery,
+) ([]ports.CollateralTrade, error) {
+	reader.queries = append(reader.queries, query)
+	return pageOf(reader.trades, query), nil
+}
+
+func pageOf[T any](rows []T, query ports.HistoryPageQuery) []T {
+	if query.Offset >= len(rows) {
+		return nil
+	}
+
+	return rows[query.Offset:min(query.Offset+query.Limit, len(rows))]
+}
+
+// newestFirstTrades builds count fills one minute apart, newest first like the exchange returns them.
+func newestFirstTrades(newest time.Time, count int) []ports.CollateralTrade {
+	trades := make([]ports.CollateralTrade, 0, count)
+	for index := 0; index < count; index++ {
+		trades = append(trades, ports.CollateralTrade{
+			TradeID:    int64(count - index),
+			Market:     "BTC_PERP",
+			ExecutedAt: newest.Add(-time.Duration(index) * time.Minute),
+		})
+	}
+
+	return trades
+}
+
+func TestHistoryServiceTradesPaginatesUntilWindowStart(t *testing.T) {
+	newest := time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)
+	reader := &fakeHistoryReader{trades: newestFirstTrades(newest, 250)}
+
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	result, err := NewHistoryService(credentialStore, reader).Trades(context.Background(), HistoryRequest{
+		Market: "BTC_PERP",
+		Since:  newest.Add(-150 * time.Minute),
+		Until:  newest.Add(-10 * time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("trades failed: %v", err)
+	}
+
+	if len(reader.queries) != 2 {
+		t.Fatalf("expected pagination to stop after window start page, got %d queries", len(reader.queries))
+	}
+	if reader.queries[1].Offset != historyPageSize || reader.queries[1].Limit != historyPageSize || reader.queries[1].Market != "BTC_PERP" {
+		t.Fatalf("unexpected second page query: %+v", reader.queries[1])
+	}
+	if len(result.Trades) != 140 {
+		t.Fatalf("expected 140 trades in window, got %d", len(result.Trades))
+	}
+	if !result.Trades[0].ExecutedAt.Equal(newest.Add(-150*time.Minute)) || !result.Trades[139].ExecutedAt.Equal(newest.Add(-11*time.Minute)) {
+		t.Fatalf("expected oldest-first window bounds, got %s..%s", result.Trades[0].ExecutedAt, result.Trades[139].ExecutedAt)
+	}
+	if result.Truncated {
+		t.Fatalf("did not expect truncated result")
+	}
+}
+
+func TestHistoryServiceTradesReportsTruncationAtOffsetLimit(t *testing.T) {
+	newest := time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)
+	reader := &fakeHistoryReader{trades: newestFirstTrades(newest, historyMaxOffset+2*historyPageSize)}
+
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	result, err := NewHistoryService(credentialStore, reader).Trades(context.Background(), HistoryRequest{})
+	if err != nil {
+		t.Fatalf("trades failed: %v", err)
+	}
+	if !result.Truncated {
+		t.Fatalf("expected truncated result")
+	}
+	if last := reader.queries[len(reader.queries)-1]; last.Offset != historyMaxOffset {
+		t.Fatalf("expected last query at max offset, got %+v", last)
+	}
+}
+
+func TestHistoryServiceOrderHistoryFiltersByFinishTime(t *testing.T) {
+	finished := time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)
+	reader := &fakeHistoryReader{
+		orders: []ports.CollateralOrderHistoryEntry{
+			{OrderID: 3, FinishedAt: finished.Add(2 * time.Hour)},
+			{OrderID: 2, FinishedAt: finished},
+			{OrderID: 1, FinishedAt: finished.Add(-2 * time.Hour)},
+		},
+	}
+
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	result, err := NewHistoryService(credentialStore, reader).OrderHistory(context.Background(), HistoryRequest{
+		Since: finished.Add(-time.Hour),
+		Until: finished.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("order history failed: %v", err)
+	}
+	if len(result.Orders) != 1 || result.Orders[0].OrderID != 2 {
+		t.Fatalf("expected only order 2, got %+v", result.Orders)
+	}
+}
+
+func TestHistoryServiceRejectsInvertedWindow(t *testing.T) {
+	reader := &fakeHistoryReader{}
+	now := time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)
+
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	_, err := NewHistoryService(credentialStore, reader).Trades(context.Background(), HistoryRequest{Since: now, Until: now})
+	if !errors.Is(err, ErrHistoryWindowInvalid) {
+		t.Fatalf("expected ErrHistoryWindowInvalid, got %v", err)
+	}
+	if len(reader.queries) != 0 {
+		t.Fatalf("expected no queries, got %d", len(reader.queries))
+	}
+}
@@ -0,0 +1,122 @@
+package collateral
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// ErrListSideInvalid indicates unsupported side filter value.
+var ErrListSideInvalid = errors.New("side filter must be one of: buy, long, sell, short")
+
+// ListOrdersRequest is input for active collateral order listing use-case.
+// Empty fields disable the matching filter.
+type ListOrdersRequest struct {
+	Market              string
+	Side                string
+	ClientOrderIDPrefix string
+}
+
+// ListedOrder is normalized view of one active order.
+// Field names follow RangePlanOrder so live orders can be diffed against a range plan.
+type ListedOrder struct {
+	OrderID       int64     `json:"order_id"`
+	ClientOrderID string    `json:"client_order_id,omitempty"`
+	Market        string    `json:"market"`
+	Side          string    `json:"side"`
+	PositionSide  string    `json:"position_side,omitempty"`
+	Type          string    `json:"type"`
+	Price         string    `json:"price"`
+	Amount        string    `json:"amount"`
+	Left          string    `json:"left"`
+	PostOnly      bool      `json:"post_only"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// ListOrdersResult is normalized output for active collateral order listing use-case.
+type ListOrdersResult struct {
+	Orders []ListedOrder `json:"orders"`
+}
+
+// ListOrdersService lists active collateral orders with local filters.
+type ListOrdersService struct {
+	credentialStore ports.CredentialStore
+	orderManager    ports.CollateralOrderManager
+}
+
+// NewListOrdersService constructs ListOrdersService.
+func NewListOrdersService(
+	credentialStore ports.CredentialStore,
+	orderManager ports.CollateralOrderManager,
+) *ListOrdersService {
+	return &ListOrdersService{
+		credentialStore: credentialStore,
+		orderManager:    orderManager,
+	}
+}
+
+// Execute lists active orders ordered by creation time, oldest first.
+func (service *ListOrdersService) Execute(ctx context.Context, request ListOrdersRequest) (ListOrdersResult, error) {
+	side := ""
+	if strings.TrimSpace(request.Side) != "" {
+		side, _ = resolveOrderSides(request.Side, false)
+		if side != "buy" && side != "sell" {
+			return ListOrdersResult{}, ErrListSideInvalid
+		}
+	}
+
+	credential, err := service.credentialStore.Load(ctx)
+	if err != nil {
+		return ListOrdersResult{}, fmt.Errorf("load credential: %w", err)
+	}
+	defer domainauth.WipeBytes(credential.APISecret)
+
+	active, err := service.orderManager.ListActiveOrders(ctx, credential, strings.TrimSpace(request.Market))
+	if err != nil {
+		return ListOrdersResult{}, fmt.Errorf("list active orders: %w", err)
+	}
+
+	prefix := strings.TrimSpace(request.ClientOrderIDPrefix)
+	orders := make([]ListedOrder, 0, len(active))
+	for _, order := range active {
+		if side != "" && order.Side != side {
+			continue
+		}
+		if prefix != "" && !strings.HasPrefix(order.ClientOrderID, prefix) {
+			continue
+		}
+		orders = append(orders, toListedOrder(order))
+	}
+
+	sort.SliceStable(orders, func(left int, right int) bool {
+		if !orders[left].CreatedAt.Equal(orders[right].CreatedAt) {
+			return orders[left].CreatedAt.Before(orders[right].CreatedAt)
+		}
+
+		return orders[left].OrderID < orders[right].OrderID
+	})
+
+	return ListOrdersResult{Orders: orders}, nil
+}
+
+func toListedOrder(order ports.CollateralOrder) ListedOrder {
+	return ListedOrder{
+		OrderID:       order.OrderID,
+		ClientOrderID: order.ClientOrderID,
+		Market:        order.Market,
+		Side:          order.Side,
+		PositionSide:  order.PositionSide,
+		Type:          order.Type,
+		Price:         order.Price,
+		Amount:        order.Amount,
+		Left:          order.Left,
+		PostOnly:      order.PostOnly,
+		CreatedAt:     order.CreatedAt,
+	}
+}
@@ -0,0 +1,69 @@
+package collateral
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+func TestListOrdersServiceExecuteFiltersAndSortsOrders(t *testing.T) {
+	createdAt := time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)
+	orderManager := &fakeOrderManager{
+		activeOrders: []ports.CollateralOrder{
+			{OrderID: 30, ClientOrderID: "run42-2", Market: "BTC_PERP", Side: "buy", Price: "49000", CreatedAt: createdAt.Add(2 * time.Second)},
+			{OrderID: 10, ClientOrderID: "run42-0", Market: "BTC_PERP", Side: "buy", Price: "50000", CreatedAt: createdAt},
+			{OrderID: 20, ClientOrderID: "run42-1", Market: "BTC_PERP", Side: "sell", Price: "51000", CreatedAt: createdAt.Add(time.Second)},
+			{OrderID: 40, ClientOrderID: "manual-1", Market: "BTC_PERP", Side: "buy", Price: "48000", CreatedAt: createdAt},
+		},
+	}
+
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	result, err := NewListOrdersService(credentialStore, orderManager).Execute(context.Background(), ListOrdersRequest{
+		Market:              "BTC_PERP",
+		Side:                "long",
+		ClientOrderIDPrefix: "run42-",
+	})
+	if err != nil {
+		t.Fatalf("execute failed: %v", err)
+	}
+	if len(result.Orders) != 2 {
+		t.Fatalf("expected 2 orders, got %+v", result.Orders)
+	}
+	if result.Orders[0].OrderID != 10 || result.Orders[1].OrderID != 30 {
+		t.Fatalf("expected orders sorted by creation time, got %+v", result.Orders)
+	}
+}
+
+func TestListOrdersServiceExecuteRejectsUnknownSide(t *testing.T) {
+	orderManager := &fakeOrderManager{}
+
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	_, err := NewListOrdersService(credentialStore, orderManager).Execute(context.Background(), ListOrdersRequest{Side: "up"})
+	if !errors.Is(err, ErrListSideInvalid) {
+		t.Fatalf("expected ErrListSideInvalid, got %v", err)
+	}
+	if orderManager.listCalls != 0 {
+		t.Fatalf("expected no list call, got %d", orderManager.listCalls)
+	}
+}
+
+func TestListOrdersServiceExecuteReturnsEmptySlice(t *testing.T) {
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	result, err := NewListOrdersService(credentialStore, &fakeOrderManager{}).Execute(context.Background(), ListOrdersRequest{})
+	if err != nil {
+		t.Fatalf("execute failed: %v", err)
+	}
+	if result.Orders == nil || len(result.Orders) != 0 {
+		t.Fatalf("expected empty non-nil orders, got %#v", result.Orders)
+	}
+}
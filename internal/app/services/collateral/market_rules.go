@@ -0,0 +1,201 @@
+package collateral
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
+)
+
+var (
+	// ErrMarketTradingDisabled indicates a market with trading disabled by the exchange.
+	ErrMarketTradingDisabled = errors.New("market trading is disabled")
+	// ErrMarketNotCollateral indicates a market that does not support collateral orders.
+	ErrMarketNotCollateral = errors.New("market does not support collateral trading")
+	// ErrMarketAmountPrecision indicates an amount with more decimals than market stock precision.
+	ErrMarketAmountPrecision = errors.New("amount exceeds market precision")
+	// ErrMarketPricePrecision indicates a price off the market money precision or tick size.
+	ErrMarketPricePrecision = errors.New("price does not match market tick size")
+	// ErrMarketMinAmount indicates an amount below market minimum.
+	ErrMarketMinAmount = errors.New("amount is below market minimum")
+	// ErrMarketMinTotal indicates price*amount below market minimum total.
+	ErrMarketMinTotal = errors.New("order total is below market minimum")
+	// ErrMarketMaxTotal indicates price*amount above market maximum total.
+	ErrMarketMaxTotal = errors.New("order total is above market maximum")
+)
+
+// lookupMarket returns trading rules for market from provider.
+func lookupMarket(ctx context.Context, provider ports.MarketInfoProvider, market string) (ports.MarketInfo, error) {
+	markets, err := provider.ListMarkets(ctx)
+	if err != nil {
+		return ports.MarketInfo{}, fmt.Errorf("load market info: %w", err)
+	}
+
+	name := strings.TrimSpace(market)
+	for _, info := range markets {
+		if strings.EqualFold(info.Name, name) {
+			return info, nil
+		}
+	}
+
+	return ports.MarketInfo{}, fmt.Errorf("%w: %s", ports.ErrMarketNotFound, name)
+}
+
+// applyMarketRules validates order price and amount against market rules before a signed request is sent.
+// With snap, amount is truncated to stock precision and price moves to the tick away from the book
+// (down for buy, up for sell); minimum and maximum limits are never snapped.
+// Attached stop-loss and take-profit prices follow the same tick rules.
+func applyMarketRules(order *ports.CollateralLimitOrderRequest, info ports.MarketInfo, snap bool) error {
+	if err := checkMarketTradable(info); err != nil {
+		return err
+	}
+
+	amount, err := checkMarketAmount(order.Amount, info, snap)
+	if err != nil {
+		return err
+	}
+	tick := marketTickSize(info)
+	price, err := checkMarketPrice(order.Price, order.Side, info.Name, tick, snap, ErrInvalidPrice)
+	if err != nil {
+		return err
+	}
+	if err := checkMarketTotal(price, amount, info); err != nil {
+		return err
+	}
+
+	if err := applyStopLevelRules(order, price, tick, snap); err != nil {
+		return err
+	}
+
+	order.Amount = amount.Normalize().String()
+	order.Price = price.Normalize().String()
+
+	return nil
+}
+
+// applyTakerMarketRules validates market, stop-market and stop-limit orders.
+// Stop-limit price follows limit rules and activation price follows the same tick rules.
+// Market orders carry no price, so their total is not checked; stop-market totals use the activation price.
+func applyTakerMarketRules(order *ports.CollateralTakerOrderRequest, info ports.MarketInfo, snap bool) error {
+	if err := checkMarketTradable(info); err != nil {
+		return err
+	}
+
+	amount, err := checkMarketAmount(order.Amount, info, snap)
+	if err != nil {
+		return err
+	}
+	order.Amount = amount.Normalize().String()
+
+	tick := marketTickSize(info)
+	if order.Type == ports.CollateralOrderTypeStopLimit {
+		price, err := checkMarketPrice(order.Price, order.Side, info.Name, tick, snap, ErrInvalidPrice)
+		if err != nil {
+			return err
+		}
+		if err := checkMarketTotal(price, amount, info); err != nil {
+			return err
+		}
+		order.Price = price.Normalize().String()
+	}
+
+	if order.Type == ports.CollateralOrderTypeMarket {
+		return nil
+	}
+
+	activation, err := checkMarketPrice(order.ActivationPrice, order.Side, info.Name, tick, snap, ErrInvalidActivationPrice)
+	if err != nil {
+		return err
+	}
+	if order.Type == ports.CollateralOrderTypeStopMarket {
+		if err := checkMarketTotal(activation, amount, info); err != nil {
+			return err
+		}
+	}
+	order.ActivationPrice = activation.Normalize().String()
+
+	return nil
+}
+
+func checkMarketTradable(info ports.MarketInfo) error {
+	if !info.TradesEnabled {
+		return fmt.Errorf("%w: %s", ErrMarketTradingDisabled, info.Name)
+	}
+	if !info.IsCollateral {
+		return fmt.Errorf("%w: %s", ErrMarketNotCollateral, info.Name)
+	}
+
+	return nil
+}
+
+func checkMarketAmount(value string, info ports.MarketInfo, snap bool) (decimal.Decimal, error) {
+	amount, err := decimal.Parse(value)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+
+	if amount.Normalize().Scale() > info.StockPrecision {
+		if !snap {
+			return decimal.Decimal{}, fmt.Errorf("%w: %s allows %d decimals, got %s", ErrMarketAmountPrecision, info.Name, info.StockPrecision, amount)
+		}
+		amount = amount.Truncate(info.StockPrecision)
+	}
+	if amount.Sign() <= 0 || amount.Cmp(info.MinAmount) < 0 {
+		return decimal.Decimal{}, fmt.Errorf("%w: %s minimum %s, got %s", ErrMarketMinAmount, info.Name, info.MinAmount, amount.Normalize())
+	}
+
+	return amount, nil
+}
+
+func checkMarketPrice(
+	value string,
+	side string,
+	market string,
+	tick decimal.Decimal,
+	snap bool,
+	invalid error,
+) (decimal.Decimal, error) {
+	price, err := decimal.Parse(value)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("%w: %q", invalid, value)
+	}
+
+	if !price.IsMultipleOf(tick) {
+		if !snap {
+			return decimal.Decimal{}, fmt.Errorf("%w: %s tick %s, got %s", ErrMarketPricePrecision, market, tick, price)
+		}
+		if side == "sell" {
+			price = price.CeilMultiple(tick)
+		} else {
+			price = price.FloorMultiple(tick)
+		}
+	}
+	if price.Sign() <= 0 {
+		return decimal.Decimal{}, fmt.Errorf("%w: %q", invalid, price.String())
+	}
+
+	return price, nil
+}
+
+func checkMarketTotal(price decimal.Decimal, amount decimal.Decimal, info ports.MarketInfo) error {
+	total := price.Mul(amount)
+	if total.Cmp(info.MinTotal) < 0 {
+		return fmt.Errorf("%w: %s minimum %s, got %s", ErrMarketMinTotal, info.Name, info.MinTotal, total.Normalize())
+	}
+	if info.MaxTotal.Sign() > 0 && total.Cmp(info.MaxTotal) > 0 {
+		return fmt.Errorf("%w: %s maximum %s, got %s", ErrMarketMaxTotal, info.Name, info.MaxTotal, total.Normalize())
+	}
+
+	return nil
+}
+
+func marketTickSize(info ports.MarketInfo) decimal.Decimal {
+	if info.TickSize.Sign() > 0 {
+		return info.TickSize
+	}
+
+	return decimal.New(1, info.MoneyPrecision)
+}
@@ -0,0 +1,191 @@
+package collateral
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
+)
+
+func strictMarketInfo() ports.MarketInfo {
+	return ports.MarketInfo{
+		Name:           "BTC_PERP",
+		StockPrecision: 3,
+		MoneyPrecision: 1,
+		TickSize:       decimal.MustParse("0.5"),
+		MinAmount:      decimal.MustParse("0.001"),
+		MinTotal:       decimal.MustParse("5"),
+		MaxTotal:       decimal.MustParse("100000"),
+		TradesEnabled:  true,
+		IsCollateral:   true,
+	}
+}
+
+func TestApplyMarketRulesRejectsViolations(t *testing.T) {
+	testCases := []struct {
+		name    string
+		order   ports.CollateralLimitOrderRequest
+		market  func(info *ports.MarketInfo)
+		wantErr error
+	}{
+		{name: "amount precision", order: ports.CollateralLimitOrderRequest{Side: "buy", Amount: "0.0015", Price: "49000"}, wantErr: ErrMarketAmountPrecision},
+		{name: "price tick", order: ports.CollateralLimitOrderRequest{Side: "buy", Amount: "0.01", Price: "49000.2"}, wantErr: ErrMarketPricePrecision},
+		{name: "min amount", order: ports.CollateralLimitOrderRequest{Side: "buy", Amount: "0.0001", Price: "49000"}, market: func(info *ports.MarketInfo) { info.StockPrecision = 4 }, wantErr: ErrMarketMinAmount},
+		{name: "min total", order: ports.CollateralLimitOrderRequest{Side: "buy", Amount: "0.001", Price: "4000"}, wantErr: ErrMarketMinTotal},
+		{name: "max total", order: ports.CollateralLimitOrderRequest{Side: "buy", Amount: "3", Price: "49000"}, wantErr: ErrMarketMaxTotal},
+		{name: "trading disabled", order: ports.CollateralLimitOrderRequest{Side: "buy", Amount: "0.01", Price: "49000"}, market: func(info *ports.MarketInfo) { info.TradesEnabled = false }, wantErr: ErrMarketTradingDisabled},
+		{name: "spot market", order: ports.CollateralLimitOrderRequest{Side: "buy", Amount: "0.01", Price: "49000"}, market: func(info *ports.MarketInfo) { info.IsCollateral = false }, wantErr: ErrMarketNotCollateral},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			info := strictMarketInfo()
+			if testCase.market != nil {
+				testCase.market(&info)
+			}
+
+			order := testCase.order
+			err := applyMarketRules(&order, info, false)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("expected %v, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestApplyMarketRulesSnapsAwayFromBook(t *testing.T) {
+	buy := ports.CollateralLimitOrderRequest{Side: "buy", Amount: "0.01299", Price: "49000.3"}
+	if err := applyMarketRules(&buy, strictMarketInfo(), true); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if buy.Amount != "0.012" || buy.Price != "49000" {
+		t.Fatalf("expected buy snapped to 0.012@49000, got %s@%s", buy.Amount, buy.Price)
+	}
+
+	sell := ports.CollateralLimitOrderRequest{Side: "sell", Amount: "0.0100", Price: "49000.3"}
+	if err := applyMarketRules(&sell, strictMarketInfo(), true); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if sell.Amount != "0.01" || sell.Price != "49000.5" {
+		t.Fatalf("expected sell snapped to 0.01@49000.5, got %s@%s", sell.Amount, sell.Price)
+	}
+
+	tooSmall := ports.CollateralLimitOrderRequest{Side: "buy", Amount: "0.0009", Price: "49000"}
+	if err := applyMarketRules(&tooSmall, strictMarketInfo(), true); !errors.Is(err, ErrMarketMinAmount) {
+		t.Fatalf("expected snapping to keep min amount rejection, got %v", err)
+	}
+}
+
+func TestPlaceOrderServiceExecuteRejectsMarketViolationBeforeSigning(t *testing.T) {
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	orderExecutor := &fakeOrderExecutor{}
+	marketInfo := &fakeMarketInfo{markets: []ports.MarketInfo{strictMarketInfo()}}
+	service := NewPlaceOrderService(credentialStore, &fakeSessionStore{}, orderExecutor, marketInfo, fakeClock{now: time.Now()})
+
+	_, err := service.Execute(context.Background(), PlaceOrderRequest{
+		Market: "BTC_PERP",
+		Side:   "buy",
+		Amount: "0.0001",
+		Price:  "49000",
+	})
+	if !errors.Is(err, ErrMarketAmountPrecision) {
+		t.Fatalf("expected amount precision error, got %v", err)
+	}
+	if len(orderExecutor.requests) != 0 || orderExecutor.getHedgeModeCalls != 0 {
+		t.Fatalf("expected no signed request")
+	}
+
+	_, err = service.Execute(context.Background(), PlaceOrderRequest{
+		Market: "ETH_PERP",
+		Side:   "buy",
+		Amount: "0.01",
+		Price:  "3000",
+	})
+	if !errors.Is(err, ports.ErrMarketNotFound) {
+		t.Fatalf("expected market not found, got %v", err)
+	}
+}
+
+func TestPlaceOrderServiceExecuteSnapsToMarket(t *testing.T) {
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	orderExecutor := &fakeOrderExecutor{}
+	marketInfo := &fakeMarketInfo{markets: []ports.MarketInfo{strictMarketInfo()}}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(false)}}
+	service := NewPlaceOrderService(credentialStore, sessionStore, orderExecutor, marketInfo, fakeClock{now: time.Now()})
+
+	_, err := service.Execute(context.Background(), PlaceOrderRequest{
+		Market:       "btc_perp",
+		Side:         "short",
+		Amount:       "0.0105",
+		Price:        "49000.1",
+		SnapToMarket: true,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(orderExecutor.requests) != 1 {
+		t.Fatalf("expected one order request, got %d", len(orderExecutor.requests))
+	}
+	if got := orderExecutor.requests[0]; got.Amount != "0.01" || got.Price != "49000.5" {
+		t.Fatalf("expected snapped 0.01@49000.5, got %s@%s", got.Amount, got.Price)
+	}
+}
+
+func TestRangePlanServiceExecuteReportsOrderIndexOnMarketViolation(t *testing.T) {
+	marketInfo := &fakeMarketInfo{markets: []ports.MarketInfo{strictMarketInfo()}}
+	service := NewRangePlanService(&fakeSessionStore{}, marketInfo, fakeClock{now: time.Now()})
+
+	_, err := service.Execute(context.Background(), RangePlanRequest{
+		Market:     "BTC_PERP",
+		Side:       "buy",
+		StartPrice: decimal.MustParse("49000"),
+		EndPrice:   decimal.MustParse("49001"),
+		Step:       decimal.MustParse("0.25"),
+		AmountMode: AmountModeConstant,
+		BaseAmount: decimal.MustParse("0.01"),
+	})
+	if !errors.Is(err, ErrMarketPricePrecision) {
+		t.Fatalf("expected price precision error, got %v", err)
+	}
+	if got := err.Error(); !strings.HasPrefix(got, "order 1:") {
+		t.Fatalf("expected order index in error, got %q", got)
+	}
+}
+
+func TestApplyTakerMarketRulesChecksStopMarketTotalAtActivation(t *testing.T) {
+	info := strictMarketInfo()
+	order := ports.CollateralTakerOrderRequest{
+		Type:            ports.CollateralOrderTypeStopMarket,
+		Side:            "buy",
+		Amount:          "0.001",
+		ActivationPrice: "4000",
+	}
+
+	err := applyTakerMarketRules(&order, info, false)
+	if !errors.Is(err, ErrMarketMinTotal) {
+		t.Fatalf("expected %v, got %v", ErrMarketMinTotal, err)
+	}
+
+	order.ActivationPrice = "49000.2"
+	if err := applyTakerMarketRules(&order, info, false); !errors.Is(err, ErrMarketPricePrecision) {
+		t.Fatalf("expected %v, got %v", ErrMarketPricePrecision, err)
+	}
+
+	order.Type = ports.CollateralOrderTypeMarket
+	order.ActivationPrice = ""
+	if err := applyTakerMarketRules(&order, info, false); err != nil {
+		t.Fatalf("expected market order without price to pass, got %v", err)
+	}
+	if order.Amount != "0.001" {
+		t.Fatalf("unexpected normalized amount %q", order.Amount)
+	}
+}
@@ -0,0 +1,416 @@
+package collateral
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// RetryClientOrderIDPrefix marks client order ids generated for orders placed without one.
+const RetryClientOrderIDPrefix = "wbcli-"
+
+// ErrOrderStatusUnknown indicates a placement that failed in transport and was not confirmed on the exchange,
+// either because the check failed or because retries ran out. The order may still exist there.
+var ErrOrderStatusUnknown = errors.New("order status unknown after transport error")
+
+// errOrderNotLanded is the cause reported when the last transport failure left no order on the exchange.
+var errOrderNotLanded = errors.New("no matching active or finished order found")
+
+// RetryPolicy bounds retries of transport-class failures. MaxAttempts counts the first attempt.
+type RetryPolicy struct {
+	MaxAttempts int
+	MinBackoff  time.Duration
+	MaxBackoff  time.Duration
+}
+
+// DefaultRetryPolicy retries twice with backoff between 500ms and 4s.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  4 * time.Second,
+}
+
+// RetryingOrderExecutor wraps an order executor and retries only transport-class errors
+// (ports.CodeTransport: network failures, 429 and 5xx) with jittered exponential backoff.
+//
+// Orders without a client order id get a deterministic one before the first attempt, so every
+// retry carries the same id. After each transport failure, active orders and the order history of
+// the market are searched for that id; an order that landed despite the error is returned as
+// placed instead of being sent again. When that check fails, or the last attempt failed and the
+// order was not found, ErrOrderStatusUnknown is returned.
+type RetryingOrderExecutor struct {
+	next         ports.CollateralOrderExecutor
+	orderManager ports.CollateralOrderManager
+	history      ports.CollateralHistoryReader
+	clock        ports.Clock
+	policy       RetryPolicy
+	wait         func(ctx context.Context, delay time.Duration) error
+}
+
+var _ ports.CollateralOrderExecutor = (*RetryingOrderExecutor)(nil)
+
+// NewRetryingOrderExecutor constructs RetryingOrderExecutor. Non-positive policy fields use DefaultRetryPolicy.
+func NewRetryingOrderExecutor(
+	next ports.CollateralOrderExecutor,
+	orderManager ports.CollateralOrderManager,
+	history ports.CollateralHistoryReader,
+	clock ports.Clock,
+	policy RetryPolicy,
+) *RetryingOrderExecutor {
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = DefaultRetryPolicy.MaxAttempts
+	}
+	if policy.MinBackoff <= 0 {
+		policy.MinBackoff = DefaultRetryPolicy.MinBackoff
+	}
+	if policy.MaxBackoff < policy.MinBackoff {
+		policy.MaxBackoff = max(DefaultRetryPolicy.MaxBackoff, policy.MinBackoff)
+	}
+
+	return &RetryingOrderExecutor{
+		next:         next,
+		orderManager: orderManager,
+		history:      history,
+		clock:        clock,
+		policy:       policy,
+		wait:         waitContext,
+	}
+}
+
+// GetCollateralAccountHedgeMode reads hedge mode; the read has no side effects and is retried directly.
+func (executor *RetryingOrderExecutor) GetCollateralAccountHedgeMode(
+	ctx context.Context,
+	credential domainauth.Credential,
+) (bool, error) {
+	for attempt := 1; ; attempt++ {
+		hedgeMode, err := executor.next.GetCollateralAccountHedgeMode(ctx, credential)
+		if err == nil || !executor.shouldRetry(err, attempt) {
+			return hedgeMode, err
+		}
+		if waitErr := executor.wait(ctx, executor.backoff(attempt)); waitErr != nil {
+			return false, err
+		}
+	}
+}
+
+// PlaceCollateralLimitOrder places one limit order. An order found on the exchange after a transport
+// error is acknowledged with its order id, client order id and fills.
+func (executor *RetryingOrderExecutor) PlaceCollateralLimitOrder(
+	ctx context.Context,
+	credential domainauth.Credential,
+	request ports.CollateralLimitOrderRequest,
+) (json.RawMessage, error) {
+	if request.ClientOrderID == "" {
+		request.ClientOrderID = executor.clientOrderID(0, OrderTypeLimit, request.Market, request.Side,
+			request.PositionSide, request.Amount, request.Price, request.StopLoss, request.TakeProfit)
+	}
+
+	clientOrderIDs := []string{request.ClientOrderID}
+	for attempt := 1; ; attempt++ {
+		result, err := executor.next.PlaceCollateralLimitOrder(ctx, credential, request)
+		if err == nil || !isTransportError(err) {
+			return result, err
+		}
+
+		if waitErr := executor.wait(ctx, executor.backoff(attempt)); waitErr != nil {
+			return nil, statusUnknown(err, clientOrderIDs, waitErr)
+		}
+		landed, checkErr := executor.findLanded(ctx, credential, request.Market, clientOrderIDs, err)
+		if checkErr != nil {
+			return nil, checkErr
+		}
+		if placed, ok := landed[request.ClientOrderID]; ok {
+			return landedAcknowledgement(request.Market, placed)
+		}
+		if !executor.shouldRetry(err, attempt) {
+			return nil, statusUnknown(err, clientOrderIDs, errOrderNotLanded)
+		}
+	}
+}
+
+// PlaceCollateralBulkLimitOrder places orders in one bulk request. After a transport error only the
+// orders that did not land are resubmitted; landed orders are reported as accepted.
+func (executor *RetryingOrderExecutor) PlaceCollateralBulkLimitOrder(
+	ctx context.Context,
+	credential domainauth.Credential,
+	orders []ports.CollateralLimitOrderRequest,
+	stopOnFail bool,
+) ([]ports.CollateralBulkOrderResult, error) {
+	orders = append([]ports.CollateralLimitOrderRequest(nil), orders...)
+	for index, order := range orders {
+		if order.ClientOrderID == "" {
+			orders[index].ClientOrderID = executor.clientOrderID(index, OrderTypeLimit, order.Market, order.Side,
+				order.PositionSide, order.Amount, order.Price, order.StopLoss, order.TakeProfit)
+		}
+	}
+
+	results := make([]ports.CollateralBulkOrderResult, len(orders))
+	pending := make([]int, len(orders))
+	for index := range orders {
+		pending[index] = index
+	}
+	// fail reports err for pending orders once some orders already landed, so landed ones stay visible
+	fail := func(err error) ([]ports.CollateralBulkOrderResult, error) {
+		if len(pending) == len(orders) {
+			return nil, err
+		}
+		for _, index := range pending {
+			results[index] = ports.CollateralBulkOrderResult{ClientOrderID: orders[index].ClientOrderID, Error: singleLineError(err)}
+		}
+
+		return results, nil
+	}
+
+	for attempt := 1; ; attempt++ {
+		batch := make([]ports.CollateralLimitOrderRequest, 0, len(pending))
+		for _, index := range pending {
+			batch = append(batch, orders[index])
+		}
+
+		outcomes, err := executor.next.PlaceCollateralBulkLimitOrder(ctx, credential, batch, stopOnFail)
+		if err == nil {
+			for position, index := range pending {
+				if position < len(outcomes) {
+					results[index] = outcomes[position]
+				}
+			}
+
+			return results, nil
+		}
+		if !isTransportError(err) {
+			return fail(err)
+		}
+
+		clientOrderIDs := make([]string, 0, len(pending))
+		byMarket := map[string][]string{}
+		for _, index := range pending {
+			clientOrderIDs = append(clientOrderIDs, orders[index].ClientOrderID)
+			byMarket[orders[index].Market] = append(byMarket[orders[index].Market], orders[index].ClientOrderID)
+		}
+		if waitErr := executor.wait(ctx, executor.backoff(attempt)); waitErr != nil {
+			return fail(statusUnknown(err, clientOrderIDs, waitErr))
+		}
+		landed := map[string]ports.CollateralPlacedOrder{}
+		for market, clientOrderIDs := range byMarket {
+			found, checkErr := executor.findLanded(ctx, credential, market, clientOrderIDs, err)
+			if checkErr != nil {
+				return fail(checkErr)
+			}
+			for clientOrderID, placed := range found {
+				landed[clientOrderID] = placed
+			}
+		}
+
+		remaining := pending[:0]
+		for _, index := range pending {
+			placed, ok := landed[orders[index].ClientOrderID]
+			if !ok {
+				remaining = append(remaining, index)
+				continue
+			}
+			results[index] = ports.CollateralBulkOrderResult{
+				Accepted:      true,
+				OrderID:       placed.OrderID,
+				ClientOrderID: orders[index].ClientOrderID,
+			}
+		}
+		pending = remaining
+		if len(pending) == 0 {
+			return results, nil
+		}
+		if !executor.shouldRetry(err, attempt) {
+			unlanded := make([]string, 0, len(pending))
+			for _, index := range pending {
+				unlanded = append(unlanded, orders[index].ClientOrderID)
+			}
+
+			return fail(statusUnknown(err, unlanded, errOrderNotLanded))
+		}
+	}
+}
+
+// PlaceCollateralTakerOrder places one market, stop-market or stop-limit order.
+func (executor *RetryingOrderExecutor) PlaceCollateralTakerOrder(
+	ctx context.Context,
+	credential domainauth.Credential,
+	request ports.CollateralTakerOrderRequest,
+) (ports.CollateralPlacedOrder, error) {
+	if request.ClientOrderID == "" {
+		request.ClientOrderID = executor.clientOrderID(0, request.Type, request.Market, request.Side,
+			request.PositionSide, request.Amount, request.Price, request.ActivationPrice)
+	}
+
+	clientOrderIDs := []string{request.ClientOrderID}
+	for attempt := 1; ; attempt++ {
+		placed, err := executor.next.PlaceCollateralTakerOrder(ctx, credential, request)
+		if err == nil || !isTransportError(err) {
+			return placed, err
+		}
+
+		if waitErr := executor.wait(ctx, executor.backoff(attempt)); waitErr != nil {
+			return ports.CollateralPlacedOrder{}, statusUnknown(err, clientOrderIDs, waitErr)
+		}
+		landed, checkErr := executor.findLanded(ctx, credential, request.Market, clientOrderIDs, err)
+		if checkErr != nil {
+			return ports.CollateralPlacedOrder{}, checkErr
+		}
+		if placed, ok := landed[request.ClientOrderID]; ok {
+			return placed, nil
+		}
+		if !executor.shouldRetry(err, attempt) {
+			return ports.CollateralPlacedOrder{}, statusUnknown(err, clientOrderIDs, errOrderNotLanded)
+		}
+	}
+}
+
+// findLanded returns which client order ids of market exist on the exchange as active or finished orders.
+// Ids missing from active orders are looked up in order history one by one, filtered by client order id.
+// A failed lookup returns ErrOrderStatusUnknown carrying cause, the placement error being retried.
+func (executor *RetryingOrderExecutor) findLanded(
+	ctx context.Context,
+	credential domainauth.Credential,
+	market string,
+	clientOrderIDs []string,
+	cause error,
+) (map[string]ports.CollateralPlacedOrder, error) {
+	wanted := make(map[string]bool, len(clientOrderIDs))
+	for _, clientOrderID := range clientOrderIDs {
+		wanted[clientOrderID] = true
+	}
+
+	landed := map[string]ports.CollateralPlacedOrder{}
+	active, err := executor.orderManager.ListActiveOrders(ctx, credential, market)
+	if err != nil {
+		return nil, statusUnknown(cause, clientOrderIDs, err)
+	}
+	for _, order := range active {
+		if wanted[order.ClientOrderID] {
+			landed[order.ClientOrderID] = ports.CollateralPlacedOrder{
+				OrderID:       order.OrderID,
+				ClientOrderID: order.ClientOrderID,
+				Amount:        order.Amount,
+				DealStock:     order.DealStock,
+				DealMoney:     order.DealMoney,
+			}
+		}
+	}
+
+	for _, clientOrderID := range clientOrderIDs {
+		if _, ok := landed[clientOrderID]; ok {
+			continue
+		}
+
+		finished, err := executor.history.OrderHistoryPage(ctx, credential, ports.HistoryPageQuery{
+			Market:        market,
+			ClientOrderID: clientOrderID,
+			Limit:         1,
+		})
+		if err != nil {
+			return nil, statusUnknown(cause, clientOrderIDs, err)
+		}
+		for _, order := range finished {
+			if order.ClientOrderID == clientOrderID {
+				landed[clientOrderID] = ports.CollateralPlacedOrder{
+					OrderID:       order.OrderID,
+					ClientOrderID: order.ClientOrderID,
+					Amount:        order.Amount,
+					DealStock:     order.DealStock,
+					DealMoney:     order.DealMoney,
+				}
+			}
+		}
+	}
+
+	return landed, nil
+}
+
+// statusUnknown wraps ErrOrderStatusUnknown around cause, the placement error, and err, why landing is unconfirmed.
+func statusUnknown(cause error, clientOrderIDs []string, err error) error {
+	return fmt.Errorf(
+		"%w: %s; check client order ids %s with wbcli collateral order list before retrying: %v",
+		ErrOrderStatusUnknown,
+		singleLineError(cause),
+		strings.Join(clientOrderIDs, ","),
+		err,
+	)
+}
+
+// landedOrderAcknowledgement mirrors the fields of an exchange limit order acknowledgement known for a landed order.
+type landedOrderAcknowledgement struct {
+	OrderID       int64  `json:"orderId"`
+	ClientOrderID string `json:"clientOrderId"`
+	Market        string `json:"market"`
+	Amount        string `json:"amount"`
+	DealStock     string `json:"dealStock"`
+	DealMoney     string `json:"dealMoney"`
+}
+
+// landedAcknowledgement encodes an order found after a transport error like a limit order acknowledgement.
+func landedAcknowledgement(market string, placed ports.CollateralPlacedOrder) (json.RawMessage, error) {
+	return json.Marshal(landedOrderAcknowledgement{
+		OrderID:       placed.OrderID,
+		ClientOrderID: placed.ClientOrderID,
+		Market:        market,
+		Amount:        placed.Amount,
+		DealStock:     placed.DealStock,
+		DealMoney:     placed.DealMoney,
+	})
+}
+
+func (executor *RetryingOrderExecutor) shouldRetry(err error, attempt int) bool {
+	return attempt < executor.policy.MaxAttempts && isTransportError(err)
+}
+
+// backoff returns a random delay between half and all of MinBackoff*2^(attempt-1), capped at MaxBackoff.
+func (executor *RetryingOrderExecutor) backoff(attempt int) time.Duration {
+	ceiling := executor.policy.MinBackoff
+	for step := 1; step < attempt && ceiling < executor.policy.MaxBackoff; step++ {
+		ceiling *= 2
+	}
+	ceiling = min(ceiling, executor.policy.MaxBackoff)
+
+	return ceiling/2 + rand.N(ceiling/2+1)
+}
+
+// clientOrderID derives a client order id from the placement time, batch index and order fields.
+func (executor *RetryingOrderExecutor) clientOrderID(index int, fields ...string) string {
+	hash := sha256.New()
+	_, _ = hash.Write([]byte(strconv.FormatInt(executor.clock.Now().UnixNano(), 10) + "|" + strconv.Itoa(index)))
+	for _, field := range fields {
+		_, _ = hash.Write([]byte("|" + field))
+	}
+
+	return RetryClientOrderIDPrefix + hex.EncodeToString(hash.Sum(nil))[:16]
+}
+
+// isTransportError reports exchange failures that may succeed when repeated.
+func isTransportError(err error) bool {
+	var apiErr *ports.APIError
+	return errors.As(err, &apiErr) && apiErr.Code == ports.CodeTransport
+}
+
+func waitContext(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
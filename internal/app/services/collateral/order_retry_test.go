@@ -0,0 +1,252 @@
+package collateral
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+func transportError() error {
+	return &ports.APIError{Code: ports.CodeTransport, Message: "order placement failed: exchange unavailable", Details: "status 503"}
+}
+
+var retryCredential = domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")}
+
+func TestRetryingOrderExecutorResubmitsWithSameClientOrderIDWhenOrderDidNotLand(t *testing.T) {
+	next := &fakeOrderExecutor{placeErrors: []error{transportError()}}
+	orderManager := &fakeOrderManager{}
+	history := &fakeHistoryReader{}
+	executor := NewRetryingOrderExecutor(
+		next,
+		orderManager,
+		history,
+		fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)},
+		RetryPolicy{MaxAttempts: 3, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second},
+	)
+	waits := []time.Duration{}
+	executor.wait = func(_ context.Context, delay time.Duration) error {
+		waits = append(waits, delay)
+		return nil
+	}
+
+	_, err := executor.PlaceCollateralLimitOrder(context.Background(), retryCredential, ports.CollateralLimitOrderRequest{
+		Market: "BTC_PERP", Side: "buy", Amount: "0.01", Price: "50000", PostOnly: true,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(next.requests) != 2 {
+		t.Fatalf("expected two attempts, got %d", len(next.requests))
+	}
+	clientOrderID := next.requests[0].ClientOrderID
+	if !strings.HasPrefix(clientOrderID, RetryClientOrderIDPrefix) || next.requests[1].ClientOrderID != clientOrderID {
+		t.Fatalf("expected stable generated client order id, got %q and %q", clientOrderID, next.requests[1].ClientOrderID)
+	}
+	if orderManager.listCalls != 1 || len(history.queries) != 1 || history.queries[0].Market != "BTC_PERP" ||
+		history.queries[0].ClientOrderID != clientOrderID {
+		t.Fatalf("expected landing check before resubmit, got list=%d history=%+v", orderManager.listCalls, history.queries)
+	}
+	if len(waits) != 1 || waits[0] < 50*time.Millisecond || waits[0] > 100*time.Millisecond {
+		t.Fatalf("unexpected backoff: %v", waits)
+	}
+}
+
+func TestRetryingOrderExecutorDoesNotResubmitLandedOrder(t *testing.T) {
+	next := &fakeOrderExecutor{placeErrors: []error{transportError()}}
+	orderManager := &fakeOrderManager{}
+	executor := NewRetryingOrderExecutor(
+		next,
+		orderManager,
+		&fakeHistoryReader{},
+		fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)},
+		RetryPolicy{MaxAttempts: 3, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second},
+	)
+	executor.wait = func(context.Context, time.Duration) error { return nil }
+	next.takerFill = func(request ports.CollateralTakerOrderRequest) ports.CollateralPlacedOrder {
+		t.Fatalf("unexpected resubmit of %+v", request)
+		return ports.CollateralPlacedOrder{}
+	}
+
+	request := ports.CollateralTakerOrderRequest{Type: ports.CollateralOrderTypeMarket, Market: "BTC_PERP", Side: "sell", Amount: "0.02"}
+	expectedID := executor.clientOrderID(0, request.Type, request.Market, request.Side, request.PositionSide, request.Amount, request.Price, request.ActivationPrice)
+	orderManager.activeOrders = []ports.CollateralOrder{{OrderID: 77, ClientOrderID: expectedID, Amount: "0.02", DealStock: "0.02"}}
+
+	placed, err := executor.PlaceCollateralTakerOrder(context.Background(), retryCredential, request)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(next.takerRequests) != 1 || placed.OrderID != 77 || placed.ClientOrderID != expectedID || placed.DealStock != "0.02" {
+		t.Fatalf("expected landed order, got %+v after %d attempts", placed, len(next.takerRequests))
+	}
+}
+
+func TestRetryingOrderExecutorDoesNotRetryNonTransportErrors(t *testing.T) {
+	for _, rejected := range []*ports.APIError{
+		{Code: ports.CodeBusinessRule, Message: "order placement failed: rejected by exchange"},
+		{Code: ports.CodeUnavailable, Message: "order placement failed: exchange unavailable", Details: "decode response"},
+	} {
+		t.Run(string(rejected.Code), func(t *testing.T) {
+			next := &fakeOrderExecutor{placeErrors: []error{rejected}}
+			orderManager := &fakeOrderManager{}
+			executor := NewRetryingOrderExecutor(
+				next,
+				orderManager,
+				&fakeHistoryReader{},
+				fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)},
+				RetryPolicy{MaxAttempts: 3, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second},
+			)
+			waits := []time.Duration{}
+			executor.wait = func(_ context.Context, delay time.Duration) error {
+				waits = append(waits, delay)
+				return nil
+			}
+
+			_, err := executor.PlaceCollateralLimitOrder(context.Background(), retryCredential, ports.CollateralLimitOrderRequest{
+				Market: "BTC_PERP", Side: "buy", Amount: "0.01", Price: "50000", ClientOrderID: "mine-1",
+			})
+			if !errors.Is(err, rejected) {
+				t.Fatalf("expected %s error, got %v", rejected.Code, err)
+			}
+			if len(next.requests) != 1 || next.requests[0].ClientOrderID != "mine-1" || orderManager.listCalls != 0 || len(waits) != 0 {
+				t.Fatalf("expected single attempt without landing check, got %+v", next.requests)
+			}
+		})
+	}
+}
+
+func TestRetryingOrderExecutorReportsUnknownStatusWhenLandingCheckFails(t *testing.T) {
+	next := &fakeOrderExecutor{placeErrors: []error{transportError()}}
+	orderManager := &fakeOrderManager{listErr: transportError()}
+	executor := NewRetryingOrderExecutor(
+		next,
+		orderManager,
+		&fakeHistoryReader{},
+		fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)},
+		RetryPolicy{MaxAttempts: 3, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second},
+	)
+	executor.wait = func(context.Context, time.Duration) error { return nil }
+
+	_, err := executor.PlaceCollateralLimitOrder(context.Background(), retryCredential, ports.CollateralLimitOrderRequest{
+		Market: "BTC_PERP", Side: "buy", Amount: "0.01", Price: "50000",
+	})
+	if !errors.Is(err, ErrOrderStatusUnknown) || !strings.Contains(err.Error(), next.requests[0].ClientOrderID) {
+		t.Fatalf("expected unknown status with client order id, got %v", err)
+	}
+	if len(next.requests) != 1 {
+		t.Fatalf("expected no resubmit, got %d attempts", len(next.requests))
+	}
+}
+
+func TestRetryingOrderExecutorStopsAfterMaxAttempts(t *testing.T) {
+	next := &fakeOrderExecutor{placeErrors: []error{transportError(), transportError(), transportError(), nil}}
+	executor := NewRetryingOrderExecutor(
+		next,
+		&fakeOrderManager{},
+		&fakeHistoryReader{},
+		fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)},
+		RetryPolicy{MaxAttempts: 3, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second},
+	)
+	waits := []time.Duration{}
+	executor.wait = func(_ context.Context, delay time.Duration) error {
+		waits = append(waits, delay)
+		return nil
+	}
+
+	_, err := executor.PlaceCollateralLimitOrder(context.Background(), retryCredential, ports.CollateralLimitOrderRequest{
+		Market: "BTC_PERP", Side: "buy", Amount: "0.01", Price: "50000",
+	})
+	if !errors.Is(err, ErrOrderStatusUnknown) || !strings.Contains(err.Error(), "exchange unavailable") {
+		t.Fatalf("expected unknown status after the last transport error, got %v", err)
+	}
+	if len(next.requests) != 3 || len(waits) != 3 || waits[1] < 100*time.Millisecond || waits[1] > 200*time.Millisecond {
+		t.Fatalf("expected 3 attempts with growing backoff, got %d attempts and waits %v", len(next.requests), waits)
+	}
+}
+
+func TestRetryingOrderExecutorAcknowledgesLimitOrderLandedOnLastAttempt(t *testing.T) {
+	next := &fakeOrderExecutor{placeErrors: []error{transportError()}}
+	history := &fakeHistoryReader{}
+	executor := NewRetryingOrderExecutor(
+		next,
+		&fakeOrderManager{},
+		history,
+		fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)},
+		RetryPolicy{MaxAttempts: 1, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second},
+	)
+	executor.wait = func(context.Context, time.Duration) error { return nil }
+	history.orders = []ports.CollateralOrderHistoryEntry{
+		{OrderID: 41, ClientOrderID: "other-1", Status: "filled"},
+		{OrderID: 42, ClientOrderID: "mine-1", Amount: "0.01", DealStock: "0.01", DealMoney: "500", Status: "filled"},
+	}
+
+	acknowledgement, err := executor.PlaceCollateralLimitOrder(context.Background(), retryCredential, ports.CollateralLimitOrderRequest{
+		Market: "BTC_PERP", Side: "buy", Amount: "0.01", Price: "50000", ClientOrderID: "mine-1",
+	})
+	if err != nil {
+		t.Fatalf("expected landed order, got %v", err)
+	}
+	if len(next.requests) != 1 || len(history.queries) != 1 || history.queries[0].ClientOrderID != "mine-1" {
+		t.Fatalf("expected one attempt and a history lookup by client order id, got %d attempts and %+v", len(next.requests), history.queries)
+	}
+
+	var decoded struct {
+		OrderID       int64  `json:"orderId"`
+		ClientOrderID string `json:"clientOrderId"`
+		DealStock     string `json:"dealStock"`
+	}
+	if err := json.Unmarshal(acknowledgement, &decoded); err != nil || decoded.OrderID != 42 || decoded.ClientOrderID != "mine-1" || decoded.DealStock != "0.01" {
+		t.Fatalf("unexpected acknowledgement %s: %v", acknowledgement, err)
+	}
+}
+
+func TestRetryingOrderExecutorBulkResubmitsOnlyOrdersThatDidNotLand(t *testing.T) {
+	attempts := 0
+	next := &fakeOrderExecutor{}
+	next.bulkResults = func(orders []ports.CollateralLimitOrderRequest) ([]ports.CollateralBulkOrderResult, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, transportError()
+		}
+		results := make([]ports.CollateralBulkOrderResult, len(orders))
+		for index, order := range orders {
+			results[index] = ports.CollateralBulkOrderResult{Accepted: true, OrderID: int64(500 + index), ClientOrderID: order.ClientOrderID}
+		}
+
+		return results, nil
+	}
+	history := &fakeHistoryReader{}
+	executor := NewRetryingOrderExecutor(
+		next,
+		&fakeOrderManager{},
+		history,
+		fakeClock{now: time.Date(2026, 3, 3, 9, 0, 0, 0, time.UTC)},
+		RetryPolicy{MaxAttempts: 3, MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second},
+	)
+	executor.wait = func(context.Context, time.Duration) error { return nil }
+
+	orders := []ports.CollateralLimitOrderRequest{
+		{Market: "BTC_PERP", Side: "buy", Amount: "0.01", Price: "50000", ClientOrderID: "run-0"},
+		{Market: "BTC_PERP", Side: "buy", Amount: "0.01", Price: "49900"},
+	}
+	history.orders = []ports.CollateralOrderHistoryEntry{{OrderID: 42, ClientOrderID: "run-0", Status: "filled"}}
+
+	results, err := executor.PlaceCollateralBulkLimitOrder(context.Background(), retryCredential, orders, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(next.bulkRequests) != 2 || len(next.bulkRequests[1]) != 1 || next.bulkRequests[1][0].ClientOrderID != next.bulkRequests[0][1].ClientOrderID {
+		t.Fatalf("expected only the missing order resubmitted, got %+v", next.bulkRequests)
+	}
+	if orders[1].ClientOrderID != "" {
+		t.Fatalf("caller orders must not be modified, got %+v", orders[1])
+	}
+	if !results[0].Accepted || results[0].OrderID != 42 || !results[1].Accepted || results[1].OrderID != 500 {
+		t.Fatalf("unexpected bulk results: %+v", results)
+	}
+}
@@ -2,12 +2,13 @@ package collateral
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/ChewX3D/crypto/internal/app/ports"
 	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
-	"github.com/ChewX3D/crypto/internal/ptrutil"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
 )
 
 const (
@@ -15,13 +16,39 @@ const (
 	collateralOrderPrefix = "order"
 )
 
+// OrderTypeLimit is the default post-only limit order type; other types are ports.CollateralOrderType* values.
+const OrderTypeLimit = "limit"
+
+var (
+	// ErrInvalidAmount indicates an order amount that is not a positive decimal.
+	ErrInvalidAmount = errors.New("order amount must be a decimal greater than 0")
+	// ErrInvalidPrice indicates an order price that is not a positive decimal.
+	ErrInvalidPrice = errors.New("order price must be a decimal greater than 0")
+	// ErrInvalidActivationPrice indicates a stop order activation price that is not a positive decimal.
+	ErrInvalidActivationPrice = errors.New("activation price must be a decimal greater than 0")
+	// ErrInvalidOrderType indicates an unsupported order type.
+	ErrInvalidOrderType = errors.New("order type must be one of: limit, market, stop-market, stop-limit")
+	// ErrTakerNotAllowed indicates a non-post-only order placed without explicit taker permission.
+	ErrTakerNotAllowed = errors.New("order may take liquidity and requires explicit taker permission")
+	// ErrStopLevelsUnsupported indicates stop-loss or take-profit on an order type that cannot carry them.
+	ErrStopLevelsUnsupported = errors.New("stop-loss and take-profit are only supported on limit orders")
+)
+
 // PlaceOrderRequest is input for collateral single order placement use-case.
+// Empty Type means a limit order. Limit orders are post-only unless AllowTaker is set;
+// market, stop-market and stop-limit orders always require AllowTaker.
 type PlaceOrderRequest struct {
-	Market        string
-	Side          string
-	Amount        string
-	Price         string
-	ClientOrderID string
+	Market          string
+	Side            string
+	Type            string
+	Amount          string
+	Price           string
+	ActivationPrice string
+	ClientOrderID   string
+	StopLoss        string
+	TakeProfit      string
+	SnapToMarket    bool
+	AllowTaker      bool
 }
 
 // PlaceOrderResult is normalized output for collateral single order placement use-case.
@@ -39,7 +66,9 @@ type PlaceOrderService struct {
 	credentialStore ports.CredentialStore
 	sessionStore    ports.SessionStore
 	orderExecutor   ports.CollateralOrderExecutor
+	marketInfo      ports.MarketInfoProvider
 	clock           ports.Clock
+	hedgeModes      hedgeModeResolver
 }
 
 // NewPlaceOrderService constructs PlaceOrderService.
@@ -47,45 +76,92 @@ func NewPlaceOrderService(
 	credentialStore ports.CredentialStore,
 	sessionStore ports.SessionStore,
 	orderExecutor ports.CollateralOrderExecutor,
+	marketInfo ports.MarketInfoProvider,
 	clock ports.Clock,
 ) *PlaceOrderService {
 	return &PlaceOrderService{
 		credentialStore: credentialStore,
 		sessionStore:    sessionStore,
 		orderExecutor:   orderExecutor,
+		marketInfo:      marketInfo,
 		clock:           clock,
+		hedgeModes:      newHedgeModeResolver(credentialStore, sessionStore, orderExecutor, clock),
 	}
 }
 
-// Execute places one collateral post-only limit order.
+// Execute places one collateral order.
+// Price and amount are checked against market rules before credentials are loaded.
 func (service *PlaceOrderService) Execute(ctx context.Context, request PlaceOrderRequest) (PlaceOrderResult, error) {
+	orderType, err := resolveOrderType(request)
+	if err != nil {
+		return PlaceOrderResult{}, err
+	}
+	if err := validateOrderDecimals(orderType, request); err != nil {
+		return PlaceOrderResult{}, err
+	}
+
+	market, err := lookupMarket(ctx, service.marketInfo, request.Market)
+	if err != nil {
+		return PlaceOrderResult{}, err
+	}
+
+	var place func(credential domainauth.Credential, hedgeMode bool) error
+	if orderType == OrderTypeLimit {
+		checked := buildOrderRequest(request, false)
+		if err := applyMarketRules(&checked, market, request.SnapToMarket); err != nil {
+			return PlaceOrderResult{}, err
+		}
+		request.Amount = checked.Amount
+		request.Price = checked.Price
+		request.StopLoss = checked.StopLoss
+		request.TakeProfit = checked.TakeProfit
+
+		place = func(credential domainauth.Credential, hedgeMode bool) error {
+			_, err := service.orderExecutor.PlaceCollateralLimitOrder(ctx, credential, buildOrderRequest(request, hedgeMode))
+			return err
+		}
+	} else {
+		checked := buildTakerOrderRequest(orderType, request, false)
+		if err := applyTakerMarketRules(&checked, market, request.SnapToMarket); err != nil {
+			return PlaceOrderResult{}, err
+		}
+		request.Amount = checked.Amount
+		request.Price = checked.Price
+		request.ActivationPrice = checked.ActivationPrice
+
+		place = func(credential domainauth.Credential, hedgeMode bool) error {
+			_, err := service.orderExecutor.PlaceCollateralTakerOrder(ctx, credential, buildTakerOrderRequest(orderType, request, hedgeMode))
+			return err
+		}
+	}
+
 	credential, err := service.credentialStore.Load(ctx)
 	if err != nil {
 		return PlaceOrderResult{}, fmt.Errorf("load credential: %w", err)
 	}
+	defer domainauth.WipeBytes(credential.APISecret)
 
-	hedgeMode, err := service.resolveHedgeMode(ctx, credential)
+	hedgeMode, err := service.hedgeModes.resolve(ctx, credential)
 	if err != nil {
 		return PlaceOrderResult{}, fmt.Errorf("resolve hedge mode: %w", err)
 	}
 
-	requestPayload := buildOrderRequest(request, hedgeMode)
-	_, err = service.orderExecutor.PlaceCollateralLimitOrder(ctx, credential, requestPayload)
+	err = place(credential, hedgeMode)
 	if err != nil && isHedgeModeMismatchError(err) {
-		refreshedHedgeMode, refreshErr := service.refreshHedgeMode(ctx, credential)
+		refreshedHedgeMode, refreshErr := service.hedgeModes.refresh(ctx, credential)
 		if refreshErr != nil {
 			return PlaceOrderResult{}, fmt.Errorf(
-				"place collateral limit order: %w; refresh hedge mode: %v",
+				"place collateral %s order: %w; refresh hedge mode: %v",
+				orderType,
 				err,
 				refreshErr,
 			)
 		}
 
-		requestPayload = buildOrderRequest(request, refreshedHedgeMode)
-		_, err = service.orderExecutor.PlaceCollateralLimitOrder(ctx, credential, requestPayload)
+		err = place(credential, refreshedHedgeMode)
 	}
 	if err != nil {
-		return PlaceOrderResult{}, fmt.Errorf("place collateral limit order: %w", err)
+		return PlaceOrderResult{}, fmt.Errorf("place collateral %s order: %w", orderType, err)
 	}
 
 	return PlaceOrderResult{
@@ -98,51 +174,43 @@ func (service *PlaceOrderService) Execute(ctx context.Context, request PlaceOrde
 	}, nil
 }
 
-func (service *PlaceOrderService) resolveHedgeMode(ctx context.Context, credential domainauth.Credential) (bool, error) {
-	session, found, err := service.sessionStore.GetSession(ctx)
-	if err != nil {
-		return false, fmt.Errorf("read session metadata: %w", err)
-	}
-	if found && session.HedgeMode != nil {
-		return *session.HedgeMode, nil
+// resolveOrderType normalizes request type and enforces the taker safety gate.
+func resolveOrderType(request PlaceOrderRequest) (string, error) {
+	orderType := strings.ToLower(strings.TrimSpace(request.Type))
+	switch orderType {
+	case "", OrderTypeLimit:
+		return OrderTypeLimit, nil
+	case ports.CollateralOrderTypeMarket, ports.CollateralOrderTypeStopMarket, ports.CollateralOrderTypeStopLimit:
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidOrderType, request.Type)
 	}
 
-	return service.refreshHedgeMode(ctx, credential)
-}
-
-func (service *PlaceOrderService) refreshHedgeMode(ctx context.Context, credential domainauth.Credential) (bool, error) {
-	value, err := service.orderExecutor.GetCollateralAccountHedgeMode(ctx, credential)
-	if err != nil {
-		return false, err
+	if !request.AllowTaker {
+		return "", fmt.Errorf("%w: %s", ErrTakerNotAllowed, orderType)
 	}
-	if err := service.persistHedgeMode(ctx, value); err != nil {
-		return false, err
+	if strings.TrimSpace(request.StopLoss) != "" || strings.TrimSpace(request.TakeProfit) != "" {
+		return "", fmt.Errorf("%w: %s", ErrStopLevelsUnsupported, orderType)
 	}
 
-	return value, nil
+	return orderType, nil
 }
 
-func (service *PlaceOrderService) persistHedgeMode(ctx context.Context, hedgeMode bool) error {
-	session, found, err := service.sessionStore.GetSession(ctx)
-	if err != nil {
-		return fmt.Errorf("read session metadata: %w", err)
+func validateOrderDecimals(orderType string, request PlaceOrderRequest) error {
+	amount, err := decimal.Parse(request.Amount)
+	if err != nil || amount.Sign() <= 0 {
+		return fmt.Errorf("%w: %q", ErrInvalidAmount, request.Amount)
 	}
-
-	now := service.clock.Now().UTC()
-	if !found {
-		session = ports.SessionMetadata{
-			Backend:   service.credentialStore.BackendName(),
-			CreatedAt: now,
+	if orderType == OrderTypeLimit || orderType == ports.CollateralOrderTypeStopLimit {
+		price, err := decimal.Parse(request.Price)
+		if err != nil || price.Sign() <= 0 {
+			return fmt.Errorf("%w: %q", ErrInvalidPrice, request.Price)
 		}
 	}
-	if session.CreatedAt.IsZero() {
-		session.CreatedAt = now
-	}
-
-	session.UpdatedAt = now
-	session.HedgeMode = ptrutil.Ptr(hedgeMode)
-	if err := service.sessionStore.SaveSession(ctx, session); err != nil {
-		return fmt.Errorf("save session metadata: %w", err)
+	if orderType == ports.CollateralOrderTypeStopMarket || orderType == ports.CollateralOrderTypeStopLimit {
+		activation, err := decimal.Parse(request.ActivationPrice)
+		if err != nil || activation.Sign() <= 0 {
+			return fmt.Errorf("%w: %q", ErrInvalidActivationPrice, request.ActivationPrice)
+		}
 	}
 
 	return nil
@@ -158,8 +226,31 @@ func buildOrderRequest(request PlaceOrderRequest, hedgeMode bool) ports.Collater
 		Amount:        strings.TrimSpace(request.Amount),
 		Price:         strings.TrimSpace(request.Price),
 		ClientOrderID: request.ClientOrderID,
-		PostOnly:      true,
+		PostOnly:      !request.AllowTaker,
+		StopLoss:      strings.TrimSpace(request.StopLoss),
+		TakeProfit:    strings.TrimSpace(request.TakeProfit),
+	}
+}
+
+func buildTakerOrderRequest(orderType string, request PlaceOrderRequest, hedgeMode bool) ports.CollateralTakerOrderRequest {
+	orderSide, positionSide := resolveOrderSides(strings.TrimSpace(request.Side), hedgeMode)
+
+	takerRequest := ports.CollateralTakerOrderRequest{
+		Type:          orderType,
+		Market:        strings.TrimSpace(request.Market),
+		Side:          orderSide,
+		PositionSide:  positionSide,
+		Amount:        strings.TrimSpace(request.Amount),
+		ClientOrderID: request.ClientOrderID,
 	}
+	if orderType == ports.CollateralOrderTypeStopLimit {
+		takerRequest.Price = strings.TrimSpace(request.Price)
+	}
+	if orderType != ports.CollateralOrderTypeMarket {
+		takerRequest.ActivationPrice = strings.TrimSpace(request.ActivationPrice)
+	}
+
+	return takerRequest
 }
 
 func resolveOrderSides(side string, hedgeMode bool) (string, string) {
@@ -186,7 +277,11 @@ func resolveOrderSides(side string, hedgeMode bool) (string, string) {
 }
 
 func isHedgeModeMismatchError(err error) bool {
-	detail := strings.ToLower(err.Error())
+	return isHedgeModeMismatchMessage(err.Error())
+}
+
+func isHedgeModeMismatchMessage(message string) bool {
+	detail := strings.ToLower(message)
 	if detail == "" {
 		return false
 	}
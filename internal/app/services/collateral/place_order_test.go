@@ -9,6 +9,7 @@ import (
 
 	"github.com/ChewX3D/crypto/internal/app/ports"
 	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
 )
 
 type fakeClock struct {
@@ -19,6 +20,27 @@ func (clock fakeClock) Now() time.Time {
 	return clock.now
 }
 
+type fakeMarketInfo struct {
+	markets []ports.MarketInfo
+	err     error
+}
+
+func (provider *fakeMarketInfo) ListMarkets(context.Context) ([]ports.MarketInfo, error) {
+	return provider.markets, provider.err
+}
+
+func testMarketInfo() *fakeMarketInfo {
+	return &fakeMarketInfo{markets: []ports.MarketInfo{{
+		Name:           "BTC_PERP",
+		StockPrecision: 8,
+		MoneyPrecision: 2,
+		MinAmount:      decimal.MustParse("0.0001"),
+		MinTotal:       decimal.MustParse("0.5"),
+		TradesEnabled:  true,
+		IsCollateral:   true,
+	}}}
+}
+
 type fakeCredentialStore struct {
 	loadCredential domainauth.Credential
 	loadErr        error
@@ -82,7 +104,13 @@ func (store *fakeSessionStore) ClearSession(context.Context) error {
 type fakeOrderExecutor struct {
 	lastCredential    domainauth.Credential
 	requests          []ports.CollateralLimitOrderRequest
+	takerRequests     []ports.CollateralTakerOrderRequest
+	takerFill         func(request ports.CollateralTakerOrderRequest) ports.CollateralPlacedOrder
+	limitAck          json.RawMessage
 	placeErrors       []error
+	bulkRequests      [][]ports.CollateralLimitOrderRequest
+	bulkStopOnFail    []bool
+	bulkResults       func(orders []ports.CollateralLimitOrderRequest) ([]ports.CollateralBulkOrderResult, error)
 	getHedgeModeValue bool
 	getHedgeModeErr   error
 	getHedgeModeCalls int
@@ -123,9 +151,58 @@ func (executor *fakeOrderExecutor) PlaceCollateralLimitOrder(
 		}
 	}
 
+	if executor.limitAck != nil {
+		return executor.limitAck, nil
+	}
+
 	return json.RawMessage(`{"status":"ok"}`), nil
 }
 
+func (executor *fakeOrderExecutor) PlaceCollateralTakerOrder(
+	_ context.Context,
+	_ domainauth.Credential,
+	request ports.CollateralTakerOrderRequest,
+) (ports.CollateralPlacedOrder, error) {
+	executor.takerRequests = append(executor.takerRequests, request)
+
+	if len(executor.placeErrors) > 0 {
+		err := executor.placeErrors[0]
+		executor.placeErrors = executor.placeErrors[1:]
+		if err != nil {
+			return ports.CollateralPlacedOrder{}, err
+		}
+	}
+	if executor.takerFill != nil {
+		return executor.takerFill(request), nil
+	}
+
+	return ports.CollateralPlacedOrder{OrderID: 1, ClientOrderID: request.ClientOrderID, Amount: request.Amount}, nil
+}
+
+func (executor *fakeOrderExecutor) PlaceCollateralBulkLimitOrder(
+	_ context.Context,
+	_ domainauth.Credential,
+	orders []ports.CollateralLimitOrderRequest,
+	stopOnFail bool,
+) ([]ports.CollateralBulkOrderResult, error) {
+	executor.bulkRequests = append(executor.bulkRequests, append([]ports.CollateralLimitOrderRequest(nil), orders...))
+	executor.bulkStopOnFail = append(executor.bulkStopOnFail, stopOnFail)
+	if executor.bulkResults != nil {
+		return executor.bulkResults(orders)
+	}
+
+	results := make([]ports.CollateralBulkOrderResult, len(orders))
+	for index, order := range orders {
+		results[index] = ports.CollateralBulkOrderResult{
+			Accepted:      true,
+			OrderID:       int64(1000 + index),
+			ClientOrderID: order.ClientOrderID,
+		}
+	}
+
+	return results, nil
+}
+
 func boolPtr(value bool) *bool {
 	allocated := value
 	return &allocated
@@ -144,6 +221,7 @@ func TestPlaceOrderServiceExecuteUsesSessionHedgeModeTrue(t *testing.T) {
 		credentialStore,
 		sessionStore,
 		orderExecutor,
+		testMarketInfo(),
 		fakeClock{now: time.Date(2026, 3, 2, 10, 0, 0, 123, time.UTC)},
 	)
 
@@ -183,7 +261,7 @@ func TestPlaceOrderServiceExecuteUsesSessionHedgeModeFalse(t *testing.T) {
 	}
 	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(false)}}
 	orderExecutor := &fakeOrderExecutor{}
-	service := NewPlaceOrderService(credentialStore, sessionStore, orderExecutor, fakeClock{now: time.Now()})
+	service := NewPlaceOrderService(credentialStore, sessionStore, orderExecutor, testMarketInfo(), fakeClock{now: time.Now()})
 
 	_, err := service.Execute(context.Background(), PlaceOrderRequest{
 		Market: "BTC_PERP",
@@ -217,7 +295,7 @@ func TestPlaceOrderServiceExecuteRefreshesMissingHedgeMode(t *testing.T) {
 		UpdatedAt:  now,
 	}}
 	orderExecutor := &fakeOrderExecutor{getHedgeModeValue: true}
-	service := NewPlaceOrderService(credentialStore, sessionStore, orderExecutor, fakeClock{now: now.Add(time.Minute)})
+	service := NewPlaceOrderService(credentialStore, sessionStore, orderExecutor, testMarketInfo(), fakeClock{now: now.Add(time.Minute)})
 
 	_, err := service.Execute(context.Background(), PlaceOrderRequest{
 		Market: "BTC_PERP",
@@ -255,7 +333,7 @@ func TestPlaceOrderServiceExecuteRetriesOnHedgeModeMismatch(t *testing.T) {
 		placeErrors:       []error{errors.New("whitebit api business rule error: status 422: hedgeMode: Order's position side does not match user's setting"), nil},
 		getHedgeModeValue: true,
 	}
-	service := NewPlaceOrderService(credentialStore, sessionStore, orderExecutor, fakeClock{now: now.Add(2 * time.Minute)})
+	service := NewPlaceOrderService(credentialStore, sessionStore, orderExecutor, testMarketInfo(), fakeClock{now: now.Add(2 * time.Minute)})
 
 	_, err := service.Execute(context.Background(), PlaceOrderRequest{
 		Market: "BTC_PERP",
@@ -284,7 +362,7 @@ func TestPlaceOrderServiceExecuteCredentialLoadFailure(t *testing.T) {
 	credentialStore := &fakeCredentialStore{loadErr: ports.ErrCredentialNotFound}
 	sessionStore := &fakeSessionStore{}
 	orderExecutor := &fakeOrderExecutor{}
-	service := NewPlaceOrderService(credentialStore, sessionStore, orderExecutor, fakeClock{now: time.Now()})
+	service := NewPlaceOrderService(credentialStore, sessionStore, orderExecutor, testMarketInfo(), fakeClock{now: time.Now()})
 
 	_, err := service.Execute(context.Background(), PlaceOrderRequest{
 		Market: "BTC_PERP",
@@ -303,7 +381,7 @@ func TestPlaceOrderServiceExecuteExecutorFailure(t *testing.T) {
 	}
 	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(false)}}
 	orderExecutor := &fakeOrderExecutor{placeErrors: []error{errors.New("exchange rejected request")}}
-	service := NewPlaceOrderService(credentialStore, sessionStore, orderExecutor, fakeClock{now: time.Now()})
+	service := NewPlaceOrderService(credentialStore, sessionStore, orderExecutor, testMarketInfo(), fakeClock{now: time.Now()})
 
 	_, err := service.Execute(context.Background(), PlaceOrderRequest{
 		Market: "BTC_PERP",
@@ -318,3 +396,190 @@ func TestPlaceOrderServiceExecuteExecutorFailure(t *testing.T) {
 		t.Fatalf("expected non-empty error")
 	}
 }
+
+func TestPlaceOrderServiceExecuteRejectsNonDecimalValues(t *testing.T) {
+	testCases := []struct {
+		name    string
+		amount  string
+		price   string
+		wantErr error
+	}{
+		{name: "exponent amount", amount: "1e-3", price: "50000", wantErr: ErrInvalidAmount},
+		{name: "zero amount", amount: "0", price: "50000", wantErr: ErrInvalidAmount},
+		{name: "negative price", amount: "0.01", price: "-1", wantErr: ErrInvalidPrice},
+		{name: "text price", amount: "0.01", price: "market", wantErr: ErrInvalidPrice},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			credentialStore := &fakeCredentialStore{loadErr: ports.ErrCredentialNotFound}
+			orderExecutor := &fakeOrderExecutor{}
+			service := NewPlaceOrderService(credentialStore, &fakeSessionStore{}, orderExecutor, testMarketInfo(), fakeClock{now: time.Now()})
+
+			_, err := service.Execute(context.Background(), PlaceOrderRequest{
+				Market: "BTC_PERP",
+				Side:   "buy",
+				Amount: testCase.amount,
+				Price:  testCase.price,
+			})
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("expected %v, got %v", testCase.wantErr, err)
+			}
+			if len(orderExecutor.requests) != 0 {
+				t.Fatalf("expected no order submission")
+			}
+		})
+	}
+}
+
+func TestPlaceOrderServiceRejectsTakerOrdersWithoutPermission(t *testing.T) {
+	testCases := []struct {
+		name    string
+		request PlaceOrderRequest
+		wantErr error
+	}{
+		{name: "market", request: PlaceOrderRequest{Type: "market"}, wantErr: ErrTakerNotAllowed},
+		{name: "stop-market", request: PlaceOrderRequest{Type: "stop-market", ActivationPrice: "49000"}, wantErr: ErrTakerNotAllowed},
+		{name: "stop-limit", request: PlaceOrderRequest{Type: "stop-limit", Price: "48900", ActivationPrice: "49000"}, wantErr: ErrTakerNotAllowed},
+		{name: "unknown type", request: PlaceOrderRequest{Type: "iceberg", AllowTaker: true}, wantErr: ErrInvalidOrderType},
+		{name: "market with stop-loss", request: PlaceOrderRequest{Type: "market", AllowTaker: true, StopLoss: "48000"}, wantErr: ErrStopLevelsUnsupported},
+		{name: "stop-market without activation", request: PlaceOrderRequest{Type: "stop-market", AllowTaker: true}, wantErr: ErrInvalidActivationPrice},
+		{name: "stop-limit without price", request: PlaceOrderRequest{Type: "stop-limit", AllowTaker: true, ActivationPrice: "49000"}, wantErr: ErrInvalidPrice},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			credentialStore := &fakeCredentialStore{
+				loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+			}
+			sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(false)}}
+			executor := &fakeOrderExecutor{}
+			service := NewPlaceOrderService(
+				credentialStore,
+				sessionStore,
+				executor,
+				testMarketInfo(),
+				fakeClock{now: time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)},
+			)
+			request := testCase.request
+			request.Market = "BTC_PERP"
+			request.Side = "buy"
+			request.Amount = "0.01"
+
+			_, err := service.Execute(context.Background(), request)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("expected %v, got %v", testCase.wantErr, err)
+			}
+			if len(executor.takerRequests) != 0 || len(executor.requests) != 0 {
+				t.Fatalf("expected no order submission")
+			}
+		})
+	}
+}
+
+func TestPlaceOrderServicePlacesMarketOrderInHedgeMode(t *testing.T) {
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(true)}}
+	executor := &fakeOrderExecutor{}
+	service := NewPlaceOrderService(
+		credentialStore,
+		sessionStore,
+		executor,
+		testMarketInfo(),
+		fakeClock{now: time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)},
+	)
+
+	_, err := service.Execute(context.Background(), PlaceOrderRequest{
+		Market:        "BTC_PERP",
+		Side:          "short",
+		Type:          "market",
+		Amount:        "0.0100",
+		Price:         "50000",
+		ClientOrderID: "lock-1",
+		AllowTaker:    true,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(executor.takerRequests) != 1 {
+		t.Fatalf("expected one taker order, got %d", len(executor.takerRequests))
+	}
+
+	got := executor.takerRequests[0]
+	want := ports.CollateralTakerOrderRequest{
+		Type:          ports.CollateralOrderTypeMarket,
+		Market:        "BTC_PERP",
+		Side:          "sell",
+		PositionSide:  "short",
+		Amount:        "0.01",
+		ClientOrderID: "lock-1",
+	}
+	if got != want {
+		t.Fatalf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestPlaceOrderServicePlacesStopLimitOrder(t *testing.T) {
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(false)}}
+	executor := &fakeOrderExecutor{}
+	service := NewPlaceOrderService(
+		credentialStore,
+		sessionStore,
+		executor,
+		testMarketInfo(),
+		fakeClock{now: time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)},
+	)
+
+	_, err := service.Execute(context.Background(), PlaceOrderRequest{
+		Market:          "BTC_PERP",
+		Side:            "sell",
+		Type:            "stop-limit",
+		Amount:          "0.01",
+		Price:           "48900",
+		ActivationPrice: "49000.004",
+		SnapToMarket:    true,
+		AllowTaker:      true,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	got := executor.takerRequests[0]
+	if got.Type != ports.CollateralOrderTypeStopLimit || got.Price != "48900" || got.ActivationPrice != "49000.01" || got.PositionSide != "" {
+		t.Fatalf("unexpected stop-limit request: %+v", got)
+	}
+}
+
+func TestPlaceOrderServiceAllowTakerDropsPostOnlyOnLimit(t *testing.T) {
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(false)}}
+	executor := &fakeOrderExecutor{}
+	service := NewPlaceOrderService(
+		credentialStore,
+		sessionStore,
+		executor,
+		testMarketInfo(),
+		fakeClock{now: time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)},
+	)
+
+	_, err := service.Execute(context.Background(), PlaceOrderRequest{
+		Market:     "BTC_PERP",
+		Side:       "buy",
+		Amount:     "0.01",
+		Price:      "50000",
+		AllowTaker: true,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(executor.requests) != 1 || executor.requests[0].PostOnly {
+		t.Fatalf("expected one limit order without postOnly, got %+v", executor.requests)
+	}
+}
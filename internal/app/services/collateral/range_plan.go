@@ -0,0 +1,315 @@
+package collateral
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
+)
+
+const (
+	rangeOrderMode        = "range"
+	collateralRangePrefix = "range"
+
+	// DefaultRangeMaxOrders caps generated orders when request does not set MaxOrders.
+	DefaultRangeMaxOrders = 100
+
+	rangeAmountDecimals = 8
+)
+
+// Supported range amount modes.
+const (
+	AmountModeConstant        = "constant"
+	AmountModeArithmetic      = "arithmetic"
+	AmountModeGeometric       = "geometric"
+	AmountModeCappedGeometric = "capped-geometric"
+	AmountModeFibonacci       = "fibonacci"
+	AmountModeCustomList      = "custom-list"
+)
+
+var (
+	// ErrRangeInvalidBounds indicates non-positive start/end price or step.
+	ErrRangeInvalidBounds = errors.New("range start price, end price and step must be greater than 0")
+	// ErrRangeInvalidBaseAmount indicates non-positive base amount.
+	ErrRangeInvalidBaseAmount = errors.New("range base amount must be greater than 0")
+	// ErrRangeInvalidAmountMode indicates unknown amount mode.
+	ErrRangeInvalidAmountMode = errors.New("unsupported range amount mode")
+	// ErrRangeInvalidMultiplier indicates amount mode parameters that produce a non-positive multiplier.
+	ErrRangeInvalidMultiplier = errors.New("range amount multiplier must be greater than 0")
+	// ErrRangeTooManyOrders indicates the plan exceeds the configured order cap.
+	ErrRangeTooManyOrders = errors.New("range plan exceeds max orders")
+	// ErrRangeMultipliersMissing indicates custom-list mode without enough multipliers.
+	ErrRangeMultipliersMissing = errors.New("custom-list amount mode requires one multiplier per planned order")
+)
+
+// RangePlanRequest is input for collateral range planning use-case.
+// Prices, amounts and multipliers are exact decimals; planned values never pass through float.
+type RangePlanRequest struct {
+	Market              string
+	Side                string
+	StartPrice          decimal.Decimal
+	EndPrice            decimal.Decimal
+	Step                decimal.Decimal
+	AmountMode          string
+	BaseAmount          decimal.Decimal
+	StartMultiplier     decimal.Decimal
+	StepMultiplier      decimal.Decimal
+	Ratio               decimal.Decimal
+	MaxMultiplier       decimal.Decimal
+	Multipliers         []decimal.Decimal
+	MaxOrders           int
+	ClientOrderIDPrefix string
+	SnapToMarket        bool
+	// StopLossOffset and TakeProfitOffset attach SL/TP to every level at a fixed price distance; zero disables.
+	StopLossOffset   decimal.Decimal
+	TakeProfitOffset decimal.Decimal
+}
+
+// RangePlanOrder is one planned order in a range preview.
+// Status, OrderID and Error are set only after live submission.
+type RangePlanOrder struct {
+	Index         int    `json:"index"`
+	Side          string `json:"side"`
+	PositionSide  string `json:"position_side,omitempty"`
+	Price         string `json:"price"`
+	Amount        string `json:"amount"`
+	ClientOrderID string `json:"client_order_id,omitempty"`
+	StopLoss      string `json:"stop_loss,omitempty"`
+	TakeProfit    string `json:"take_profit,omitempty"`
+	Status        string `json:"status,omitempty"`
+	OrderID       int64  `json:"order_id,omitempty"`
+	Error         string `json:"error,omitempty"`
+}
+
+// RangePlanResult is normalized output for collateral range planning use-case.
+type RangePlanResult struct {
+	PlaceOrderResult
+	Market        string           `json:"market"`
+	AmountMode    string           `json:"amount_mode"`
+	TotalAmount   string           `json:"total_amount"`
+	TotalNotional string           `json:"total_notional"`
+	OrdersUnknown int              `json:"orders_unknown,omitempty"`
+	Orders        []RangePlanOrder `json:"orders"`
+}
+
+// RangePlanService turns range parameters into a deterministic collateral order plan.
+type RangePlanService struct {
+	sessionStore ports.SessionStore
+	marketInfo   ports.MarketInfoProvider
+	clock        ports.Clock
+}
+
+// NewRangePlanService constructs RangePlanService.
+func NewRangePlanService(
+	sessionStore ports.SessionStore,
+	marketInfo ports.MarketInfoProvider,
+	clock ports.Clock,
+) *RangePlanService {
+	return &RangePlanService{
+		sessionStore: sessionStore,
+		marketInfo:   marketInfo,
+		clock:        clock,
+	}
+}
+
+// Execute builds a range plan preview without submitting orders.
+// Position side follows cached session hedge mode; one-way shape is used when it is unknown.
+// Every order is checked against market rules, so a valid preview is also a valid submission.
+func (service *RangePlanService) Execute(ctx context.Context, request RangePlanRequest) (RangePlanResult, error) {
+	market, err := lookupMarket(ctx, service.marketInfo, request.Market)
+	if err != nil {
+		return RangePlanResult{}, err
+	}
+
+	hedgeMode, err := service.cachedHedgeMode(ctx)
+	if err != nil {
+		return RangePlanResult{}, err
+	}
+
+	orders, err := buildMarketRangeOrders(request, market, hedgeMode)
+	if err != nil {
+		return RangePlanResult{}, err
+	}
+
+	return newRangePlanResult(
+		fmt.Sprintf("%s-%d", collateralRangePrefix, service.clock.Now().UTC().UnixNano()),
+		request,
+		orders,
+	), nil
+}
+
+func (service *RangePlanService) cachedHedgeMode(ctx context.Context) (bool, error) {
+	session, found, err := service.sessionStore.GetSession(ctx)
+	if err != nil {
+		return false, fmt.Errorf("read session metadata: %w", err)
+	}
+	if !found || session.HedgeMode == nil {
+		return false, nil
+	}
+
+	return *session.HedgeMode, nil
+}
+
+func newRangePlanResult(
+	requestID string,
+	request RangePlanRequest,
+	orders []ports.CollateralLimitOrderRequest,
+) RangePlanResult {
+	planned := make([]RangePlanOrder, 0, len(orders))
+	var totalAmount, totalNotional decimal.Decimal
+	for index, order := range orders {
+		planned = append(planned, RangePlanOrder{
+			Index:         index,
+			Side:          order.Side,
+			PositionSide:  order.PositionSide,
+			Price:         order.Price,
+			Amount:        order.Amount,
+			ClientOrderID: order.ClientOrderID,
+			StopLoss:      order.StopLoss,
+			TakeProfit:    order.TakeProfit,
+		})
+
+		price, _ := decimal.Parse(order.Price)
+		amount, _ := decimal.Parse(order.Amount)
+		totalAmount = totalAmount.Add(amount)
+		totalNotional = totalNotional.Add(price.Mul(amount))
+	}
+
+	return RangePlanResult{
+		PlaceOrderResult: PlaceOrderResult{
+			RequestID:       requestID,
+			Mode:            rangeOrderMode,
+			OrdersPlanned:   len(orders),
+			OrdersSubmitted: 0,
+			OrdersFailed:    0,
+			Errors:          []string{},
+		},
+		Market:        strings.TrimSpace(request.Market),
+		AmountMode:    request.AmountMode,
+		TotalAmount:   totalAmount.Normalize().String(),
+		TotalNotional: totalNotional.Normalize().String(),
+		Orders:        planned,
+	}
+}
+
+func buildRangeOrders(request RangePlanRequest, hedgeMode bool) ([]ports.CollateralLimitOrderRequest, error) {
+	if request.StartPrice.Sign() <= 0 || request.EndPrice.Sign() <= 0 || request.Step.Sign() <= 0 {
+		return nil, ErrRangeInvalidBounds
+	}
+	if request.BaseAmount.Sign() <= 0 {
+		return nil, ErrRangeInvalidBaseAmount
+	}
+	if request.StopLossOffset.Sign() < 0 || request.TakeProfitOffset.Sign() < 0 {
+		return nil, ErrInvalidStopOffset
+	}
+
+	maxOrders := request.MaxOrders
+	if maxOrders <= 0 {
+		maxOrders = DefaultRangeMaxOrders
+	}
+
+	steps, err := request.EndPrice.Sub(request.StartPrice).Abs().QuoInt(request.Step)
+	if err != nil {
+		return nil, ErrRangeInvalidBounds
+	}
+	if !steps.IsInt64() || steps.Int64()+1 > int64(maxOrders) {
+		return nil, fmt.Errorf("%w: %s orders planned, limit %d", ErrRangeTooManyOrders, new(big.Int).Add(steps, big.NewInt(1)), maxOrders)
+	}
+	count := int(steps.Int64()) + 1
+
+	step := request.Step
+	if request.EndPrice.Cmp(request.StartPrice) < 0 {
+		step = step.Neg()
+	}
+
+	orders := make([]ports.CollateralLimitOrderRequest, 0, count)
+	price := request.StartPrice
+	for index := range count {
+		multiplier, err := rangeMultiplier(request, index)
+		if err != nil {
+			return nil, err
+		}
+
+		order := buildOrderRequest(PlaceOrderRequest{
+			Market: request.Market,
+			Side:   request.Side,
+			Amount: request.BaseAmount.Mul(multiplier).Round(rangeAmountDecimals).Normalize().String(),
+			Price:  price.String(),
+		}, hedgeMode)
+		if prefix := strings.TrimSpace(request.ClientOrderIDPrefix); prefix != "" {
+			order.ClientOrderID = fmt.Sprintf("%s-%d", prefix, index)
+		}
+		order.StopLoss, order.TakeProfit = rangeStopLevels(order.Side, price, request.StopLossOffset, request.TakeProfitOffset)
+
+		orders = append(orders, order)
+		price = price.Add(step)
+	}
+
+	return orders, nil
+}
+
+// buildMarketRangeOrders builds range orders and applies market rules to each of them.
+func buildMarketRangeOrders(
+	request RangePlanRequest,
+	market ports.MarketInfo,
+	hedgeMode bool,
+) ([]ports.CollateralLimitOrderRequest, error) {
+	orders, err := buildRangeOrders(request, hedgeMode)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range orders {
+		if err := applyMarketRules(&orders[index], market, request.SnapToMarket); err != nil {
+			return nil, fmt.Errorf("order %d: %w", index, err)
+		}
+	}
+
+	return orders, nil
+}
+
+func rangeMultiplier(request RangePlanRequest, index int) (decimal.Decimal, error) {
+	var multiplier decimal.Decimal
+
+	switch request.AmountMode {
+	case AmountModeConstant:
+		multiplier = decimal.FromInt(1)
+	case AmountModeArithmetic:
+		multiplier = request.StartMultiplier.Add(decimal.FromInt(int64(index)).Mul(request.StepMultiplier))
+	case AmountModeGeometric:
+		multiplier = request.Ratio.Pow(index)
+	case AmountModeCappedGeometric:
+		if request.MaxMultiplier.Sign() <= 0 {
+			return decimal.Decimal{}, fmt.Errorf("%w: capped-geometric requires max multiplier", ErrRangeInvalidMultiplier)
+		}
+		multiplier = request.Ratio.Pow(index).Min(request.MaxMultiplier)
+	case AmountModeFibonacci:
+		multiplier = fibonacci(index + 1)
+	case AmountModeCustomList:
+		if index >= len(request.Multipliers) {
+			return decimal.Decimal{}, fmt.Errorf("%w: got %d", ErrRangeMultipliersMissing, len(request.Multipliers))
+		}
+		multiplier = request.Multipliers[index]
+	default:
+		return decimal.Decimal{}, fmt.Errorf("%w: %q", ErrRangeInvalidAmountMode, request.AmountMode)
+	}
+
+	if multiplier.Sign() <= 0 {
+		return decimal.Decimal{}, fmt.Errorf("%w: order %d", ErrRangeInvalidMultiplier, index)
+	}
+
+	return multiplier, nil
+}
+
+func fibonacci(n int) decimal.Decimal {
+	previous, current := decimal.FromInt(0), decimal.FromInt(1)
+	for range n - 1 {
+		previous, current = current, previous.Add(current)
+	}
+
+	return current
+}
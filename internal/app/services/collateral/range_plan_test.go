@@ -0,0 +1,241 @@
+package collateral
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
+)
+
+func TestRangePlanServiceExecuteAscendingConstant(t *testing.T) {
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(true)}}
+	service := NewRangePlanService(sessionStore, testMarketInfo(), fakeClock{now: time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)})
+
+	result, err := service.Execute(context.Background(), RangePlanRequest{
+		Market:              "BTC_PERP",
+		Side:                "long",
+		StartPrice:          decimal.MustParse("49000"),
+		EndPrice:            decimal.MustParse("49100"),
+		Step:                decimal.MustParse("50"),
+		AmountMode:          AmountModeConstant,
+		BaseAmount:          decimal.MustParse("0.005"),
+		ClientOrderIDPrefix: "ladder",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Mode != "range" {
+		t.Fatalf("expected mode range, got %q", result.Mode)
+	}
+	if result.OrdersPlanned != 3 || len(result.Orders) != 3 {
+		t.Fatalf("expected 3 planned orders, got %d/%d", result.OrdersPlanned, len(result.Orders))
+	}
+	if result.OrdersSubmitted != 0 {
+		t.Fatalf("expected no submitted orders in plan, got %d", result.OrdersSubmitted)
+	}
+
+	wantPrices := []string{"49000", "49050", "49100"}
+	for index, order := range result.Orders {
+		if order.Price != wantPrices[index] {
+			t.Fatalf("order %d: expected price %s, got %s", index, wantPrices[index], order.Price)
+		}
+		if order.Amount != "0.005" {
+			t.Fatalf("order %d: expected amount 0.005, got %s", index, order.Amount)
+		}
+		if order.Side != "buy" || order.PositionSide != "long" {
+			t.Fatalf("order %d: expected buy/long, got %s/%s", index, order.Side, order.PositionSide)
+		}
+	}
+	if result.Orders[2].ClientOrderID != "ladder-2" {
+		t.Fatalf("expected client order id ladder-2, got %q", result.Orders[2].ClientOrderID)
+	}
+	if result.TotalAmount != "0.015" {
+		t.Fatalf("expected total amount 0.015, got %s", result.TotalAmount)
+	}
+	if result.TotalNotional != "735.75" {
+		t.Fatalf("expected total notional 735.75, got %s", result.TotalNotional)
+	}
+}
+
+func TestRangePlanServiceExecuteDescendingArithmeticOneWay(t *testing.T) {
+	service := NewRangePlanService(&fakeSessionStore{}, testMarketInfo(), fakeClock{now: time.Now()})
+
+	result, err := service.Execute(context.Background(), RangePlanRequest{
+		Market:          "BTC_PERP",
+		Side:            "sell",
+		StartPrice:      decimal.MustParse("51000"),
+		EndPrice:        decimal.MustParse("50800"),
+		Step:            decimal.MustParse("100"),
+		AmountMode:      AmountModeArithmetic,
+		BaseAmount:      decimal.MustParse("0.01"),
+		StartMultiplier: decimal.MustParse("1"),
+		StepMultiplier:  decimal.MustParse("0.5"),
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	wantPrices := []string{"51000", "50900", "50800"}
+	wantAmounts := []string{"0.01", "0.015", "0.02"}
+	for index, order := range result.Orders {
+		if order.Price != wantPrices[index] || order.Amount != wantAmounts[index] {
+			t.Fatalf("order %d: expected %s@%s, got %s@%s", index, wantAmounts[index], wantPrices[index], order.Amount, order.Price)
+		}
+		if order.PositionSide != "" {
+			t.Fatalf("order %d: expected no position side without cached hedge mode, got %q", index, order.PositionSide)
+		}
+	}
+}
+
+func TestBuildRangeOrdersAmountModes(t *testing.T) {
+	testCases := []struct {
+		name        string
+		request     RangePlanRequest
+		wantAmounts []string
+	}{
+		{
+			name:        "geometric",
+			request:     RangePlanRequest{AmountMode: AmountModeGeometric, Ratio: decimal.MustParse("2")},
+			wantAmounts: []string{"1", "2", "4", "8"},
+		},
+		{
+			name:        "capped geometric",
+			request:     RangePlanRequest{AmountMode: AmountModeCappedGeometric, Ratio: decimal.MustParse("2"), MaxMultiplier: decimal.MustParse("3")},
+			wantAmounts: []string{"1", "2", "3", "3"},
+		},
+		{
+			name:        "fibonacci",
+			request:     RangePlanRequest{AmountMode: AmountModeFibonacci},
+			wantAmounts: []string{"1", "1", "2", "3"},
+		},
+		{
+			name:        "custom list",
+			request:     RangePlanRequest{AmountMode: AmountModeCustomList, Multipliers: decimalList("1", "1.5", "2", "2.5", "3")},
+			wantAmounts: []string{"1", "1.5", "2", "2.5"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			request := testCase.request
+			request.Market = "BTC_PERP"
+			request.Side = "buy"
+			request.StartPrice = decimal.MustParse("100")
+			request.EndPrice = decimal.MustParse("103")
+			request.Step = decimal.MustParse("1")
+			request.BaseAmount = decimal.MustParse("1")
+
+			orders, err := buildRangeOrders(request, false)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(orders) != len(testCase.wantAmounts) {
+				t.Fatalf("expected %d orders, got %d", len(testCase.wantAmounts), len(orders))
+			}
+			for index, order := range orders {
+				if order.Amount != testCase.wantAmounts[index] {
+					t.Fatalf("order %d: expected amount %s, got %s", index, testCase.wantAmounts[index], order.Amount)
+				}
+				if !order.PostOnly {
+					t.Fatalf("order %d: expected postOnly=true", index)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildRangeOrdersValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		request RangePlanRequest
+		wantErr error
+	}{
+		{
+			name:    "zero step",
+			request: RangePlanRequest{StartPrice: decimal.MustParse("100"), EndPrice: decimal.MustParse("110"), AmountMode: AmountModeConstant, BaseAmount: decimal.MustParse("1")},
+			wantErr: ErrRangeInvalidBounds,
+		},
+		{
+			name:    "missing base amount",
+			request: RangePlanRequest{StartPrice: decimal.MustParse("100"), EndPrice: decimal.MustParse("110"), Step: decimal.MustParse("1"), AmountMode: AmountModeConstant},
+			wantErr: ErrRangeInvalidBaseAmount,
+		},
+		{
+			name:    "too many orders",
+			request: RangePlanRequest{StartPrice: decimal.MustParse("100"), EndPrice: decimal.MustParse("110"), Step: decimal.MustParse("1"), AmountMode: AmountModeConstant, BaseAmount: decimal.MustParse("1"), MaxOrders: 5},
+			wantErr: ErrRangeTooManyOrders,
+		},
+		{
+			name:    "unknown amount mode",
+			request: RangePlanRequest{StartPrice: decimal.MustParse("100"), EndPrice: decimal.MustParse("110"), Step: decimal.MustParse("5"), AmountMode: "random", BaseAmount: decimal.MustParse("1")},
+			wantErr: ErrRangeInvalidAmountMode,
+		},
+		{
+			name:    "custom list too short",
+			request: RangePlanRequest{StartPrice: decimal.MustParse("100"), EndPrice: decimal.MustParse("110"), Step: decimal.MustParse("5"), AmountMode: AmountModeCustomList, BaseAmount: decimal.MustParse("1"), Multipliers: decimalList("1")},
+			wantErr: ErrRangeMultipliersMissing,
+		},
+		{
+			name:    "capped geometric without cap",
+			request: RangePlanRequest{StartPrice: decimal.MustParse("100"), EndPrice: decimal.MustParse("110"), Step: decimal.MustParse("5"), AmountMode: AmountModeCappedGeometric, BaseAmount: decimal.MustParse("1"), Ratio: decimal.MustParse("2")},
+			wantErr: ErrRangeInvalidMultiplier,
+		},
+		{
+			name:    "arithmetic reaches zero",
+			request: RangePlanRequest{StartPrice: decimal.MustParse("100"), EndPrice: decimal.MustParse("110"), Step: decimal.MustParse("5"), AmountMode: AmountModeArithmetic, BaseAmount: decimal.MustParse("1"), StartMultiplier: decimal.MustParse("1"), StepMultiplier: decimal.MustParse("-1")},
+			wantErr: ErrRangeInvalidMultiplier,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			request := testCase.request
+			request.Market = "BTC_PERP"
+			request.Side = "buy"
+
+			_, err := buildRangeOrders(request, false)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("expected error %v, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func decimalList(values ...string) []decimal.Decimal {
+	parsed := make([]decimal.Decimal, 0, len(values))
+	for _, value := range values {
+		parsed = append(parsed, decimal.MustParse(value))
+	}
+
+	return parsed
+}
+
+func TestBuildRangeOrdersHasNoFloatDrift(t *testing.T) {
+	orders, err := buildRangeOrders(RangePlanRequest{
+		Market:     "DOGE_PERP",
+		Side:       "buy",
+		StartPrice: decimal.MustParse("0.1"),
+		EndPrice:   decimal.MustParse("0.7"),
+		Step:       decimal.MustParse("0.1"),
+		AmountMode: AmountModeGeometric,
+		BaseAmount: decimal.MustParse("0.005"),
+		Ratio:      decimal.MustParse("1.5"),
+	}, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	wantPrices := []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7"}
+	wantAmounts := []string{"0.005", "0.0075", "0.01125", "0.016875", "0.0253125", "0.03796875", "0.05695313"}
+	if len(orders) != len(wantPrices) {
+		t.Fatalf("expected %d orders, got %d", len(wantPrices), len(orders))
+	}
+	for index, order := range orders {
+		if order.Price != wantPrices[index] || order.Amount != wantAmounts[index] {
+			t.Fatalf("order %d: expected %s@%s, got %s@%s", index, wantAmounts[index], wantPrices[index], order.Amount, order.Price)
+		}
+	}
+}
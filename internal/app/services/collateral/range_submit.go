@@ -0,0 +1,247 @@
+package collateral
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+// DefaultRangeChunkSize matches the WhiteBIT bulk endpoint limit of orders per request.
+const DefaultRangeChunkSize = 20
+
+// Range order submission statuses.
+const (
+	RangeOrderStatusAccepted = "accepted"
+	RangeOrderStatusRejected = "rejected"
+	RangeOrderStatusSkipped  = "skipped"
+	// RangeOrderStatusUnknown marks orders of a chunk that failed in transport and may have landed.
+	RangeOrderStatusUnknown = "unknown"
+)
+
+// RangeSubmitRequest is input for collateral range live submission use-case.
+type RangeSubmitRequest struct {
+	RangePlanRequest
+	StopOnFail bool
+	ChunkSize  int
+}
+
+// RangeSubmitService submits a planned range through the collateral bulk endpoint in chunks.
+type RangeSubmitService struct {
+	credentialStore ports.CredentialStore
+	orderExecutor   ports.CollateralOrderExecutor
+	marketInfo      ports.MarketInfoProvider
+	clock           ports.Clock
+	hedgeModes      hedgeModeResolver
+}
+
+// NewRangeSubmitService constructs RangeSubmitService.
+func NewRangeSubmitService(
+	credentialStore ports.CredentialStore,
+	sessionStore ports.SessionStore,
+	orderExecutor ports.CollateralOrderExecutor,
+	marketInfo ports.MarketInfoProvider,
+	clock ports.Clock,
+) *RangeSubmitService {
+	return &RangeSubmitService{
+		credentialStore: credentialStore,
+		orderExecutor:   orderExecutor,
+		marketInfo:      marketInfo,
+		clock:           clock,
+		hedgeModes:      newHedgeModeResolver(credentialStore, sessionStore, orderExecutor, clock),
+	}
+}
+
+// Execute plans the range and submits it chunk by chunk.
+// A chunk that failed in transport is reported as unknown, since it may have landed, and the orders
+// after it as skipped. Any other failure of the first chunk returns an error; later chunk failures are
+// reported per order so partial placement stays visible. With StopOnFail, orders after the first
+// rejection are skipped.
+func (service *RangeSubmitService) Execute(ctx context.Context, request RangeSubmitRequest) (RangePlanResult, error) {
+	market, err := lookupMarket(ctx, service.marketInfo, request.Market)
+	if err != nil {
+		return RangePlanResult{}, err
+	}
+	// orders are validated in one-way shape before the credential is loaded; hedge mode only changes sides
+	orders, err := buildMarketRangeOrders(request.RangePlanRequest, market, false)
+	if err != nil {
+		return RangePlanResult{}, err
+	}
+
+	credential, err := service.credentialStore.Load(ctx)
+	if err != nil {
+		return RangePlanResult{}, fmt.Errorf("load credential: %w", err)
+	}
+	defer domainauth.WipeBytes(credential.APISecret)
+
+	hedgeMode, err := service.hedgeModes.resolve(ctx, credential)
+	if err != nil {
+		return RangePlanResult{}, fmt.Errorf("resolve hedge mode: %w", err)
+	}
+	applyRangeOrderSides(orders, request.Side, hedgeMode)
+
+	result := newRangePlanResult(
+		fmt.Sprintf("%s-%d", collateralRangePrefix, service.clock.Now().UTC().UnixNano()),
+		request.RangePlanRequest,
+		orders,
+	)
+
+	chunkSize := request.ChunkSize
+	if chunkSize <= 0 || chunkSize > DefaultRangeChunkSize {
+		chunkSize = DefaultRangeChunkSize
+	}
+
+	hedgeModeRefreshed := false
+	for start := 0; start < len(orders); start += chunkSize {
+		end := min(start+chunkSize, len(orders))
+
+		outcomes, err := service.orderExecutor.PlaceCollateralBulkLimitOrder(ctx, credential, orders[start:end], request.StopOnFail)
+		if err != nil {
+			if !isTransportError(err) && !errors.Is(err, ErrOrderStatusUnknown) {
+				if start == 0 {
+					return RangePlanResult{}, fmt.Errorf("place collateral bulk limit order: %w", err)
+				}
+				markRangeOrders(&result, start, len(orders), RangeOrderStatusRejected, singleLineError(err))
+				break
+			}
+
+			markRangeOrders(&result, start, end, RangeOrderStatusUnknown, fmt.Sprintf(
+				"%s; check wbcli collateral order list --market %s --client-order-id-prefix %s before resubmitting",
+				singleLineError(err), market.Name, rangeClientOrderIDPrefix(request.RangePlanRequest),
+			))
+			if end < len(orders) {
+				markRangeOrders(&result, end, len(orders), RangeOrderStatusSkipped, "skipped after transport error")
+			}
+			break
+		}
+
+		if !hedgeModeRefreshed && hasHedgeModeMismatch(outcomes) {
+			hedgeModeRefreshed = true
+
+			refreshedHedgeMode, refreshErr := service.hedgeModes.refresh(ctx, credential)
+			if refreshErr == nil && refreshedHedgeMode != hedgeMode {
+				hedgeMode = refreshedHedgeMode
+				applyRangeOrderSides(orders, request.Side, hedgeMode)
+				syncRangeOrderSides(&result, orders)
+
+				outcomes = service.retryMismatched(ctx, credential, orders[start:end], outcomes, request.StopOnFail)
+			}
+		}
+
+		rejected := applyRangeOutcomes(&result, start, outcomes)
+		if request.StopOnFail && rejected && end < len(orders) {
+			markRangeOrders(&result, end, len(orders), RangeOrderStatusSkipped, "skipped after rejection with stop-on-fail")
+			break
+		}
+	}
+
+	return result, nil
+}
+
+func (service *RangeSubmitService) retryMismatched(
+	ctx context.Context,
+	credential domainauth.Credential,
+	chunk []ports.CollateralLimitOrderRequest,
+	outcomes []ports.CollateralBulkOrderResult,
+	stopOnFail bool,
+) []ports.CollateralBulkOrderResult {
+	retryIndexes := make([]int, 0, len(outcomes))
+	retryOrders := make([]ports.CollateralLimitOrderRequest, 0, len(outcomes))
+	for index, outcome := range outcomes {
+		if !outcome.Accepted && isHedgeModeMismatchMessage(outcome.Error) {
+			retryIndexes = append(retryIndexes, index)
+			retryOrders = append(retryOrders, chunk[index])
+		}
+	}
+
+	retried, err := service.orderExecutor.PlaceCollateralBulkLimitOrder(ctx, credential, retryOrders, stopOnFail)
+	if err != nil {
+		return outcomes
+	}
+
+	merged := append([]ports.CollateralBulkOrderResult(nil), outcomes...)
+	for position, index := range retryIndexes {
+		if position < len(retried) {
+			merged[index] = retried[position]
+		}
+	}
+
+	return merged
+}
+
+func applyRangeOutcomes(result *RangePlanResult, offset int, outcomes []ports.CollateralBulkOrderResult) bool {
+	rejected := false
+	for position, outcome := range outcomes {
+		index := offset + position
+		if index >= len(result.Orders) {
+			break
+		}
+
+		order := &result.Orders[index]
+		if outcome.Accepted {
+			order.Status = RangeOrderStatusAccepted
+			order.OrderID = outcome.OrderID
+			result.OrdersSubmitted++
+			continue
+		}
+
+		rejected = true
+		order.Status = RangeOrderStatusRejected
+		order.Error = outcome.Error
+		result.OrdersFailed++
+		result.Errors = append(result.Errors, fmt.Sprintf("order %d: %s", index, outcome.Error))
+	}
+
+	return rejected
+}
+
+func markRangeOrders(result *RangePlanResult, from int, to int, status string, message string) {
+	for index := from; index < to; index++ {
+		result.Orders[index].Status = status
+		result.Orders[index].Error = message
+		if status == RangeOrderStatusUnknown {
+			result.OrdersUnknown++
+		} else {
+			result.OrdersFailed++
+		}
+	}
+	result.Errors = append(result.Errors, fmt.Sprintf("orders %d-%d %s: %s", from, to-1, status, message))
+}
+
+// applyRangeOrderSides sets order and position sides of built range orders for hedgeMode.
+func applyRangeOrderSides(orders []ports.CollateralLimitOrderRequest, side string, hedgeMode bool) {
+	orderSide, positionSide := resolveOrderSides(side, hedgeMode)
+	for index := range orders {
+		orders[index].Side = orderSide
+		orders[index].PositionSide = positionSide
+	}
+}
+
+// rangeClientOrderIDPrefix returns the prefix shared by the client order ids of submitted range orders.
+func rangeClientOrderIDPrefix(request RangePlanRequest) string {
+	if prefix := strings.TrimSpace(request.ClientOrderIDPrefix); prefix != "" {
+		return prefix + "-"
+	}
+
+	return RetryClientOrderIDPrefix
+}
+
+func syncRangeOrderSides(result *RangePlanResult, orders []ports.CollateralLimitOrderRequest) {
+	for index := range result.Orders {
+		result.Orders[index].Side = orders[index].Side
+		result.Orders[index].PositionSide = orders[index].PositionSide
+	}
+}
+
+func hasHedgeModeMismatch(outcomes []ports.CollateralBulkOrderResult) bool {
+	for _, outcome := range outcomes {
+		if !outcome.Accepted && isHedgeModeMismatchMessage(outcome.Error) {
+			return true
+		}
+	}
+
+	return false
+}
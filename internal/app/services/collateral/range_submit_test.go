@@ -0,0 +1,313 @@
+package collateral
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
+)
+
+func TestRangeSubmitServiceExecuteSubmitsInChunks(t *testing.T) {
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(false)}}
+	orderExecutor := &fakeOrderExecutor{}
+	service := NewRangeSubmitService(credentialStore, sessionStore, orderExecutor, testMarketInfo(), fakeClock{now: time.Now()})
+
+	request := RangeSubmitRequest{
+		RangePlanRequest: RangePlanRequest{
+			Market:              "BTC_PERP",
+			Side:                "buy",
+			StartPrice:          decimal.MustParse("100"),
+			EndPrice:            decimal.MustParse("104"),
+			Step:                decimal.MustParse("1"),
+			AmountMode:          AmountModeConstant,
+			BaseAmount:          decimal.MustParse("0.01"),
+			ClientOrderIDPrefix: "run",
+		},
+		ChunkSize: 2,
+	}
+	result, err := service.Execute(context.Background(), request)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(orderExecutor.bulkRequests) != 3 {
+		t.Fatalf("expected 3 bulk chunks, got %d", len(orderExecutor.bulkRequests))
+	}
+	if got := len(orderExecutor.bulkRequests[2]); got != 1 {
+		t.Fatalf("expected last chunk with 1 order, got %d", got)
+	}
+	if result.Mode != "range" || result.OrdersPlanned != 5 || result.OrdersSubmitted != 5 || result.OrdersFailed != 0 {
+		t.Fatalf("unexpected result counters: %+v", result.PlaceOrderResult)
+	}
+	if result.Orders[4].Status != RangeOrderStatusAccepted || result.Orders[4].OrderID != 1000 {
+		t.Fatalf("unexpected last order outcome: %+v", result.Orders[4])
+	}
+}
+
+func TestRangeSubmitServiceExecuteStopOnFailSkipsRemainingChunks(t *testing.T) {
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(false)}}
+	orderExecutor := &fakeOrderExecutor{
+		bulkResults: func(orders []ports.CollateralLimitOrderRequest) ([]ports.CollateralBulkOrderResult, error) {
+			return []ports.CollateralBulkOrderResult{
+				{Accepted: true, OrderID: 1, ClientOrderID: orders[0].ClientOrderID},
+				{Error: "Validation failed: amount: too small"},
+			}, nil
+		},
+	}
+	service := NewRangeSubmitService(credentialStore, sessionStore, orderExecutor, testMarketInfo(), fakeClock{now: time.Now()})
+
+	request := RangeSubmitRequest{
+		RangePlanRequest: RangePlanRequest{
+			Market:              "BTC_PERP",
+			Side:                "buy",
+			StartPrice:          decimal.MustParse("100"),
+			EndPrice:            decimal.MustParse("104"),
+			Step:                decimal.MustParse("1"),
+			AmountMode:          AmountModeConstant,
+			BaseAmount:          decimal.MustParse("0.01"),
+			ClientOrderIDPrefix: "run",
+		},
+		ChunkSize:  2,
+		StopOnFail: true,
+	}
+	result, err := service.Execute(context.Background(), request)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(orderExecutor.bulkRequests) != 1 {
+		t.Fatalf("expected submission to stop after first chunk, got %d chunks", len(orderExecutor.bulkRequests))
+	}
+	if !orderExecutor.bulkStopOnFail[0] {
+		t.Fatalf("expected stopOnFail to be passed to executor")
+	}
+	if result.OrdersSubmitted != 1 || result.OrdersFailed != 4 {
+		t.Fatalf("unexpected result counters: %+v", result.PlaceOrderResult)
+	}
+	if result.Orders[1].Status != RangeOrderStatusRejected || !strings.Contains(result.Orders[1].Error, "too small") {
+		t.Fatalf("expected rejected order 1, got %+v", result.Orders[1])
+	}
+	for _, order := range result.Orders[2:] {
+		if order.Status != RangeOrderStatusSkipped {
+			t.Fatalf("expected skipped order, got %+v", order)
+		}
+	}
+}
+
+func TestRangeSubmitServiceExecuteRetriesHedgeModeMismatch(t *testing.T) {
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(false)}}
+	orderExecutor := &fakeOrderExecutor{getHedgeModeValue: true}
+	orderExecutor.bulkResults = func(orders []ports.CollateralLimitOrderRequest) ([]ports.CollateralBulkOrderResult, error) {
+		results := make([]ports.CollateralBulkOrderResult, len(orders))
+		for index, order := range orders {
+			if order.PositionSide == "" {
+				results[index].Error = "hedgeMode: Order's position side does not match user's setting"
+				continue
+			}
+			results[index] = ports.CollateralBulkOrderResult{Accepted: true, OrderID: int64(index + 1)}
+		}
+
+		return results, nil
+	}
+	service := NewRangeSubmitService(credentialStore, sessionStore, orderExecutor, testMarketInfo(), fakeClock{now: time.Now()})
+
+	request := RangeSubmitRequest{
+		RangePlanRequest: RangePlanRequest{
+			Market:              "BTC_PERP",
+			Side:                "buy",
+			StartPrice:          decimal.MustParse("100"),
+			EndPrice:            decimal.MustParse("103"),
+			Step:                decimal.MustParse("1"),
+			AmountMode:          AmountModeConstant,
+			BaseAmount:          decimal.MustParse("0.01"),
+			ClientOrderIDPrefix: "run",
+		},
+		ChunkSize: 2,
+	}
+	result, err := service.Execute(context.Background(), request)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if orderExecutor.getHedgeModeCalls != 1 {
+		t.Fatalf("expected one hedge mode refresh, got %d", orderExecutor.getHedgeModeCalls)
+	}
+	if len(orderExecutor.bulkRequests) != 3 {
+		t.Fatalf("expected mismatched chunk retry plus second chunk, got %d requests", len(orderExecutor.bulkRequests))
+	}
+	if result.OrdersSubmitted != 4 || result.OrdersFailed != 0 {
+		t.Fatalf("unexpected result counters: %+v", result.PlaceOrderResult)
+	}
+	if result.Orders[3].PositionSide != "long" {
+		t.Fatalf("expected refreshed position side long, got %q", result.Orders[3].PositionSide)
+	}
+	if sessionStore.session.HedgeMode == nil || !*sessionStore.session.HedgeMode {
+		t.Fatalf("expected refreshed hedge mode to be persisted")
+	}
+}
+
+func TestRangeSubmitServiceExecuteMarksTransportFailedChunkUnknown(t *testing.T) {
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(false)}}
+	calls := 0
+	orderExecutor := &fakeOrderExecutor{
+		bulkResults: func(orders []ports.CollateralLimitOrderRequest) ([]ports.CollateralBulkOrderResult, error) {
+			calls++
+			if calls == 2 {
+				return nil, &ports.APIError{Code: ports.CodeTransport, Message: "bulk order placement failed: exchange unavailable"}
+			}
+			results := make([]ports.CollateralBulkOrderResult, len(orders))
+			for index := range orders {
+				results[index] = ports.CollateralBulkOrderResult{Accepted: true, OrderID: int64(index + 1)}
+			}
+			return results, nil
+		},
+	}
+	service := NewRangeSubmitService(credentialStore, sessionStore, orderExecutor, testMarketInfo(), fakeClock{now: time.Now()})
+
+	request := RangeSubmitRequest{
+		RangePlanRequest: RangePlanRequest{
+			Market:              "BTC_PERP",
+			Side:                "buy",
+			StartPrice:          decimal.MustParse("100"),
+			EndPrice:            decimal.MustParse("104"),
+			Step:                decimal.MustParse("1"),
+			AmountMode:          AmountModeConstant,
+			BaseAmount:          decimal.MustParse("0.01"),
+			ClientOrderIDPrefix: "run",
+		},
+		ChunkSize: 2,
+	}
+	result, err := service.Execute(context.Background(), request)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(orderExecutor.bulkRequests) != 2 {
+		t.Fatalf("expected submission to stop after the failed chunk, got %d chunks", len(orderExecutor.bulkRequests))
+	}
+	if result.OrdersSubmitted != 2 || result.OrdersUnknown != 2 || result.OrdersFailed != 1 {
+		t.Fatalf("unexpected result counters: submitted=%d unknown=%d failed=%d", result.OrdersSubmitted, result.OrdersUnknown, result.OrdersFailed)
+	}
+	for _, order := range result.Orders[2:4] {
+		if order.Status != RangeOrderStatusUnknown || !strings.Contains(order.Error, "--client-order-id-prefix run-") {
+			t.Fatalf("expected unknown order with order list hint, got %+v", order)
+		}
+	}
+	if result.Orders[4].Status != RangeOrderStatusSkipped {
+		t.Fatalf("expected skipped order after the failed chunk, got %+v", result.Orders[4])
+	}
+}
+
+func TestRangeSubmitServiceExecuteKeepsPlanWhenFirstChunkFailsInTransport(t *testing.T) {
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(false)}}
+	orderExecutor := &fakeOrderExecutor{
+		bulkResults: func([]ports.CollateralLimitOrderRequest) ([]ports.CollateralBulkOrderResult, error) {
+			return nil, fmt.Errorf("place bulk limit order: %w", ErrOrderStatusUnknown)
+		},
+	}
+	service := NewRangeSubmitService(credentialStore, sessionStore, orderExecutor, testMarketInfo(), fakeClock{now: time.Now()})
+
+	request := RangeSubmitRequest{
+		RangePlanRequest: RangePlanRequest{
+			Market:              "BTC_PERP",
+			Side:                "buy",
+			StartPrice:          decimal.MustParse("100"),
+			EndPrice:            decimal.MustParse("104"),
+			Step:                decimal.MustParse("1"),
+			AmountMode:          AmountModeConstant,
+			BaseAmount:          decimal.MustParse("0.01"),
+			ClientOrderIDPrefix: "run",
+		},
+		ChunkSize: 2,
+	}
+	result, err := service.Execute(context.Background(), request)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(orderExecutor.bulkRequests) != 1 || result.OrdersPlanned != 5 || result.OrdersSubmitted != 0 || result.OrdersUnknown != 2 {
+		t.Fatalf("unexpected result: chunks=%d planned=%d submitted=%d unknown=%d",
+			len(orderExecutor.bulkRequests), result.OrdersPlanned, result.OrdersSubmitted, result.OrdersUnknown)
+	}
+	for index, order := range result.Orders {
+		expected := RangeOrderStatusSkipped
+		if index < 2 {
+			expected = RangeOrderStatusUnknown
+		}
+		if order.Status != expected {
+			t.Fatalf("expected order %d to be %s, got %+v", index, expected, order)
+		}
+	}
+}
+
+func TestRangeSubmitServiceExecuteFirstChunkErrorFails(t *testing.T) {
+	credentialStore := &fakeCredentialStore{
+		loadCredential: domainauth.Credential{APIKey: "public-key", APISecret: []byte("secret-key")},
+	}
+	sessionStore := &fakeSessionStore{session: &ports.SessionMetadata{HedgeMode: boolPtr(false)}}
+	forbidden := &ports.APIError{Code: ports.CodeForbidden, Message: "bulk order placement failed: API token lacks endpoint permission"}
+	orderExecutor := &fakeOrderExecutor{
+		bulkResults: func([]ports.CollateralLimitOrderRequest) ([]ports.CollateralBulkOrderResult, error) {
+			return nil, forbidden
+		},
+	}
+	service := NewRangeSubmitService(credentialStore, sessionStore, orderExecutor, testMarketInfo(), fakeClock{now: time.Now()})
+
+	request := RangeSubmitRequest{
+		RangePlanRequest: RangePlanRequest{
+			Market:              "BTC_PERP",
+			Side:                "buy",
+			StartPrice:          decimal.MustParse("100"),
+			EndPrice:            decimal.MustParse("104"),
+			Step:                decimal.MustParse("1"),
+			AmountMode:          AmountModeConstant,
+			BaseAmount:          decimal.MustParse("0.01"),
+			ClientOrderIDPrefix: "run",
+		},
+		ChunkSize: 2,
+	}
+	_, err := service.Execute(context.Background(), request)
+	var apiErr *ports.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != ports.CodeForbidden {
+		t.Fatalf("expected forbidden api error, got %v", err)
+	}
+}
+
+func TestRangeSubmitServiceExecuteValidatesBeforeLoadingCredential(t *testing.T) {
+	credentialStore := &fakeCredentialStore{loadErr: ports.ErrCredentialNotFound}
+	orderExecutor := &fakeOrderExecutor{}
+	service := NewRangeSubmitService(credentialStore, &fakeSessionStore{}, orderExecutor, testMarketInfo(), fakeClock{now: time.Now()})
+
+	request := RangeSubmitRequest{
+		RangePlanRequest: RangePlanRequest{
+			Market:              "BTC_PERP",
+			Side:                "buy",
+			StartPrice:          decimal.MustParse("100"),
+			EndPrice:            decimal.MustParse("104"),
+			Step:                decimal.MustParse("1"),
+			AmountMode:          AmountModeConstant,
+			ClientOrderIDPrefix: "run",
+		},
+		ChunkSize: 2,
+	}
+	_, err := service.Execute(context.Background(), request)
+	if !errors.Is(err, ErrRangeInvalidBaseAmount) {
+		t.Fatalf("expected plan validation error, got %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package collateral
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
+)
+
+var (
+	// ErrInvalidStopLoss indicates a stop-loss price that is not a positive decimal.
+	ErrInvalidStopLoss = errors.New("stop-loss must be a decimal greater than 0")
+	// ErrInvalidTakeProfit indicates a take-profit price that is not a positive decimal.
+	ErrInvalidTakeProfit = errors.New("take-profit must be a decimal greater than 0")
+	// ErrStopLossPlacement indicates a stop-loss on the profit side of the entry price.
+	ErrStopLossPlacement = errors.New("stop-loss must be below price for buy/long and above price for sell/short")
+	// ErrTakeProfitPlacement indicates a take-profit on the loss side of the entry price.
+	ErrTakeProfitPlacement = errors.New("take-profit must be above price for buy/long and below price for sell/short")
+	// ErrInvalidStopOffset indicates a negative per-level stop-loss or take-profit offset.
+	ErrInvalidStopOffset = errors.New("stop-loss and take-profit offsets must not be negative")
+)
+
+// applyStopLevelRules checks attached stop-loss and take-profit prices against tick size and entry price.
+// With snap, each level moves to the tick further from the entry price, so protection never tightens.
+func applyStopLevelRules(order *ports.CollateralLimitOrderRequest, price decimal.Decimal, tick decimal.Decimal, snap bool) error {
+	buy := order.Side != "sell"
+
+	if order.StopLoss != "" {
+		stopLoss, err := parseStopLevel(order.StopLoss, ErrInvalidStopLoss)
+		if err != nil {
+			return err
+		}
+		if stopLoss, err = snapStopLevel(stopLoss, tick, snap, !buy); err != nil {
+			return fmt.Errorf("stop-loss: %w", err)
+		}
+		if stopLoss.Sign() <= 0 {
+			return fmt.Errorf("%w: %q", ErrInvalidStopLoss, stopLoss.String())
+		}
+		if (buy && stopLoss.Cmp(price) >= 0) || (!buy && stopLoss.Cmp(price) <= 0) {
+			return fmt.Errorf("%w: price %s, stop-loss %s", ErrStopLossPlacement, price.Normalize(), stopLoss.Normalize())
+		}
+		order.StopLoss = stopLoss.Normalize().String()
+	}
+
+	if order.TakeProfit != "" {
+		takeProfit, err := parseStopLevel(order.TakeProfit, ErrInvalidTakeProfit)
+		if err != nil {
+			return err
+		}
+		if takeProfit, err = snapStopLevel(takeProfit, tick, snap, buy); err != nil {
+			return fmt.Errorf("take-profit: %w", err)
+		}
+		if (buy && takeProfit.Cmp(price) <= 0) || (!buy && takeProfit.Cmp(price) >= 0) {
+			return fmt.Errorf("%w: price %s, take-profit %s", ErrTakeProfitPlacement, price.Normalize(), takeProfit.Normalize())
+		}
+		order.TakeProfit = takeProfit.Normalize().String()
+	}
+
+	return nil
+}
+
+// rangeStopLevels returns per-level stop-loss and take-profit prices at fixed offsets from the level price.
+// Zero offsets leave the level empty.
+func rangeStopLevels(side string, price decimal.Decimal, stopLossOffset decimal.Decimal, takeProfitOffset decimal.Decimal) (string, string) {
+	if side == "sell" {
+		stopLossOffset, takeProfitOffset = stopLossOffset.Neg(), takeProfitOffset.Neg()
+	}
+
+	stopLoss, takeProfit := "", ""
+	if !stopLossOffset.IsZero() {
+		stopLoss = price.Sub(stopLossOffset).String()
+	}
+	if !takeProfitOffset.IsZero() {
+		takeProfit = price.Add(takeProfitOffset).String()
+	}
+
+	return stopLoss, takeProfit
+}
+
+func parseStopLevel(value string, invalid error) (decimal.Decimal, error) {
+	parsed, err := decimal.Parse(value)
+	if err != nil || parsed.Sign() <= 0 {
+		return decimal.Decimal{}, fmt.Errorf("%w: %q", invalid, value)
+	}
+
+	return parsed, nil
+}
+
+func snapStopLevel(level decimal.Decimal, tick decimal.Decimal, snap bool, up bool) (decimal.Decimal, error) {
+	if level.IsMultipleOf(tick) {
+		return level, nil
+	}
+	if !snap {
+		return decimal.Decimal{}, fmt.Errorf("%w: tick %s, got %s", ErrMarketPricePrecision, tick, level)
+	}
+	if up {
+		return level.CeilMultiple(tick), nil
+	}
+
+	return level.FloorMultiple(tick), nil
+}
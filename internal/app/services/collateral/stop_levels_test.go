@@ -0,0 +1,126 @@
+package collateral
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
+)
+
+func TestApplyStopLevelRulesChecksPlacement(t *testing.T) {
+	testCases := []struct {
+		name    string
+		order   ports.CollateralLimitOrderRequest
+		wantErr error
+	}{
+		{name: "buy stop-loss above price", order: ports.CollateralLimitOrderRequest{Side: "buy", StopLoss: "50500"}, wantErr: ErrStopLossPlacement},
+		{name: "buy take-profit below price", order: ports.CollateralLimitOrderRequest{Side: "buy", TakeProfit: "49500"}, wantErr: ErrTakeProfitPlacement},
+		{name: "sell stop-loss below price", order: ports.CollateralLimitOrderRequest{Side: "sell", StopLoss: "49500"}, wantErr: ErrStopLossPlacement},
+		{name: "sell take-profit above price", order: ports.CollateralLimitOrderRequest{Side: "sell", TakeProfit: "50500"}, wantErr: ErrTakeProfitPlacement},
+		{name: "stop-loss at price", order: ports.CollateralLimitOrderRequest{Side: "buy", StopLoss: "50000"}, wantErr: ErrStopLossPlacement},
+		{name: "negative stop-loss", order: ports.CollateralLimitOrderRequest{Side: "buy", StopLoss: "-1"}, wantErr: ErrInvalidStopLoss},
+		{name: "malformed take-profit", order: ports.CollateralLimitOrderRequest{Side: "buy", TakeProfit: "abc"}, wantErr: ErrInvalidTakeProfit},
+		{name: "off tick without snap", order: ports.CollateralLimitOrderRequest{Side: "buy", StopLoss: "49000.2"}, wantErr: ErrMarketPricePrecision},
+		{name: "valid buy", order: ports.CollateralLimitOrderRequest{Side: "buy", StopLoss: "49000", TakeProfit: "51000.5"}},
+		{name: "valid sell", order: ports.CollateralLimitOrderRequest{Side: "sell", StopLoss: "51000", TakeProfit: "49000"}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			order := testCase.order
+			err := applyStopLevelRules(&order, decimal.MustParse("50000"), decimal.MustParse("0.5"), false)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("expected %v, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestApplyStopLevelRulesSnapsAwayFromEntry(t *testing.T) {
+	testCases := []struct {
+		name           string
+		side           string
+		stopLoss       string
+		takeProfit     string
+		wantStopLoss   string
+		wantTakeProfit string
+	}{
+		{name: "buy", side: "buy", stopLoss: "49000.2", takeProfit: "51000.2", wantStopLoss: "49000", wantTakeProfit: "51000.5"},
+		{name: "sell", side: "sell", stopLoss: "51000.2", takeProfit: "49000.2", wantStopLoss: "51000.5", wantTakeProfit: "49000"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			order := ports.CollateralLimitOrderRequest{Side: testCase.side, StopLoss: testCase.stopLoss, TakeProfit: testCase.takeProfit}
+			if err := applyStopLevelRules(&order, decimal.MustParse("50000"), decimal.MustParse("0.5"), true); err != nil {
+				t.Fatalf("apply stop levels failed: %v", err)
+			}
+			if order.StopLoss != testCase.wantStopLoss || order.TakeProfit != testCase.wantTakeProfit {
+				t.Fatalf("expected sl=%s tp=%s, got sl=%s tp=%s", testCase.wantStopLoss, testCase.wantTakeProfit, order.StopLoss, order.TakeProfit)
+			}
+		})
+	}
+}
+
+func TestApplyStopLevelRulesRejectsStopLossSnappedToZero(t *testing.T) {
+	order := ports.CollateralLimitOrderRequest{Side: "buy", StopLoss: "0.2"}
+	err := applyStopLevelRules(&order, decimal.MustParse("10"), decimal.MustParse("0.5"), true)
+	if !errors.Is(err, ErrInvalidStopLoss) {
+		t.Fatalf("expected %v, got %v", ErrInvalidStopLoss, err)
+	}
+}
+
+func TestBuildRangeOrdersAttachesStopLevels(t *testing.T) {
+	testCases := []struct {
+		name string
+		side string
+		want [][2]string
+	}{
+		{name: "buy", side: "buy", want: [][2]string{{"90", "115"}, {"95", "120"}}},
+		{name: "sell", side: "sell", want: [][2]string{{"110", "85"}, {"115", "90"}}},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			orders, err := buildRangeOrders(RangePlanRequest{
+				Market:           "BTC_PERP",
+				Side:             testCase.side,
+				StartPrice:       decimal.MustParse("100"),
+				EndPrice:         decimal.MustParse("105"),
+				Step:             decimal.MustParse("5"),
+				AmountMode:       AmountModeConstant,
+				BaseAmount:       decimal.MustParse("1"),
+				StopLossOffset:   decimal.MustParse("10"),
+				TakeProfitOffset: decimal.MustParse("15"),
+			}, false)
+			if err != nil {
+				t.Fatalf("build range orders failed: %v", err)
+			}
+			if len(orders) != len(testCase.want) {
+				t.Fatalf("expected %d orders, got %d", len(testCase.want), len(orders))
+			}
+			for index, order := range orders {
+				if order.StopLoss != testCase.want[index][0] || order.TakeProfit != testCase.want[index][1] {
+					t.Fatalf("order %d: expected sl/tp %v, got %s/%s", index, testCase.want[index], order.StopLoss, order.TakeProfit)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildRangeOrdersRejectsNegativeStopOffset(t *testing.T) {
+	_, err := buildRangeOrders(RangePlanRequest{
+		Market:         "BTC_PERP",
+		Side:           "buy",
+		StartPrice:     decimal.MustParse("100"),
+		EndPrice:       decimal.MustParse("105"),
+		Step:           decimal.MustParse("5"),
+		AmountMode:     AmountModeConstant,
+		BaseAmount:     decimal.MustParse("1"),
+		StopLossOffset: decimal.MustParse("-1"),
+	}, false)
+	if !errors.Is(err, ErrInvalidStopOffset) {
+		t.Fatalf("expected %v, got %v", ErrInvalidStopOffset, err)
+	}
+}
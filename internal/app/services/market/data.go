@@ -0,0 +1,248 @@
+package market
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
+)
+
+// MaxDepthLimit is the largest supported number of order book levels per side.
+const MaxDepthLimit = 100
+
+var (
+	// ErrMarketRequired indicates a missing market name.
+	ErrMarketRequired = errors.New("market is required")
+	// ErrDepthLimitInvalid indicates order book depth outside 1..MaxDepthLimit.
+	ErrDepthLimitInvalid = errors.New("depth limit must be between 1 and 100")
+	// ErrTradeSideInvalid indicates a trade side filter other than buy or sell.
+	ErrTradeSideInvalid = errors.New("trade side must be buy or sell")
+)
+
+// TickerRequest is input for ticker use-case. Empty Markets returns every market.
+type TickerRequest struct {
+	Markets []string
+}
+
+// Ticker is normalized 24h market summary.
+type Ticker struct {
+	Market      string `json:"market"`
+	LastPrice   string `json:"last_price"`
+	Change      string `json:"change_percent"`
+	BaseVolume  string `json:"base_volume"`
+	QuoteVolume string `json:"quote_volume"`
+	Frozen      bool   `json:"frozen"`
+}
+
+// TickerResult is normalized output for ticker use-case, sorted by market.
+type TickerResult struct {
+	Tickers []Ticker `json:"tickers"`
+}
+
+// DepthRequest is input for order book depth use-case. Zero Limit uses the exchange default.
+type DepthRequest struct {
+	Market string
+	Limit  int
+}
+
+// DepthLevel is one aggregated order book level.
+type DepthLevel struct {
+	Price  string `json:"price"`
+	Amount string `json:"amount"`
+}
+
+// DepthResult is normalized order book snapshot with top-of-book summary.
+// Spread fields are empty when either side of the book is empty.
+type DepthResult struct {
+	Market        string       `json:"market"`
+	Timestamp     time.Time    `json:"timestamp"`
+	BestBid       string       `json:"best_bid,omitempty"`
+	BestAsk       string       `json:"best_ask,omitempty"`
+	Spread        string       `json:"spread,omitempty"`
+	SpreadPercent string       `json:"spread_percent,omitempty"`
+	Asks          []DepthLevel `json:"asks"`
+	Bids          []DepthLevel `json:"bids"`
+}
+
+// TradesRequest is input for recent trades use-case. Empty Side returns both taker sides.
+type TradesRequest struct {
+	Market string
+	Side   string
+}
+
+// PublicTrade is normalized public market trade; Side is the taker side.
+type PublicTrade struct {
+	TradeID    int64     `json:"trade_id"`
+	Side       string    `json:"side"`
+	Price      string    `json:"price"`
+	Amount     string    `json:"amount"`
+	Total      string    `json:"total"`
+	ExecutedAt time.Time `json:"executed_at"`
+}
+
+// TradesResult is normalized output for recent trades use-case, newest first.
+type TradesResult struct {
+	Market string        `json:"market"`
+	Trades []PublicTrade `json:"trades"`
+}
+
+// DataService reads public market data without credentials.
+type DataService struct {
+	reader ports.MarketDataReader
+}
+
+// NewDataService constructs DataService.
+func NewDataService(reader ports.MarketDataReader) *DataService {
+	return &DataService{reader: reader}
+}
+
+// Ticker returns 24h tickers for requested markets or for every market.
+func (service *DataService) Ticker(ctx context.Context, request TickerRequest) (TickerResult, error) {
+	tickers, err := service.reader.Tickers(ctx)
+	if err != nil {
+		return TickerResult{}, fmt.Errorf("read tickers: %w", err)
+	}
+
+	byMarket := make(map[string]ports.Ticker, len(tickers))
+	for _, ticker := range tickers {
+		byMarket[strings.ToUpper(ticker.Market)] = ticker
+	}
+
+	selected := tickers
+	if len(request.Markets) > 0 {
+		selected = make([]ports.Ticker, 0, len(request.Markets))
+		for _, market := range request.Markets {
+			ticker, ok := byMarket[strings.ToUpper(strings.TrimSpace(market))]
+			if !ok {
+				return TickerResult{}, fmt.Errorf("%w: %s", ports.ErrMarketNotFound, strings.TrimSpace(market))
+			}
+			selected = append(selected, ticker)
+		}
+	}
+
+	result := TickerResult{Tickers: make([]Ticker, 0, len(selected))}
+	for _, ticker := range selected {
+		result.Tickers = append(result.Tickers, Ticker{
+			Market:      ticker.Market,
+			LastPrice:   ticker.LastPrice,
+			Change:      ticker.Change,
+			BaseVolume:  ticker.BaseVolume,
+			QuoteVolume: ticker.QuoteVolume,
+			Frozen:      ticker.Frozen,
+		})
+	}
+	sort.Slice(result.Tickers, func(left int, right int) bool {
+		return result.Tickers[left].Market < result.Tickers[right].Market
+	})
+
+	return result, nil
+}
+
+// Depth returns order book levels with best bid, best ask and spread.
+func (service *DataService) Depth(ctx context.Context, request DepthRequest) (DepthResult, error) {
+	market := strings.TrimSpace(request.Market)
+	if market == "" {
+		return DepthResult{}, ErrMarketRequired
+	}
+	if request.Limit < 0 || request.Limit > MaxDepthLimit {
+		return DepthResult{}, fmt.Errorf("%w: got %d", ErrDepthLimitInvalid, request.Limit)
+	}
+
+	book, err := service.reader.OrderBook(ctx, market, request.Limit)
+	if err != nil {
+		return DepthResult{}, fmt.Errorf("read order book: %w", err)
+	}
+
+	result := DepthResult{
+		Market:    book.Market,
+		Timestamp: book.Timestamp,
+		Asks:      toDepthLevels(book.Asks),
+		Bids:      toDepthLevels(book.Bids),
+	}
+	if len(result.Asks) > 0 {
+		result.BestAsk = result.Asks[0].Price
+	}
+	if len(result.Bids) > 0 {
+		result.BestBid = result.Bids[0].Price
+	}
+	result.Spread, result.SpreadPercent = spread(result.BestBid, result.BestAsk)
+
+	return result, nil
+}
+
+// Trades returns the latest public trades for one market, newest first.
+func (service *DataService) Trades(ctx context.Context, request TradesRequest) (TradesResult, error) {
+	market := strings.TrimSpace(request.Market)
+	if market == "" {
+		return TradesResult{}, ErrMarketRequired
+	}
+	side := strings.ToLower(strings.TrimSpace(request.Side))
+	if side != "" && side != "buy" && side != "sell" {
+		return TradesResult{}, fmt.Errorf("%w: %q", ErrTradeSideInvalid, request.Side)
+	}
+
+	trades, err := service.reader.RecentTrades(ctx, market, side)
+	if err != nil {
+		return TradesResult{}, fmt.Errorf("read recent trades: %w", err)
+	}
+
+	result := TradesResult{Market: market, Trades: make([]PublicTrade, 0, len(trades))}
+	for _, trade := range trades {
+		result.Trades = append(result.Trades, PublicTrade{
+			TradeID:    trade.TradeID,
+			Side:       trade.Side,
+			Price:      trade.Price,
+			Amount:     trade.Amount,
+			Total:      trade.Total,
+			ExecutedAt: trade.ExecutedAt,
+		})
+	}
+	sort.SliceStable(result.Trades, func(left int, right int) bool {
+		if !result.Trades[left].ExecutedAt.Equal(result.Trades[right].ExecutedAt) {
+			return result.Trades[left].ExecutedAt.After(result.Trades[right].ExecutedAt)
+		}
+
+		return result.Trades[left].TradeID > result.Trades[right].TradeID
+	})
+
+	return result, nil
+}
+
+func toDepthLevels(levels []ports.OrderBookLevel) []DepthLevel {
+	converted := make([]DepthLevel, 0, len(levels))
+	for _, level := range levels {
+		converted = append(converted, DepthLevel{Price: level.Price, Amount: level.Amount})
+	}
+
+	return converted
+}
+
+// spread returns ask-bid and the spread as percent of mid price, rounded to 4 places.
+func spread(bestBid string, bestAsk string) (string, string) {
+	bid, err := decimal.Parse(bestBid)
+	if err != nil {
+		return "", ""
+	}
+	ask, err := decimal.Parse(bestAsk)
+	if err != nil {
+		return "", ""
+	}
+
+	difference := ask.Sub(bid)
+	mid := ask.Add(bid)
+	if mid.Sign() <= 0 {
+		return difference.Normalize().String(), ""
+	}
+
+	percent, err := difference.Mul(decimal.FromInt(200)).Quo(mid, 4)
+	if err != nil {
+		return difference.Normalize().String(), ""
+	}
+
+	return difference.Normalize().String(), percent.Normalize().String()
+}
@@ -0,0 +1,124 @@
+package market
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+)
+
+type fakeMarketDataReader struct {
+	tickers   []ports.Ticker
+	book      ports.OrderBook
+	trades    []ports.PublicTrade
+	lastLimit int
+	lastSide  string
+}
+
+func (reader *fakeMarketDataReader) Tickers(context.Context) ([]ports.Ticker, error) {
+	return reader.tickers, nil
+}
+
+func (reader *fakeMarketDataReader) OrderBook(_ context.Context, _ string, limit int) (ports.OrderBook, error) {
+	reader.lastLimit = limit
+	return reader.book, nil
+}
+
+func (reader *fakeMarketDataReader) RecentTrades(_ context.Context, _ string, side string) ([]ports.PublicTrade, error) {
+	reader.lastSide = side
+	return reader.trades, nil
+}
+
+func TestDataServiceTickerFiltersAndSorts(t *testing.T) {
+	reader := &fakeMarketDataReader{tickers: []ports.Ticker{
+		{Market: "ETH_PERP", LastPrice: "3000"},
+		{Market: "BTC_PERP", LastPrice: "60000"},
+		{Market: "BTC_USDT", LastPrice: "60010"},
+	}}
+	service := NewDataService(reader)
+
+	result, err := service.Ticker(context.Background(), TickerRequest{Markets: []string{"eth_perp", " BTC_PERP "}})
+	if err != nil {
+		t.Fatalf("ticker failed: %v", err)
+	}
+	if len(result.Tickers) != 2 || result.Tickers[0].Market != "BTC_PERP" || result.Tickers[1].Market != "ETH_PERP" {
+		t.Fatalf("unexpected tickers: %+v", result.Tickers)
+	}
+
+	_, err = service.Ticker(context.Background(), TickerRequest{Markets: []string{"DOGE_PERP"}})
+	if !errors.Is(err, ports.ErrMarketNotFound) {
+		t.Fatalf("expected %v, got %v", ports.ErrMarketNotFound, err)
+	}
+}
+
+func TestDataServiceDepthComputesSpread(t *testing.T) {
+	reader := &fakeMarketDataReader{book: ports.OrderBook{
+		Market: "BTC_PERP",
+		Asks:   []ports.OrderBookLevel{{Price: "60010", Amount: "1.2"}, {Price: "60020", Amount: "3"}},
+		Bids:   []ports.OrderBookLevel{{Price: "59990", Amount: "0.5"}},
+	}}
+	service := NewDataService(reader)
+
+	result, err := service.Depth(context.Background(), DepthRequest{Market: "BTC_PERP", Limit: 5})
+	if err != nil {
+		t.Fatalf("depth failed: %v", err)
+	}
+	if reader.lastLimit != 5 {
+		t.Fatalf("expected limit pass-through, got %d", reader.lastLimit)
+	}
+	if result.BestBid != "59990" || result.BestAsk != "60010" || result.Spread != "20" || result.SpreadPercent != "0.0333" {
+		t.Fatalf("unexpected top of book: %+v", result)
+	}
+}
+
+func TestDataServiceDepthEmptySideHasNoSpread(t *testing.T) {
+	service := NewDataService(&fakeMarketDataReader{book: ports.OrderBook{
+		Market: "BTC_PERP",
+		Asks:   []ports.OrderBookLevel{{Price: "60010", Amount: "1"}},
+	}})
+
+	result, err := service.Depth(context.Background(), DepthRequest{Market: "BTC_PERP"})
+	if err != nil {
+		t.Fatalf("depth failed: %v", err)
+	}
+	if result.Spread != "" || result.BestBid != "" || result.BestAsk != "60010" {
+		t.Fatalf("unexpected one-sided book: %+v", result)
+	}
+}
+
+func TestDataServiceValidatesRequests(t *testing.T) {
+	service := NewDataService(&fakeMarketDataReader{})
+
+	if _, err := service.Depth(context.Background(), DepthRequest{Market: "BTC_PERP", Limit: 101}); !errors.Is(err, ErrDepthLimitInvalid) {
+		t.Fatalf("expected %v, got %v", ErrDepthLimitInvalid, err)
+	}
+	if _, err := service.Depth(context.Background(), DepthRequest{}); !errors.Is(err, ErrMarketRequired) {
+		t.Fatalf("expected %v, got %v", ErrMarketRequired, err)
+	}
+	if _, err := service.Trades(context.Background(), TradesRequest{Market: "BTC_PERP", Side: "long"}); !errors.Is(err, ErrTradeSideInvalid) {
+		t.Fatalf("expected %v, got %v", ErrTradeSideInvalid, err)
+	}
+}
+
+func TestDataServiceTradesNewestFirst(t *testing.T) {
+	base := time.Date(2026, 3, 6, 10, 0, 0, 0, time.UTC)
+	reader := &fakeMarketDataReader{trades: []ports.PublicTrade{
+		{TradeID: 1, ExecutedAt: base},
+		{TradeID: 3, ExecutedAt: base.Add(time.Second)},
+		{TradeID: 2, ExecutedAt: base.Add(time.Second)},
+	}}
+	service := NewDataService(reader)
+
+	result, err := service.Trades(context.Background(), TradesRequest{Market: "BTC_PERP", Side: "SELL"})
+	if err != nil {
+		t.Fatalf("trades failed: %v", err)
+	}
+	if reader.lastSide != "sell" {
+		t.Fatalf("expected normalized side filter, got %q", reader.lastSide)
+	}
+	if result.Trades[0].TradeID != 3 || result.Trades[1].TradeID != 2 || result.Trades[2].TradeID != 1 {
+		t.Fatalf("unexpected order: %+v", result.Trades)
+	}
+}
@@ -0,0 +1,262 @@
+package market
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+)
+
+const (
+	// MaxKlinesPerPage is the largest number of candles requested from the exchange at once.
+	MaxKlinesPerPage = 1440
+	// DefaultKlinePageDelay is the pause between kline page requests that keeps backfills under public rate limits.
+	DefaultKlinePageDelay = 250 * time.Millisecond
+)
+
+var (
+	// ErrKlineIntervalInvalid indicates an interval without a fixed candle length.
+	ErrKlineIntervalInvalid = errors.New("kline interval must be one of: 1m, 3m, 5m, 15m, 30m, 1h, 2h, 4h, 6h, 8h, 12h, 1d, 1w")
+	// ErrKlineWindowInvalid indicates a missing window start or a start not before the end.
+	ErrKlineWindowInvalid = errors.New("kline window needs a start before its end")
+)
+
+var klineIntervals = map[string]time.Duration{
+	"1m":  time.Minute,
+	"3m":  3 * time.Minute,
+	"5m":  5 * time.Minute,
+	"15m": 15 * time.Minute,
+	"30m": 30 * time.Minute,
+	"1h":  time.Hour,
+	"2h":  2 * time.Hour,
+	"4h":  4 * time.Hour,
+	"6h":  6 * time.Hour,
+	"8h":  8 * time.Hour,
+	"12h": 12 * time.Hour,
+	"1d":  24 * time.Hour,
+	"1w":  7 * 24 * time.Hour,
+}
+
+// KlinesRequest is input for kline export use-case.
+// Since is inclusive and Until exclusive on candle open time; zero Until means now.
+type KlinesRequest struct {
+	Market   string
+	Interval string
+	Since    time.Time
+	Until    time.Time
+}
+
+// Kline is normalized OHLCV candle.
+type Kline struct {
+	OpenTime    time.Time `json:"open_time"`
+	Open        string    `json:"open"`
+	High        string    `json:"high"`
+	Low         string    `json:"low"`
+	Close       string    `json:"close"`
+	Volume      string    `json:"volume"`
+	QuoteVolume string    `json:"quote_volume"`
+}
+
+// KlinesResult is normalized output for kline export use-case, ascending by open time.
+// Fetched counts candles downloaded by this call, Cached those served from local cache.
+type KlinesResult struct {
+	Market   string    `json:"market"`
+	Interval string    `json:"interval"`
+	Since    time.Time `json:"since"`
+	Until    time.Time `json:"until"`
+	Fetched  int       `json:"fetched"`
+	Cached   int       `json:"cached"`
+	Klines   []Kline   `json:"klines"`
+}
+
+// KlineService serves closed candles from local cache and backfills missing history from the exchange.
+type KlineService struct {
+	reader    ports.KlineReader
+	cache     ports.KlineCache
+	clock     ports.Clock
+	pageDelay time.Duration
+	wait      func(ctx context.Context, delay time.Duration) error
+}
+
+// NewKlineService constructs KlineService.
+func NewKlineService(reader ports.KlineReader, cache ports.KlineCache, clock ports.Clock) *KlineService {
+	return &KlineService{
+		reader:    reader,
+		cache:     cache,
+		clock:     clock,
+		pageDelay: DefaultKlinePageDelay,
+		wait:      waitContext,
+	}
+}
+
+// Klines returns closed candles of the window. Pages are fetched backward from the window end,
+// skipping pages already complete in cache, until the window start or the first page with no history.
+// Only closed candles are cached; the forming candle is never returned.
+func (service *KlineService) Klines(ctx context.Context, request KlinesRequest) (KlinesResult, error) {
+	market := strings.ToUpper(strings.TrimSpace(request.Market))
+	if market == "" {
+		return KlinesResult{}, ErrMarketRequired
+	}
+	interval := strings.TrimSpace(request.Interval)
+	step, ok := klineIntervals[interval]
+	if !ok {
+		return KlinesResult{}, fmt.Errorf("%w: got %q", ErrKlineIntervalInvalid, request.Interval)
+	}
+
+	now := service.clock.Now().UTC()
+	until := request.Until.UTC()
+	if request.Until.IsZero() || until.After(now) {
+		until = now
+	}
+	if request.Since.IsZero() || !request.Since.Before(until) {
+		return KlinesResult{}, ErrKlineWindowInvalid
+	}
+
+	first := alignCeil(request.Since.UTC(), step)
+	// last closed candle opening before until
+	last := alignFloor(until.Add(-time.Nanosecond), step)
+	if closedBefore := alignFloor(now, step).Add(-step); last.After(closedBefore) {
+		last = closedBefore
+	}
+
+	result := KlinesResult{Market: market, Interval: interval, Since: request.Since.UTC(), Until: until, Klines: []Kline{}}
+	if last.Before(first) {
+		return result, nil
+	}
+
+	cached, err := service.cache.LoadKlines(ctx, market, interval, first, last)
+	if err != nil {
+		return KlinesResult{}, fmt.Errorf("load kline cache: %w", err)
+	}
+	byOpenTime := make(map[int64]ports.Kline, len(cached))
+	for _, kline := range cached {
+		byOpenTime[kline.OpenTime.Unix()] = kline
+	}
+	result.Cached = len(byOpenTime)
+
+	requested := false
+	for pageEnd := last; !pageEnd.Before(first); {
+		pageStart := pageEnd.Add(-time.Duration(MaxKlinesPerPage-1) * step)
+		if pageStart.Before(first) {
+			pageStart = first
+		}
+
+		if !pageComplete(byOpenTime, pageStart, pageEnd, step) {
+			if requested {
+				if err := service.wait(ctx, service.pageDelay); err != nil {
+					return KlinesResult{}, err
+				}
+			}
+			requested = true
+
+			fetched, err := service.reader.Klines(ctx, market, interval, pageStart, pageEnd, MaxKlinesPerPage)
+			if err != nil {
+				return KlinesResult{}, fmt.Errorf("fetch klines: %w", err)
+			}
+
+			fresh := make([]ports.Kline, 0, len(fetched))
+			for _, kline := range fetched {
+				openTime := kline.OpenTime.UTC()
+				if openTime.Before(pageStart) || openTime.After(pageEnd) {
+					continue
+				}
+				if _, exists := byOpenTime[openTime.Unix()]; exists {
+					continue
+				}
+				kline.OpenTime = openTime
+				byOpenTime[openTime.Unix()] = kline
+				fresh = append(fresh, kline)
+			}
+			if err := service.cache.AppendKlines(ctx, market, interval, fresh); err != nil {
+				return KlinesResult{}, fmt.Errorf("append kline cache: %w", err)
+			}
+			result.Fetched += len(fresh)
+
+			if len(fetched) == 0 {
+				// nothing traded before this page: history starts later than the window
+				break
+			}
+		}
+
+		pageEnd = pageStart.Add(-step)
+	}
+
+	for _, kline := range byOpenTime {
+		result.Klines = append(result.Klines, Kline{
+			OpenTime:    kline.OpenTime,
+			Open:        kline.Open,
+			High:        kline.High,
+			Low:         kline.Low,
+			Close:       kline.Close,
+			Volume:      kline.Volume,
+			QuoteVolume: kline.QuoteVolume,
+		})
+	}
+	sort.Slice(result.Klines, func(left int, right int) bool {
+		return result.Klines[left].OpenTime.Before(result.Klines[right].OpenTime)
+	})
+
+	return result, nil
+}
+
+// pageComplete reports whether every candle of [start, end] is cached.
+func pageComplete(byOpenTime map[int64]ports.Kline, start time.Time, end time.Time, step time.Duration) bool {
+	for openTime := start; !openTime.After(end); openTime = openTime.Add(step) {
+		if _, ok := byOpenTime[openTime.Unix()]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// weekStartOffset moves the weekly grid from the unix epoch, a Thursday, to Monday 00:00 UTC,
+// when exchange weekly candles open.
+const weekStartOffset = 4 * 24 * time.Hour
+
+// alignFloor rounds down to the interval grid of exchange candles: anchored at the unix epoch,
+// except weekly candles, which open on Mondays.
+func alignFloor(value time.Time, step time.Duration) time.Time {
+	var offset int64
+	if step == klineIntervals["1w"] {
+		offset = int64(weekStartOffset / time.Second)
+	}
+
+	seconds := int64(step / time.Second)
+	unix := value.Unix() - offset
+	aligned := unix - unix%seconds
+	if unix < 0 && unix%seconds != 0 {
+		aligned -= seconds
+	}
+
+	return time.Unix(aligned+offset, 0).UTC()
+}
+
+func alignCeil(value time.Time, step time.Duration) time.Time {
+	floor := alignFloor(value, step)
+	if floor.Before(value) {
+		return floor.Add(step)
+	}
+
+	return floor
+}
+
+func waitContext(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,196 @@
+package market
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+)
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (clock fixedClock) Now() time.Time {
+	return clock.now
+}
+
+// fakeKlineReader serves one candle per interval from historyStart on, on the grid anchored at historyStart.
+type fakeKlineReader struct {
+	historyStart time.Time
+	step         time.Duration
+	pages        [][2]time.Time
+}
+
+func (reader *fakeKlineReader) Klines(
+	_ context.Context,
+	_ string,
+	_ string,
+	start time.Time,
+	end time.Time,
+	limit int,
+) ([]ports.Kline, error) {
+	reader.pages = append(reader.pages, [2]time.Time{start, end})
+
+	openTime := start
+	if offset := start.Sub(reader.historyStart) % reader.step; offset > 0 {
+		openTime = openTime.Add(reader.step - offset)
+	} else if offset < 0 {
+		openTime = openTime.Add(-offset)
+	}
+
+	klines := []ports.Kline{}
+	for ; !openTime.After(end) && len(klines) < limit; openTime = openTime.Add(reader.step) {
+		if openTime.Before(reader.historyStart) {
+			continue
+		}
+		klines = append(klines, ports.Kline{OpenTime: openTime, Close: strconv.FormatInt(openTime.Unix(), 10)})
+	}
+
+	return klines, nil
+}
+
+type memoryKlineCache struct {
+	klines  []ports.Kline
+	appends int
+}
+
+func (cache *memoryKlineCache) LoadKlines(_ context.Context, _ string, _ string, start time.Time, end time.Time) ([]ports.Kline, error) {
+	loaded := []ports.Kline{}
+	for _, kline := range cache.klines {
+		if !kline.OpenTime.Before(start) && !kline.OpenTime.After(end) {
+			loaded = append(loaded, kline)
+		}
+	}
+
+	return loaded, nil
+}
+
+func (cache *memoryKlineCache) AppendKlines(_ context.Context, _ string, _ string, klines []ports.Kline) error {
+	if len(klines) > 0 {
+		cache.appends++
+	}
+	cache.klines = append(cache.klines, klines...)
+
+	return nil
+}
+
+func TestKlineServicePagesBackwardAndStopsAtHistoryStart(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 7, 0, 0, time.UTC)
+	reader := &fakeKlineReader{historyStart: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), step: 15 * time.Minute}
+	cache := &memoryKlineCache{}
+	service := NewKlineService(reader, cache, fixedClock{now: now})
+	service.pageDelay = 0
+
+	result, err := service.Klines(context.Background(), KlinesRequest{
+		Market:   "btc_perp",
+		Interval: "15m",
+		Since:    time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("klines failed: %v", err)
+	}
+
+	// ten full days plus 00:00..11:45 on the last day; the 12:00 candle is still forming
+	expected := 10*96 + 48
+	if len(result.Klines) != expected || result.Fetched != expected {
+		t.Fatalf("expected %d candles, got %d (fetched %d)", expected, len(result.Klines), result.Fetched)
+	}
+	if !result.Klines[0].OpenTime.Equal(reader.historyStart) || !result.Klines[expected-1].OpenTime.Equal(time.Date(2026, 3, 20, 11, 45, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected range %s..%s", result.Klines[0].OpenTime, result.Klines[expected-1].OpenTime)
+	}
+	if !reader.pages[0][1].After(reader.pages[1][1]) {
+		t.Fatalf("expected backward paging, got %v", reader.pages)
+	}
+	// one page covering history start plus one empty page before it
+	if len(reader.pages) != 2 {
+		t.Fatalf("expected 2 page requests, got %d", len(reader.pages))
+	}
+}
+
+func TestKlineServiceServesCompletePagesFromCache(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	reader := &fakeKlineReader{historyStart: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), step: time.Hour}
+	cache := &memoryKlineCache{}
+	service := NewKlineService(reader, cache, fixedClock{now: now})
+	service.pageDelay = 0
+	request := KlinesRequest{Market: "BTC_PERP", Interval: "1h", Since: time.Date(2026, 3, 19, 0, 0, 0, 0, time.UTC)}
+
+	first, err := service.Klines(context.Background(), request)
+	if err != nil {
+		t.Fatalf("first export failed: %v", err)
+	}
+	second, err := service.Klines(context.Background(), request)
+	if err != nil {
+		t.Fatalf("second export failed: %v", err)
+	}
+
+	if first.Fetched != 36 || second.Fetched != 0 || second.Cached != 36 || len(second.Klines) != 36 {
+		t.Fatalf("unexpected cache use: first %d second fetched=%d cached=%d", first.Fetched, second.Fetched, second.Cached)
+	}
+	if len(reader.pages) != 1 || cache.appends != 1 {
+		t.Fatalf("expected one fetch and one append, got %d and %d", len(reader.pages), cache.appends)
+	}
+}
+
+func TestKlineServiceAlignsWeeklyCandlesToMonday(t *testing.T) {
+	// WhiteBIT weekly candles open on Mondays 00:00 UTC, e.g. 2026-02-02
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	reader := &fakeKlineReader{historyStart: time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC), step: 7 * 24 * time.Hour}
+	cache := &memoryKlineCache{}
+	service := NewKlineService(reader, cache, fixedClock{now: now})
+	service.pageDelay = 0
+	request := KlinesRequest{Market: "BTC_PERP", Interval: "1w", Since: time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)}
+
+	first, err := service.Klines(context.Background(), request)
+	if err != nil {
+		t.Fatalf("first export failed: %v", err)
+	}
+	second, err := service.Klines(context.Background(), request)
+	if err != nil {
+		t.Fatalf("second export failed: %v", err)
+	}
+
+	// 2026-02-02 through 2026-03-09; the week opened 2026-03-16 is still forming
+	if len(first.Klines) != 6 || first.Fetched != 6 {
+		t.Fatalf("expected 6 weekly candles, got %d (fetched %d)", len(first.Klines), first.Fetched)
+	}
+	for _, kline := range first.Klines {
+		if kline.OpenTime.Weekday() != time.Monday {
+			t.Fatalf("expected Monday open time, got %s", kline.OpenTime)
+		}
+	}
+	if second.Fetched != 0 || second.Cached != 6 || len(reader.pages) != 1 {
+		t.Fatalf("expected second export from cache, got fetched=%d cached=%d pages=%d", second.Fetched, second.Cached, len(reader.pages))
+	}
+}
+
+func TestKlineServiceRejectsInvalidRequests(t *testing.T) {
+	service := NewKlineService(&fakeKlineReader{}, &memoryKlineCache{}, fixedClock{now: time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)})
+	service.pageDelay = 0
+	since := time.Date(2026, 3, 19, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		request KlinesRequest
+		wantErr error
+	}{
+		{name: "missing market", request: KlinesRequest{Interval: "15m", Since: since}, wantErr: ErrMarketRequired},
+		{name: "monthly interval", request: KlinesRequest{Market: "BTC_PERP", Interval: "1M", Since: since}, wantErr: ErrKlineIntervalInvalid},
+		{name: "three day interval", request: KlinesRequest{Market: "BTC_PERP", Interval: "3d", Since: since}, wantErr: ErrKlineIntervalInvalid},
+		{name: "missing since", request: KlinesRequest{Market: "BTC_PERP", Interval: "15m"}, wantErr: ErrKlineWindowInvalid},
+		{name: "inverted window", request: KlinesRequest{Market: "BTC_PERP", Interval: "15m", Since: since, Until: since.Add(-time.Hour)}, wantErr: ErrKlineWindowInvalid},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := service.Klines(context.Background(), testCase.request)
+			if !errors.Is(err, testCase.wantErr) {
+				t.Fatalf("expected %v, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
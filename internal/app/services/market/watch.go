@@ -0,0 +1,288 @@
+package market
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
+)
+
+// watchDepthLimit is the book depth kept per side; enough to find the next best level after a fill.
+const watchDepthLimit = 10
+
+// fillPriceScale bounds average fill price digits when the order price carries no scale.
+const fillPriceScale = 8
+
+// Watch event types.
+const (
+	WatchEventStatus = "status"
+	WatchEventQuote  = "quote"
+	WatchEventFill   = "fill"
+	WatchEventError  = "error"
+)
+
+// WatchRequest is input for live market watch use-case.
+// Fills streams own order fills of Market and requires a stored credential.
+type WatchRequest struct {
+	Market string
+	Fills  bool
+}
+
+// WatchStatus reports stream connection changes. RetryIn is set after a disconnect.
+type WatchStatus struct {
+	Connected bool   `json:"connected"`
+	RetryIn   string `json:"retry_in,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+// WatchQuote is the latest last price and top of book. Empty fields are not known yet.
+type WatchQuote struct {
+	LastPrice string `json:"last_price,omitempty"`
+	BestBid   string `json:"best_bid,omitempty"`
+	BestAsk   string `json:"best_ask,omitempty"`
+	Spread    string `json:"spread,omitempty"`
+}
+
+// WatchFill is one own fill: Amount and Price cover this execution only, Filled and Fee are cumulative.
+type WatchFill struct {
+	OrderID       int64  `json:"order_id"`
+	ClientOrderID string `json:"client_order_id,omitempty"`
+	Side          string `json:"side"`
+	Price         string `json:"price"`
+	Amount        string `json:"amount"`
+	Filled        string `json:"filled"`
+	Left          string `json:"left"`
+	Fee           string `json:"fee,omitempty"`
+	Finished      bool   `json:"finished"`
+}
+
+// WatchEvent is one normalized live update; Type selects the populated payload.
+type WatchEvent struct {
+	Type    string       `json:"type"`
+	Time    time.Time    `json:"time"`
+	Market  string       `json:"market"`
+	Status  *WatchStatus `json:"status,omitempty"`
+	Quote   *WatchQuote  `json:"quote,omitempty"`
+	Fill    *WatchFill   `json:"fill,omitempty"`
+	Message string       `json:"message,omitempty"`
+}
+
+// WatchService streams last price, top of book and own fills of one market.
+type WatchService struct {
+	credentialStore ports.CredentialStore
+	streamer        ports.MarketStreamer
+	clock           ports.Clock
+}
+
+// NewWatchService constructs WatchService.
+func NewWatchService(credentialStore ports.CredentialStore, streamer ports.MarketStreamer, clock ports.Clock) *WatchService {
+	return &WatchService{
+		credentialStore: credentialStore,
+		streamer:        streamer,
+		clock:           clock,
+	}
+}
+
+// Watch calls emit for every status change, quote change, own fill and stream error until ctx is cancelled.
+// Cancellation is a clean stop and returns nil; an emit error stops the stream and is returned.
+func (service *WatchService) Watch(ctx context.Context, request WatchRequest, emit func(WatchEvent) error) error {
+	market := strings.ToUpper(strings.TrimSpace(request.Market))
+	if market == "" {
+		return ErrMarketRequired
+	}
+
+	streamRequest := ports.MarketStreamRequest{Market: market, DepthLimit: watchDepthLimit}
+	if request.Fills {
+		credential, err := service.credentialStore.Load(ctx)
+		if err != nil {
+			return fmt.Errorf("load credential: %w", err)
+		}
+		defer domainauth.WipeBytes(credential.APISecret)
+		streamRequest.Credential = &credential
+	}
+
+	state := newWatchState(market)
+	err := service.streamer.StreamMarket(ctx, streamRequest, func(event ports.MarketStreamEvent) error {
+		for _, watchEvent := range state.apply(event) {
+			watchEvent.Time = service.clock.Now().UTC()
+			if err := emit(watchEvent); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		if ctx.Err() != nil && errors.Is(err, ctx.Err()) {
+			return nil
+		}
+
+		return fmt.Errorf("stream market: %w", err)
+	}
+
+	return nil
+}
+
+// fillProgress is the cumulative execution of one order seen so far.
+type fillProgress struct {
+	stock decimal.Decimal
+	money decimal.Decimal
+}
+
+// watchState folds stream deltas into the current book, quote and per-order fill progress.
+type watchState struct {
+	market string
+	asks   map[string]decimal.Decimal
+	bids   map[string]decimal.Decimal
+	quote  WatchQuote
+	orders map[int64]fillProgress
+}
+
+func newWatchState(market string) *watchState {
+	return &watchState{
+		market: market,
+		asks:   map[string]decimal.Decimal{},
+		bids:   map[string]decimal.Decimal{},
+		orders: map[int64]fillProgress{},
+	}
+}
+
+func (state *watchState) apply(event ports.MarketStreamEvent) []WatchEvent {
+	switch event.Kind {
+	case ports.MarketStreamState:
+		status := &WatchStatus{Connected: event.Connected, Reason: event.Message}
+		if !event.Connected && event.RetryIn > 0 {
+			status.RetryIn = event.RetryIn.Round(time.Millisecond).String()
+		}
+
+		return []WatchEvent{{Type: WatchEventStatus, Market: state.market, Status: status}}
+	case ports.MarketStreamError:
+		return []WatchEvent{{Type: WatchEventError, Market: state.market, Message: event.Message}}
+	case ports.MarketStreamLastPrice:
+		if !strings.EqualFold(event.Market, state.market) {
+			return nil
+		}
+		quote := state.quote
+		quote.LastPrice = event.Price
+
+		return state.updateQuote(quote)
+	case ports.MarketStreamDepth:
+		if event.Market != "" && !strings.EqualFold(event.Market, state.market) {
+			return nil
+		}
+		if event.FullReload {
+			clear(state.asks)
+			clear(state.bids)
+		}
+		applyLevels(state.asks, event.Asks)
+		applyLevels(state.bids, event.Bids)
+
+		quote := state.quote
+		quote.BestAsk = bestPrice(state.asks, -1)
+		quote.BestBid = bestPrice(state.bids, 1)
+		quote.Spread, _ = spread(quote.BestBid, quote.BestAsk)
+
+		return state.updateQuote(quote)
+	case ports.MarketStreamOrder:
+		if !strings.EqualFold(event.Order.Market, state.market) {
+			return nil
+		}
+		if fill, ok := state.fill(event.Order); ok {
+			return []WatchEvent{{Type: WatchEventFill, Market: state.market, Fill: &fill}}
+		}
+	}
+
+	return nil
+}
+
+func (state *watchState) updateQuote(quote WatchQuote) []WatchEvent {
+	if quote == state.quote {
+		return nil
+	}
+	state.quote = quote
+	emitted := quote
+
+	return []WatchEvent{{Type: WatchEventQuote, Market: state.market, Quote: &emitted}}
+}
+
+// fill returns the execution since the previous update of the same order, if any.
+// An order first seen mid-life reports its earlier executions with the first fill.
+func (state *watchState) fill(order ports.OwnOrderUpdate) (WatchFill, bool) {
+	stock, err := decimal.Parse(order.DealStock)
+	if err != nil {
+		stock = decimal.Decimal{}
+	}
+	money, err := decimal.Parse(order.DealMoney)
+	if err != nil {
+		money = decimal.Decimal{}
+	}
+
+	previous := state.orders[order.OrderID]
+	if order.Finished {
+		delete(state.orders, order.OrderID)
+	} else {
+		state.orders[order.OrderID] = fillProgress{stock: stock, money: money}
+	}
+
+	amount := stock.Sub(previous.stock)
+	if amount.Sign() <= 0 {
+		return WatchFill{}, false
+	}
+
+	price := order.Price
+	scale := fillPriceScale
+	if limitPrice, err := decimal.Parse(order.Price); err == nil && limitPrice.Scale() > 0 {
+		scale = limitPrice.Scale()
+	}
+	if money.Sub(previous.money).Sign() > 0 {
+		if average, err := money.Sub(previous.money).Quo(amount, scale); err == nil {
+			price = average.Normalize().String()
+		}
+	}
+
+	return WatchFill{
+		OrderID:       order.OrderID,
+		ClientOrderID: order.ClientOrderID,
+		Side:          order.Side,
+		Price:         price,
+		Amount:        amount.Normalize().String(),
+		Filled:        order.DealStock,
+		Left:          order.Left,
+		Fee:           order.DealFee,
+		Finished:      order.Finished,
+	}, true
+}
+
+// applyLevels sets level amounts by price; zero or unparsable amounts remove the level.
+func applyLevels(book map[string]decimal.Decimal, levels []ports.OrderBookLevel) {
+	for _, level := range levels {
+		amount, err := decimal.Parse(level.Amount)
+		if err != nil || amount.Sign() <= 0 {
+			delete(book, level.Price)
+			continue
+		}
+		book[level.Price] = amount
+	}
+}
+
+// bestPrice returns the lowest price for direction -1 and the highest for direction 1.
+func bestPrice(book map[string]decimal.Decimal, direction int) string {
+	best := ""
+	var bestValue decimal.Decimal
+	for price := range book {
+		value, err := decimal.Parse(price)
+		if err != nil {
+			continue
+		}
+		if best == "" || value.Cmp(bestValue) == direction {
+			best, bestValue = price, value
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,167 @@
+package market
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+)
+
+type fakeMarketStreamer struct {
+	events      []ports.MarketStreamEvent
+	err         error
+	lastRequest ports.MarketStreamRequest
+}
+
+func (streamer *fakeMarketStreamer) StreamMarket(
+	_ context.Context,
+	request ports.MarketStreamRequest,
+	handle func(ports.MarketStreamEvent) error,
+) error {
+	streamer.lastRequest = request
+	for _, event := range streamer.events {
+		if err := handle(event); err != nil {
+			return err
+		}
+	}
+
+	return streamer.err
+}
+
+type fakeWatchCredentialStore struct {
+	credential domainauth.Credential
+	err        error
+}
+
+func (store *fakeWatchCredentialStore) BackendName() string {
+	return "test"
+}
+
+func (store *fakeWatchCredentialStore) Save(context.Context, domainauth.Credential) error {
+	return nil
+}
+
+func (store *fakeWatchCredentialStore) Load(context.Context) (domainauth.Credential, error) {
+	return store.credential, store.err
+}
+
+func (store *fakeWatchCredentialStore) Exists(context.Context) (bool, error) {
+	return store.err == nil, nil
+}
+
+func (store *fakeWatchCredentialStore) Delete(context.Context) error {
+	return nil
+}
+
+func collectWatch(t *testing.T, service *WatchService, request WatchRequest) []WatchEvent {
+	t.Helper()
+
+	events := []WatchEvent{}
+	err := service.Watch(context.Background(), request, func(event WatchEvent) error {
+		events = append(events, event)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("watch failed: %v", err)
+	}
+
+	return events
+}
+
+func TestWatchServiceFoldsDepthDeltasIntoQuotes(t *testing.T) {
+	streamer := &fakeMarketStreamer{events: []ports.MarketStreamEvent{
+		{Kind: ports.MarketStreamState, Connected: true},
+		{Kind: ports.MarketStreamDepth, Market: "BTC_PERP", FullReload: true,
+			Asks: []ports.OrderBookLevel{{Price: "60020", Amount: "1"}, {Price: "60010", Amount: "2"}},
+			Bids: []ports.OrderBookLevel{{Price: "59980", Amount: "1"}, {Price: "59990", Amount: "3"}},
+		},
+		{Kind: ports.MarketStreamLastPrice, Market: "BTC_PERP", Price: "60000"},
+		{Kind: ports.MarketStreamLastPrice, Market: "BTC_PERP", Price: "60000"},
+		{Kind: ports.MarketStreamDepth, Market: "BTC_PERP", Asks: []ports.OrderBookLevel{{Price: "60010", Amount: "0"}}},
+		{Kind: ports.MarketStreamLastPrice, Market: "ETH_PERP", Price: "3000"},
+	}}
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	service := NewWatchService(&fakeWatchCredentialStore{}, streamer, fixedClock{now: now})
+
+	events := collectWatch(t, service, WatchRequest{Market: " btc_perp "})
+	if streamer.lastRequest.Market != "BTC_PERP" || streamer.lastRequest.Credential != nil || streamer.lastRequest.DepthLimit != watchDepthLimit {
+		t.Fatalf("unexpected stream request: %+v", streamer.lastRequest)
+	}
+	if len(events) != 4 {
+		t.Fatalf("expected status and three quote changes, got %+v", events)
+	}
+	if events[0].Status == nil || !events[0].Status.Connected || !events[0].Time.Equal(now) {
+		t.Fatalf("unexpected status event: %+v", events[0])
+	}
+	if quote := events[1].Quote; quote == nil || quote.BestBid != "59990" || quote.BestAsk != "60010" || quote.Spread != "20" {
+		t.Fatalf("unexpected first quote: %+v", events[1].Quote)
+	}
+	if quote := events[2].Quote; quote == nil || quote.LastPrice != "60000" {
+		t.Fatalf("unexpected last price quote: %+v", events[2].Quote)
+	}
+	if quote := events[3].Quote; quote == nil || quote.BestAsk != "60020" || quote.Spread != "30" {
+		t.Fatalf("expected removed best ask to expose next level, got %+v", events[3].Quote)
+	}
+}
+
+func TestWatchServiceReportsIncrementalOwnFills(t *testing.T) {
+	order := ports.OwnOrderUpdate{OrderID: 7, ClientOrderID: "grid-1", Market: "BTC_PERP", Side: "buy", Price: "100.5", Amount: "3"}
+	partial := order
+	partial.DealStock, partial.DealMoney, partial.Left = "1", "100.5", "2"
+	finished := order
+	finished.DealStock, finished.DealMoney, finished.Left, finished.Finished = "3", "301.3", "0", true
+
+	created := order
+	created.DealStock, created.DealMoney, created.Left = "0", "0", "3"
+	streamer := &fakeMarketStreamer{events: []ports.MarketStreamEvent{
+		{Kind: ports.MarketStreamOrder, Order: created},
+		{Kind: ports.MarketStreamOrder, Order: partial},
+		{Kind: ports.MarketStreamOrder, Order: finished},
+	}}
+	service := NewWatchService(&fakeWatchCredentialStore{
+		credential: domainauth.Credential{APIKey: "key", APISecret: []byte("secret")},
+	}, streamer, fixedClock{})
+
+	events := collectWatch(t, service, WatchRequest{Market: "BTC_PERP", Fills: true})
+	if streamer.lastRequest.Credential == nil || streamer.lastRequest.Credential.APIKey != "key" {
+		t.Fatalf("expected credential in stream request, got %+v", streamer.lastRequest)
+	}
+	if len(events) != 2 {
+		t.Fatalf("expected two fills, got %+v", events)
+	}
+	first, second := events[0].Fill, events[1].Fill
+	if first == nil || first.Amount != "1" || first.Price != "100.5" || first.Filled != "1" || first.Finished {
+		t.Fatalf("unexpected first fill: %+v", first)
+	}
+	if second == nil || second.Amount != "2" || second.Price != "100.4" || second.Left != "0" || !second.Finished {
+		t.Fatalf("unexpected second fill: %+v", second)
+	}
+}
+
+func TestWatchServiceFillsRequireCredential(t *testing.T) {
+	service := NewWatchService(&fakeWatchCredentialStore{err: ports.ErrCredentialNotFound}, &fakeMarketStreamer{}, fixedClock{})
+
+	err := service.Watch(context.Background(), WatchRequest{Market: "BTC_PERP", Fills: true}, func(WatchEvent) error { return nil })
+	if !errors.Is(err, ports.ErrCredentialNotFound) {
+		t.Fatalf("expected %v, got %v", ports.ErrCredentialNotFound, err)
+	}
+}
+
+func TestWatchServiceCancellationIsCleanStop(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	service := NewWatchService(&fakeWatchCredentialStore{}, &fakeMarketStreamer{err: context.Canceled}, fixedClock{})
+
+	if err := service.Watch(ctx, WatchRequest{Market: "BTC_PERP"}, func(WatchEvent) error { return nil }); err != nil {
+		t.Fatalf("expected nil on cancellation, got %v", err)
+	}
+
+	streamErr := errors.New("boom")
+	service = NewWatchService(&fakeWatchCredentialStore{}, &fakeMarketStreamer{err: streamErr}, fixedClock{})
+	if err := service.Watch(context.Background(), WatchRequest{Market: "BTC_PERP"}, func(WatchEvent) error { return nil }); !errors.Is(err, streamErr) {
+		t.Fatalf("expected stream error, got %v", err)
+	}
+}
@@ -18,14 +18,41 @@ var (
 	ErrCredentialInputTooLarge = errors.New("credential stdin payload is too large")
 )
 
-// StdinCredentialInput holds parsed key/secret values.
+// StdinCredentialInput holds parsed key/secret values and the optional passphrase line.
 type StdinCredentialInput struct {
-	APIKey    string
-	APISecret []byte
+	APIKey     string
+	APISecret  []byte
+	Passphrase []byte
 }
 
 // ReadCredentialPairFromReader parses stdin payload as exactly two lines: key, secret.
 func ReadCredentialPairFromReader(reader io.Reader, maxBytes int64) (StdinCredentialInput, error) {
+	lines, err := readCredentialLines(reader, maxBytes, 2)
+	if err != nil {
+		return StdinCredentialInput{}, err
+	}
+
+	return StdinCredentialInput{
+		APIKey:    lines[0],
+		APISecret: []byte(lines[1]),
+	}, nil
+}
+
+// ReadCredentialsWithPassphraseFromReader parses stdin payload as exactly three lines: key, secret, passphrase.
+func ReadCredentialsWithPassphraseFromReader(reader io.Reader, maxBytes int64) (StdinCredentialInput, error) {
+	lines, err := readCredentialLines(reader, maxBytes, 3)
+	if err != nil {
+		return StdinCredentialInput{}, err
+	}
+
+	return StdinCredentialInput{
+		APIKey:     lines[0],
+		APISecret:  []byte(lines[1]),
+		Passphrase: []byte(lines[2]),
+	}, nil
+}
+
+func readCredentialLines(reader io.Reader, maxBytes int64, count int) ([]string, error) {
 	if maxBytes <= 0 {
 		maxBytes = defaultMaxCredentialPayloadBytes
 	}
@@ -33,34 +60,32 @@ func ReadCredentialPairFromReader(reader io.Reader, maxBytes int64) (StdinCreden
 	limitedReader := io.LimitReader(reader, maxBytes+1)
 	payload, err := io.ReadAll(limitedReader)
 	if err != nil {
-		return StdinCredentialInput{}, fmt.Errorf("read credential stdin payload: %w", err)
+		return nil, fmt.Errorf("read credential stdin payload: %w", err)
 	}
 	if int64(len(payload)) > maxBytes {
-		return StdinCredentialInput{}, ErrCredentialInputTooLarge
+		return nil, ErrCredentialInputTooLarge
 	}
 	if len(payload) == 0 {
-		return StdinCredentialInput{}, ErrCredentialInputMissing
+		return nil, ErrCredentialInputMissing
 	}
 
 	normalizedPayload := normalizePayload(payload)
 	if normalizedPayload == "" {
-		return StdinCredentialInput{}, ErrCredentialInputMissing
+		return nil, ErrCredentialInputMissing
 	}
 
 	lines := strings.Split(normalizedPayload, "\n")
-	if len(lines) != 2 {
-		return StdinCredentialInput{}, ErrCredentialInputFormat
+	if len(lines) != count {
+		return nil, ErrCredentialInputFormat
 	}
-	apiKey := strings.TrimSuffix(lines[0], "\r")
-	apiSecret := strings.TrimSuffix(lines[1], "\r")
-	if apiKey == "" || apiSecret == "" {
-		return StdinCredentialInput{}, ErrCredentialInputFormat
+	for index, line := range lines {
+		lines[index] = strings.TrimSuffix(line, "\r")
+		if lines[index] == "" {
+			return nil, ErrCredentialInputFormat
+		}
 	}
 
-	return StdinCredentialInput{
-		APIKey:    apiKey,
-		APISecret: []byte(apiSecret),
-	}, nil
+	return lines, nil
 }
 
 func normalizePayload(payload []byte) string {
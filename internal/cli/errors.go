@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"errors"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+)
+
+var passphraseErrorMessages = []struct {
+	match   error
+	message string
+}{
+	{match: ports.ErrPassphraseRequired, message: "encrypted-file backend needs a passphrase; set WBCLI_PASSPHRASE_FILE or WBCLI_PASSPHRASE_FD"},
+	{match: ports.ErrPassphraseMismatch, message: "cannot decrypt ~/.wbcli/credentials.enc; the passphrase is wrong or the file was modified"},
+}
+
+// MapPassphraseError returns the user-facing error for encrypted-file passphrase failures, or nil for other errors.
+func MapPassphraseError(err error) error {
+	for _, rule := range passphraseErrorMessages {
+		if errors.Is(err, rule.match) {
+			return errors.New(rule.message)
+		}
+	}
+
+	return nil
+}
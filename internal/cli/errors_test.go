@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+)
+
+func TestMapPassphraseError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{name: "required", err: fmt.Errorf("load credential: %w", ports.ErrPassphraseRequired), expected: "needs a passphrase"},
+		{name: "mismatch", err: ports.ErrPassphraseMismatch, expected: "passphrase is wrong"},
+		{name: "other", err: errors.New("boom")},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mapped := MapPassphraseError(testCase.err)
+			if testCase.expected == "" {
+				if mapped != nil {
+					t.Fatalf("expected nil, got %v", mapped)
+				}
+				return
+			}
+			if mapped == nil || !strings.Contains(mapped.Error(), testCase.expected) {
+				t.Fatalf("expected message containing %q, got %v", testCase.expected, mapped)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package cli
 
-import "os"
+import (
+	"os"
+
+	"golang.org/x/term"
+)
 
 // IsTerminalInput reports whether provided file is attached to a terminal.
 func IsTerminalInput(file *os.File) bool {
@@ -15,3 +19,12 @@ func IsTerminalInput(file *os.File) bool {
 
 	return (fileInfo.Mode() & os.ModeCharDevice) != 0
 }
+
+// IsTerminalOutput reports whether provided file writes to a terminal that renders cursor control.
+func IsTerminalOutput(file *os.File) bool {
+	if file == nil {
+		return false
+	}
+
+	return term.IsTerminal(int(file.Fd()))
+}
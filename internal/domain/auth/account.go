@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"errors"
+	"regexp"
+)
+
+// DefaultAccount is the account slot used until another account is selected; single-account setups only use it.
+const DefaultAccount = "default"
+
+// ErrAccountNameInvalid indicates an account name that cannot be used as a slot name.
+var ErrAccountNameInvalid = errors.New("account name must be 1-32 characters of a-z, 0-9, '-' or '_'")
+
+var accountNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+// ValidateAccountName checks that name is safe to use in keychain entries and file names.
+func ValidateAccountName(name string) error {
+	if !accountNamePattern.MatchString(name) {
+		return ErrAccountNameInvalid
+	}
+
+	return nil
+}
@@ -0,0 +1,303 @@
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var (
+	// ErrInvalidDecimal indicates a string that is not a plain base-10 decimal.
+	ErrInvalidDecimal = errors.New("invalid decimal")
+	// ErrDivisionByZero indicates a zero divisor.
+	ErrDivisionByZero = errors.New("decimal division by zero")
+)
+
+var bigTen = big.NewInt(10)
+
+// Decimal is an exact base-10 number: coefficient * 10^-scale.
+// Values are immutable; the zero value is 0.
+// Prices and amounts are kept as Decimal from parsing to the request payload so they never pass through float.
+type Decimal struct {
+	coefficient *big.Int
+	scale       int32
+}
+
+// Parse parses plain decimal notation like "49000", "-1.5" or "0.00100".
+// Exponent notation, NaN and infinity are rejected; trailing zeros are preserved.
+func Parse(value string) (Decimal, error) {
+	trimmed := strings.TrimSpace(value)
+	digits := trimmed
+	negative := false
+	if digits != "" && (digits[0] == '-' || digits[0] == '+') {
+		negative = digits[0] == '-'
+		digits = digits[1:]
+	}
+
+	integerPart, fractionPart, _ := strings.Cut(digits, ".")
+	if integerPart == "" && fractionPart == "" {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, value)
+	}
+	for _, char := range integerPart + fractionPart {
+		if char < '0' || char > '9' {
+			return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, value)
+		}
+	}
+
+	coefficient, ok := new(big.Int).SetString(integerPart+fractionPart, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("%w: %q", ErrInvalidDecimal, value)
+	}
+	if negative {
+		coefficient.Neg(coefficient)
+	}
+
+	return Decimal{coefficient: coefficient, scale: int32(len(fractionPart))}, nil
+}
+
+// MustParse is like Parse but panics on invalid input. Intended for constants and tests.
+func MustParse(value string) Decimal {
+	parsed, err := Parse(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return parsed
+}
+
+// New returns coefficient * 10^-scale, for example New(1, 2) is 0.01.
+func New(coefficient int64, scale int) Decimal {
+	return Decimal{coefficient: big.NewInt(coefficient), scale: int32(scale)}
+}
+
+// FromInt returns an integer decimal.
+func FromInt(value int64) Decimal {
+	return Decimal{coefficient: big.NewInt(value)}
+}
+
+// String formats the value in plain notation with exactly Scale fractional digits.
+func (value Decimal) String() string {
+	digits := value.coeff().String()
+	negative := strings.HasPrefix(digits, "-")
+	digits = strings.TrimPrefix(digits, "-")
+
+	scale := int(value.scale)
+	if scale > 0 {
+		if len(digits) <= scale {
+			digits = strings.Repeat("0", scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	}
+	if negative {
+		return "-" + digits
+	}
+
+	return digits
+}
+
+// Scale returns the number of fractional digits.
+func (value Decimal) Scale() int {
+	return int(value.scale)
+}
+
+// Sign returns -1, 0 or +1.
+func (value Decimal) Sign() int {
+	return value.coeff().Sign()
+}
+
+// IsZero reports whether value equals 0.
+func (value Decimal) IsZero() bool {
+	return value.Sign() == 0
+}
+
+// Cmp compares value and other: -1 if value < other, 0 if equal, +1 if value > other.
+func (value Decimal) Cmp(other Decimal) int {
+	left, right := align(value, other)
+	return left.Cmp(right)
+}
+
+// Add returns value + other.
+func (value Decimal) Add(other Decimal) Decimal {
+	left, right := align(value, other)
+	return Decimal{coefficient: new(big.Int).Add(left, right), scale: max(value.scale, other.scale)}
+}
+
+// Sub returns value - other.
+func (value Decimal) Sub(other Decimal) Decimal {
+	left, right := align(value, other)
+	return Decimal{coefficient: new(big.Int).Sub(left, right), scale: max(value.scale, other.scale)}
+}
+
+// Mul returns value * other.
+func (value Decimal) Mul(other Decimal) Decimal {
+	return Decimal{
+		coefficient: new(big.Int).Mul(value.coeff(), other.coeff()),
+		scale:       value.scale + other.scale,
+	}
+}
+
+// Neg returns -value.
+func (value Decimal) Neg() Decimal {
+	return Decimal{coefficient: new(big.Int).Neg(value.coeff()), scale: value.scale}
+}
+
+// Abs returns |value|.
+func (value Decimal) Abs() Decimal {
+	return Decimal{coefficient: new(big.Int).Abs(value.coeff()), scale: value.scale}
+}
+
+// Pow returns value^exponent for exponent >= 0.
+func (value Decimal) Pow(exponent int) Decimal {
+	result := FromInt(1)
+	for range exponent {
+		result = result.Mul(value)
+	}
+
+	return result
+}
+
+// Min returns the smaller of value and other.
+func (value Decimal) Min(other Decimal) Decimal {
+	if value.Cmp(other) <= 0 {
+		return value
+	}
+
+	return other
+}
+
+// QuoInt returns value / divisor truncated towards zero.
+func (value Decimal) QuoInt(divisor Decimal) (*big.Int, error) {
+	if divisor.IsZero() {
+		return nil, ErrDivisionByZero
+	}
+
+	left, right := align(value, divisor)
+	return new(big.Int).Quo(left, right), nil
+}
+
+// Quo returns value divided by divisor, rounded half away from zero to places fractional digits.
+func (value Decimal) Quo(divisor Decimal, places int) (Decimal, error) {
+	if divisor.IsZero() {
+		return Decimal{}, ErrDivisionByZero
+	}
+	if places < 0 {
+		places = 0
+	}
+
+	// One guard digit is enough: truncation toward zero never crosses a half boundary.
+	numerator := value.coeff()
+	denominator := divisor.coeff()
+	shift := places + 1 + int(divisor.scale) - int(value.scale)
+	if shift >= 0 {
+		numerator = new(big.Int).Mul(numerator, pow10(shift))
+	} else {
+		denominator = new(big.Int).Mul(denominator, pow10(-shift))
+	}
+
+	quotient := Decimal{coefficient: new(big.Int).Quo(numerator, denominator), scale: int32(places + 1)}
+	return quotient.Round(places), nil
+}
+
+// Round rounds value half away from zero to places fractional digits.
+// Values that already have at most places fractional digits are returned unchanged.
+func (value Decimal) Round(places int) Decimal {
+	if places < 0 || int(value.scale) <= places {
+		return value
+	}
+
+	divisor := pow10(int(value.scale) - places)
+	quotient, remainder := new(big.Int).QuoRem(value.coeff(), divisor, new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(divisor) >= 0 {
+		quotient.Add(quotient, big.NewInt(int64(value.Sign())))
+	}
+
+	return Decimal{coefficient: quotient, scale: int32(places)}
+}
+
+// Truncate drops fractional digits beyond places, rounding towards zero.
+func (value Decimal) Truncate(places int) Decimal {
+	if places < 0 || int(value.scale) <= places {
+		return value
+	}
+
+	quotient := new(big.Int).Quo(value.coeff(), pow10(int(value.scale)-places))
+	return Decimal{coefficient: quotient, scale: int32(places)}
+}
+
+// IsMultipleOf reports whether value is an exact multiple of step. A zero step never matches.
+func (value Decimal) IsMultipleOf(step Decimal) bool {
+	if step.IsZero() {
+		return false
+	}
+
+	left, right := align(value, step)
+	return new(big.Int).Rem(left, right).Sign() == 0
+}
+
+// FloorMultiple returns the largest multiple of a positive step that is <= value, at the step's scale.
+func (value Decimal) FloorMultiple(step Decimal) Decimal {
+	return value.snapMultiple(step, false)
+}
+
+// CeilMultiple returns the smallest multiple of a positive step that is >= value, at the step's scale.
+func (value Decimal) CeilMultiple(step Decimal) Decimal {
+	return value.snapMultiple(step, true)
+}
+
+func (value Decimal) snapMultiple(step Decimal, up bool) Decimal {
+	if step.Sign() <= 0 {
+		return value
+	}
+
+	left, right := align(value, step)
+	quotient, modulus := new(big.Int).DivMod(left, right, new(big.Int))
+	if up && modulus.Sign() != 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+
+	return Decimal{coefficient: quotient.Mul(quotient, step.coeff()), scale: step.scale}
+}
+
+// Normalize removes trailing fractional zeros: 1.500 becomes 1.5 and 2.0 becomes 2.
+func (value Decimal) Normalize() Decimal {
+	coefficient := new(big.Int).Set(value.coeff())
+	scale := value.scale
+	remainder := new(big.Int)
+	for scale > 0 && coefficient.Sign() != 0 {
+		quotient, rem := new(big.Int).QuoRem(coefficient, bigTen, remainder)
+		if rem.Sign() != 0 {
+			break
+		}
+		coefficient = quotient
+		scale--
+	}
+	if coefficient.Sign() == 0 {
+		scale = 0
+	}
+
+	return Decimal{coefficient: coefficient, scale: scale}
+}
+
+func (value Decimal) coeff() *big.Int {
+	if value.coefficient == nil {
+		return new(big.Int)
+	}
+
+	return value.coefficient
+}
+
+func align(left Decimal, right Decimal) (*big.Int, *big.Int) {
+	switch {
+	case left.scale == right.scale:
+		return left.coeff(), right.coeff()
+	case left.scale < right.scale:
+		return new(big.Int).Mul(left.coeff(), pow10(int(right.scale-left.scale))), right.coeff()
+	default:
+		return left.coeff(), new(big.Int).Mul(right.coeff(), pow10(int(left.scale-right.scale)))
+	}
+}
+
+func pow10(exponent int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(exponent)), nil)
+}
@@ -0,0 +1,167 @@
+package decimal
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseAndString(t *testing.T) {
+	testCases := []struct {
+		input string
+		want  string
+	}{
+		{input: "49000", want: "49000"},
+		{input: "0.00100", want: "0.00100"},
+		{input: " -1.5 ", want: "-1.5"},
+		{input: "+2", want: "2"},
+		{input: ".5", want: "0.5"},
+		{input: "7.", want: "7"},
+	}
+
+	for _, testCase := range testCases {
+		parsed, err := Parse(testCase.input)
+		if err != nil {
+			t.Fatalf("parse %q: expected no error, got %v", testCase.input, err)
+		}
+		if got := parsed.String(); got != testCase.want {
+			t.Fatalf("parse %q: expected %s, got %s", testCase.input, testCase.want, got)
+		}
+	}
+}
+
+func TestParseRejectsNonPlainNotation(t *testing.T) {
+	for _, input := range []string{"", "-", ".", "1e3", "NaN", "Inf", "1,5", "1.2.3", "0x10"} {
+		if _, err := Parse(input); !errors.Is(err, ErrInvalidDecimal) {
+			t.Fatalf("parse %q: expected invalid decimal error, got %v", input, err)
+		}
+	}
+}
+
+func TestArithmeticIsExact(t *testing.T) {
+	sum := MustParse("0.1").Add(MustParse("0.2"))
+	if sum.Cmp(MustParse("0.3")) != 0 || sum.String() != "0.3" {
+		t.Fatalf("expected 0.1+0.2 == 0.3, got %s", sum)
+	}
+
+	amount := MustParse("0.005").Mul(MustParse("1.5").Pow(3))
+	if amount.String() != "0.016875" {
+		t.Fatalf("expected 0.005*1.5^3 = 0.016875, got %s", amount)
+	}
+
+	if got := MustParse("49000").Sub(MustParse("0.25")).String(); got != "48999.75" {
+		t.Fatalf("expected 48999.75, got %s", got)
+	}
+	if got := MustParse("3").Min(MustParse("2.5")).String(); got != "2.5" {
+		t.Fatalf("expected min 2.5, got %s", got)
+	}
+	if got := MustParse("-0.10").Abs().Neg().String(); got != "-0.10" {
+		t.Fatalf("expected -0.10, got %s", got)
+	}
+	if !(Decimal{}).IsZero() || (Decimal{}).String() != "0" {
+		t.Fatalf("expected zero value to be 0")
+	}
+}
+
+func TestQuoInt(t *testing.T) {
+	quotient, err := MustParse("1000").QuoInt(MustParse("0.3"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if quotient.Int64() != 3333 {
+		t.Fatalf("expected 3333, got %s", quotient)
+	}
+
+	if _, err := MustParse("1").QuoInt(Decimal{}); !errors.Is(err, ErrDivisionByZero) {
+		t.Fatalf("expected division by zero error, got %v", err)
+	}
+}
+
+func TestQuo(t *testing.T) {
+	testCases := []struct {
+		value   string
+		divisor string
+		places  int
+		want    string
+	}{
+		{value: "1", divisor: "3", places: 4, want: "0.3333"},
+		{value: "2", divisor: "3", places: 2, want: "0.67"},
+		{value: "-0.045", divisor: "1", places: 2, want: "-0.05"},
+		{value: "250.5", divisor: "0.001", places: 0, want: "250500"},
+		{value: "123.45", divisor: "1000", places: 2, want: "0.12"},
+	}
+
+	for _, testCase := range testCases {
+		got, err := MustParse(testCase.value).Quo(MustParse(testCase.divisor), testCase.places)
+		if err != nil {
+			t.Fatalf("quo %s/%s: expected no error, got %v", testCase.value, testCase.divisor, err)
+		}
+		if got.String() != testCase.want {
+			t.Fatalf("quo %s/%s to %d: expected %s, got %s", testCase.value, testCase.divisor, testCase.places, testCase.want, got)
+		}
+	}
+
+	if _, err := MustParse("1").Quo(Decimal{}, 2); !errors.Is(err, ErrDivisionByZero) {
+		t.Fatalf("expected division by zero error, got %v", err)
+	}
+}
+
+func TestRoundAndNormalize(t *testing.T) {
+	testCases := []struct {
+		value  string
+		places int
+		want   string
+	}{
+		{value: "0.123456785", places: 8, want: "0.12345679"},
+		{value: "0.123456784", places: 8, want: "0.12345678"},
+		{value: "-2.5", places: 0, want: "-3"},
+		{value: "1.25", places: 4, want: "1.25"},
+	}
+
+	for _, testCase := range testCases {
+		if got := MustParse(testCase.value).Round(testCase.places).String(); got != testCase.want {
+			t.Fatalf("round %s to %d: expected %s, got %s", testCase.value, testCase.places, testCase.want, got)
+		}
+	}
+
+	if got := MustParse("1.500").Normalize().String(); got != "1.5" {
+		t.Fatalf("expected 1.5, got %s", got)
+	}
+	if got := MustParse("2.000").Normalize().String(); got != "2" {
+		t.Fatalf("expected 2, got %s", got)
+	}
+	if got := MustParse("0.000").Normalize().String(); got != "0" {
+		t.Fatalf("expected 0, got %s", got)
+	}
+	if got := MustParse("100").Normalize().String(); got != "100" {
+		t.Fatalf("expected 100, got %s", got)
+	}
+}
+
+func TestTruncateAndMultiples(t *testing.T) {
+	if got := MustParse("0.123456789").Truncate(3).String(); got != "0.123" {
+		t.Fatalf("expected 0.123, got %s", got)
+	}
+	if got := MustParse("-1.999").Truncate(0).String(); got != "-1" {
+		t.Fatalf("expected -1, got %s", got)
+	}
+
+	tick := New(5, 1)
+	if !MustParse("49000.5").IsMultipleOf(tick) || MustParse("49000.25").IsMultipleOf(tick) {
+		t.Fatalf("unexpected tick multiple check")
+	}
+	if MustParse("1").IsMultipleOf(Decimal{}) {
+		t.Fatalf("expected zero step to never match")
+	}
+	if got := MustParse("49000.27").FloorMultiple(tick).String(); got != "49000.0" {
+		t.Fatalf("expected floor 49000.0, got %s", got)
+	}
+	if got := MustParse("49000.27").CeilMultiple(tick).String(); got != "49000.5" {
+		t.Fatalf("expected ceil 49000.5, got %s", got)
+	}
+	if got := MustParse("49000.5").CeilMultiple(tick).String(); got != "49000.5" {
+		t.Fatalf("expected unchanged 49000.5, got %s", got)
+	}
+	if got := New(1, 2).String(); got != "0.01" {
+		t.Fatalf("expected 0.01, got %s", got)
+	}
+}
@@ -0,0 +1,50 @@
+package authcmd
+
+import (
+	"fmt"
+	"time"
+
+	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	clitools "github.com/ChewX3D/crypto/internal/cli"
+	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
+	"github.com/spf13/cobra"
+)
+
+// newAgentServeCmd runs the credential agent process started by wbcli auth unlock; it is not meant to be run by hand.
+func newAgentServeCmd() *cobra.Command {
+	var socketPath string
+	var ttl time.Duration
+
+	command := &cobra.Command{
+		Use:    appcontainer.CredentialAgentServeCommand,
+		Short:  "Run the credential agent (internal)",
+		Hidden: true,
+		Args:   cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			if socketPath == "" || ttl <= 0 {
+				return fmt.Errorf("--socket and a positive --ttl are required")
+			}
+
+			credentials, err := clitools.ReadCredentialPairFromReader(command.InOrStdin(), 16*1024)
+			if err != nil {
+				return mapError(err)
+			}
+
+			return appcontainer.ServeCredentialAgent(
+				command.Context(),
+				socketPath,
+				domainauth.Credential{APIKey: credentials.APIKey, APISecret: credentials.APISecret},
+				ttl,
+				func() error {
+					_, err := fmt.Fprintln(command.OutOrStdout(), appcontainer.CredentialAgentReadyLine)
+					return err
+				},
+			)
+		},
+	}
+
+	command.Flags().StringVar(&socketPath, "socket", "", "agent socket path")
+	command.Flags().DurationVar(&ttl, "ttl", 0, "how long to keep the credential")
+
+	return command
+}
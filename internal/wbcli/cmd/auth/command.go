@@ -12,7 +12,8 @@ func NewCommand(getApplication func() (*appcontainer.Application, error)) *cobra
 	authCmd := &cobra.Command{
 		Use:   "auth",
 		Short: "Manage authentication credentials",
-		Long:  "Manage WhiteBIT API authentication credentials in single-session mode.",
+		Long: "Manage WhiteBIT API authentication credentials. Each named account keeps its own session;\n" +
+			"single-account setups only use the default account.",
 		RunE: func(command *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				return fmt.Errorf("unknown command %q for %q", args[0], command.CommandPath())
@@ -25,6 +26,11 @@ func NewCommand(getApplication func() (*appcontainer.Application, error)) *cobra
 	authCmd.AddCommand(newLoginCmd(getApplication))
 	authCmd.AddCommand(newLogoutCmd(getApplication))
 	authCmd.AddCommand(newStatusCmd(getApplication))
+	authCmd.AddCommand(newRotateCmd(getApplication))
+	authCmd.AddCommand(newUnlockCmd(getApplication))
+	authCmd.AddCommand(newLockCmd(getApplication))
+	authCmd.AddCommand(newUseCmd(getApplication))
+	authCmd.AddCommand(newAgentServeCmd())
 
 	return authCmd
 }
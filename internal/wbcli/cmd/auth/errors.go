@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/ChewX3D/crypto/internal/app/ports"
+	authservice "github.com/ChewX3D/crypto/internal/app/services/auth"
 	clitools "github.com/ChewX3D/crypto/internal/cli"
 	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
 )
@@ -18,10 +19,20 @@ var staticAuthErrorRules = []staticAuthErrorRule{
 	{match: domainauth.ErrAPISecretRequired, message: "api secret is required in stdin payload"},
 	{match: clitools.ErrCredentialInputMissing, message: "stdin credentials are required: first line API key, second line API secret"},
 	{match: clitools.ErrCredentialInputTooLarge, message: "stdin credential payload exceeds maximum allowed size"},
-	{match: clitools.ErrCredentialInputFormat, message: "stdin credential payload must contain exactly two non-empty lines: api_key then api_secret"},
+	{match: clitools.ErrCredentialInputFormat, message: "stdin credential payload must contain exactly two non-empty lines: api_key then api_secret (plus passphrase with --passphrase-stdin)"},
 	{match: ports.ErrCredentialNotFound, message: "not logged in; run wbcli auth login first"},
-	{match: ports.ErrSecretStoreUnavailable, message: "os-keychain backend is unavailable on this system; install/unlock keychain backend and retry"},
+	{match: ports.ErrSecretStoreUnavailable, message: "os-keychain backend is unavailable on this system; install/unlock keychain backend and retry, or use --backend encrypted-file"},
 	{match: ports.ErrSecretStorePermissionDenied, message: "os-keychain access denied; keychain is locked or access is restricted"},
+	{match: ports.ErrCredentialStoreReadOnly, message: "credentials from --credentials-from are read-only; run without it to manage stored credentials"},
+	{match: authservice.ErrRotationSameKey, message: "new api key equals the stored api key; nothing to rotate"},
+	{match: authservice.ErrRotationAccountMismatch, message: "new api key sees different positions or balances than the stored key; stored credential was kept"},
+	{match: authservice.ErrRotationAccountUnverified, message: "account has no balances or open positions to confirm both keys belong to it; rerun with --allow-empty-account to accept the new key"},
+	{match: authservice.ErrCheckMarketRequired, message: "--market is required with --check"},
+	{match: authservice.ErrUnlockTTLInvalid, message: "--ttl must be positive and at most 12h"},
+	{match: ports.ErrCredentialAgentUnsupported, message: "credential agent is not supported on this platform"},
+	{match: domainauth.ErrAccountNameInvalid, message: "account name must be 1-32 characters of a-z, 0-9, '-' or '_'"},
+	{match: authservice.ErrAccountNotFound, message: "account has no stored login; run wbcli --account <name> auth login first"},
+	{match: ports.ErrUnknownCredentialBackend, message: "session refers to an unknown credential backend; run wbcli auth login again"},
 }
 
 func mapError(err error) error {
@@ -33,6 +44,9 @@ func mapError(err error) error {
 	if errors.As(err, &apiErr) {
 		return apiErr
 	}
+	if mapped := clitools.MapPassphraseError(err); mapped != nil {
+		return mapped
+	}
 
 	for _, rule := range staticAuthErrorRules {
 		if errors.Is(err, rule.match) {
@@ -1,8 +1,10 @@
 package authcmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
 	authservice "github.com/ChewX3D/crypto/internal/app/services/auth"
@@ -10,11 +12,28 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	backendOSKeychain    = "os-keychain"
+	backendEncryptedFile = "encrypted-file"
+)
+
+type loginOptions struct {
+	Backend         string
+	PassphraseFile  string
+	PassphraseFD    int
+	PassphraseStdin bool
+}
+
 func newLoginCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	options := &loginOptions{}
+
 	command := &cobra.Command{
 		Use:   "login",
 		Short: "Validate and store credentials from stdin",
-		Long:  "Validate WhiteBIT access via collateral hedge-mode probe, then store API key and API secret in secure OS keychain backend using stdin-only input.",
+		Long: "Validate WhiteBIT access via collateral hedge-mode probe, then store API key and API secret in secure OS keychain backend using stdin-only input.\n" +
+			"On systems without a usable keychain, --backend encrypted-file stores them in ~/.wbcli/credentials.enc, encrypted with a passphrase.\n" +
+			"The passphrase comes from --passphrase-file, --passphrase-fd, a third stdin line with --passphrase-stdin, or WBCLI_PASSPHRASE_FILE/WBCLI_PASSPHRASE_FD;\n" +
+			"later commands read it from WBCLI_PASSPHRASE_FILE or WBCLI_PASSPHRASE_FD.",
 		Example: `  # Option 1: local inline values
   WBCLI_API_KEY='1' WBCLI_API_SECRET='2' sh -c 'printf "%s\n%s\n" "$WBCLI_API_KEY" "$WBCLI_API_SECRET"' | wbcli auth login
 
@@ -23,21 +42,39 @@ func newLoginCmd(getApplication func() (*appcontainer.Application, error)) *cobr
 
   # Option 3: local file with exactly two lines
   # line 1 = api key, line 2 = api secret
-  cat /tmp/wbcli-auth.txt | wbcli auth login`,
+  cat /tmp/wbcli-auth.txt | wbcli auth login
+
+  # Option 4: headless server without keychain, passphrase from a file
+  cat /tmp/wbcli-auth.txt | wbcli auth login --backend encrypted-file --passphrase-file ~/.wbcli-passphrase`,
 		RunE: func(command *cobra.Command, args []string) error {
+			if err := options.validate(); err != nil {
+				return err
+			}
 			if inputFile, ok := command.InOrStdin().(*os.File); ok && clitools.IsTerminalInput(inputFile) {
 				return mapError(clitools.ErrCredentialInputMissing)
 			}
 
-			credentials, err := clitools.ReadCredentialPairFromReader(command.InOrStdin(), 16*1024)
+			readCredentials := clitools.ReadCredentialPairFromReader
+			if options.PassphraseStdin {
+				readCredentials = clitools.ReadCredentialsWithPassphraseFromReader
+			}
+			credentials, err := readCredentials(command.InOrStdin(), 16*1024)
 			if err != nil {
 				return mapError(err)
 			}
+			if options.PassphraseFile != "" || options.PassphraseFD >= 0 {
+				credentials.Passphrase, err = appcontainer.ReadPassphrase(command.Context(), options.PassphraseFile, options.PassphraseFD)
+				if err != nil {
+					return mapError(err)
+				}
+			}
 
 			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
 				result, err := application.Auth.Login(command.Context(), authservice.LoginRequest{
-					APIKey:    credentials.APIKey,
-					APISecret: credentials.APISecret,
+					APIKey:     credentials.APIKey,
+					APISecret:  credentials.APISecret,
+					Backend:    options.Backend,
+					Passphrase: credentials.Passphrase,
 				})
 				if err != nil {
 					return err
@@ -55,5 +92,32 @@ func newLoginCmd(getApplication func() (*appcontainer.Application, error)) *cobr
 		},
 	}
 
+	command.Flags().StringVar(&options.Backend, "backend", backendOSKeychain, "credential backend: os-keychain|encrypted-file")
+	command.Flags().StringVar(&options.PassphraseFile, "passphrase-file", "", "read encrypted-file passphrase from the first line of this file")
+	command.Flags().IntVar(&options.PassphraseFD, "passphrase-fd", -1, "read encrypted-file passphrase from this inherited file descriptor")
+	command.Flags().BoolVar(&options.PassphraseStdin, "passphrase-stdin", false, "read encrypted-file passphrase from a third stdin line")
+
 	return command
 }
+
+func (options *loginOptions) validate() error {
+	options.Backend = strings.ToLower(strings.TrimSpace(options.Backend))
+	if options.Backend != backendOSKeychain && options.Backend != backendEncryptedFile {
+		return errors.New("--backend must be one of: os-keychain, encrypted-file")
+	}
+
+	sources := 0
+	for _, set := range []bool{options.PassphraseFile != "", options.PassphraseFD >= 0, options.PassphraseStdin} {
+		if set {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return errors.New("use only one of --passphrase-file, --passphrase-fd, --passphrase-stdin")
+	}
+	if sources == 1 && options.Backend != backendEncryptedFile {
+		return errors.New("passphrase flags require --backend encrypted-file")
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package authcmd
+
+import (
+	"fmt"
+	"os"
+
+	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	authservice "github.com/ChewX3D/crypto/internal/app/services/auth"
+	clitools "github.com/ChewX3D/crypto/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+func newRotateCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	var allowEmptyAccount bool
+
+	command := &cobra.Command{
+		Use:   "rotate",
+		Short: "Replace stored credentials with a new key pair from stdin",
+		Long: "Read a new API key and API secret from stdin, verify both the stored and the new key against WhiteBIT,\n" +
+			"and replace the stored credential only when both keys report the same hedge mode and see the same account\n" +
+			"(open position ids, or non-zero collateral balances when there are no positions).\n" +
+			"On any failure the stored credential stays in place.",
+		Example: `  # rotate to a freshly issued key; revoke the old key on WhiteBIT afterwards
+  sh -c 'printf "%s\n%s\n" "$NEW_WBCLI_API_KEY" "$NEW_WBCLI_API_SECRET"' | wbcli auth rotate`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			if inputFile, ok := command.InOrStdin().(*os.File); ok && clitools.IsTerminalInput(inputFile) {
+				return mapError(clitools.ErrCredentialInputMissing)
+			}
+
+			credentials, err := clitools.ReadCredentialPairFromReader(command.InOrStdin(), 16*1024)
+			if err != nil {
+				return mapError(err)
+			}
+
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				result, err := application.Auth.Rotate(command.Context(), authservice.RotateRequest{
+					APIKey:            credentials.APIKey,
+					APISecret:         credentials.APISecret,
+					AllowEmptyAccount: allowEmptyAccount,
+				})
+				if err != nil {
+					return err
+				}
+
+				_, err = fmt.Fprintf(
+					command.OutOrStdout(),
+					"rotated=true backend=%s api_key=%s previous_api_key=%s hedge_mode=%t saved_at=%s\n",
+					result.Backend,
+					result.APIKeyHint,
+					result.PreviousAPIKeyHint,
+					result.HedgeMode,
+					result.SavedAt,
+				)
+				return err
+			})
+		},
+	}
+
+	command.Flags().BoolVar(
+		&allowEmptyAccount,
+		"allow-empty-account",
+		false,
+		"accept the new key when neither key sees balances or open positions to compare",
+	)
+
+	return command
+}
@@ -2,36 +2,93 @@ package authcmd
 
 import (
 	"fmt"
+	"io"
 
 	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	authservice "github.com/ChewX3D/crypto/internal/app/services/auth"
 	"github.com/spf13/cobra"
 )
 
 func newStatusCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
-	return &cobra.Command{
-		Use:     "status",
-		Short:   "Show current auth status",
-		Example: "wbcli auth status",
+	var (
+		check  bool
+		market string
+	)
+
+	command := &cobra.Command{
+		Use:   "status",
+		Short: "Show current auth status",
+		Long: "Show current auth status from local session metadata.\n" +
+			"--check also makes signed calls to every private endpoint wbcli uses and reports which ones the API key may call.\n" +
+			"Market-scoped probes use --market. Order probes use a zero amount that the exchange rejects, so no order is placed or cancelled;\n" +
+			"such validation rejections are reported as access=rejected, since they do not prove the permission check passed.",
+		Example: "wbcli auth status\n" +
+			"wbcli auth status --check\n" +
+			"wbcli auth status --check --market ETH_PERP",
 		RunE: func(command *cobra.Command, args []string) error {
 			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
 				result, err := application.Auth.Status(command.Context())
 				if err != nil {
 					return err
 				}
+				account := ""
+				if result.Account != "" {
+					account = " account=" + result.Account
+				}
 				if !result.LoggedIn {
-					_, err := fmt.Fprintln(command.OutOrStdout(), "logged_in=false")
+					_, err = fmt.Fprintf(command.OutOrStdout(), "logged_in=false%s\n", account)
+				} else {
+					_, err = fmt.Fprintf(
+						command.OutOrStdout(),
+						"logged_in=true%s backend=%s api_key=%s updated_at=%s\n",
+						account,
+						result.Backend,
+						result.APIKeyHint,
+						result.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+					)
+				}
+				if err != nil || !check {
+					return err
+				}
+
+				permissions, err := application.Auth.CheckPermissions(command.Context(), authservice.CheckPermissionsRequest{Market: market})
+				if err != nil {
 					return err
 				}
 
-				_, err = fmt.Fprintf(
-					command.OutOrStdout(),
-					"logged_in=true backend=%s api_key=%s updated_at=%s\n",
-					result.Backend,
-					result.APIKeyHint,
-					result.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
-				)
-				return err
+				return writePermissions(command.OutOrStdout(), permissions)
 			})
 		},
 	}
+	command.Flags().BoolVar(&check, "check", false, "probe every private endpoint wbcli uses and report allowed/rejected/forbidden access")
+	command.Flags().StringVar(&market, "market", "BTC_PERP", "market used by market-scoped probes with --check")
+
+	return command
+}
+
+func writePermissions(writer io.Writer, result authservice.CheckPermissionsResult) error {
+	counts := map[ports.EndpointAccess]int{}
+	for _, endpoint := range result.Endpoints {
+		counts[endpoint.Access]++
+
+		reason := ""
+		if endpoint.Reason != "" {
+			reason = fmt.Sprintf(" reason=%q", endpoint.Reason)
+		}
+		if _, err := fmt.Fprintf(writer, "endpoint=%s access=%s%s\n", endpoint.Endpoint, endpoint.Access, reason); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(
+		writer,
+		"endpoints=%d allowed=%d rejected=%d forbidden=%d unknown=%d\n",
+		len(result.Endpoints),
+		counts[ports.EndpointAccessAllowed],
+		counts[ports.EndpointAccessRejected],
+		counts[ports.EndpointAccessForbidden],
+		counts[ports.EndpointAccessUnknown],
+	)
+	return err
 }
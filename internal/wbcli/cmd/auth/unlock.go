@@ -0,0 +1,66 @@
+package authcmd
+
+import (
+	"fmt"
+
+	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	authservice "github.com/ChewX3D/crypto/internal/app/services/auth"
+	"github.com/spf13/cobra"
+)
+
+func newUnlockCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	ttl := authservice.DefaultUnlockTTL
+
+	command := &cobra.Command{
+		Use:   "unlock",
+		Short: "Keep the stored credential in a local agent for a limited time",
+		Long: "Load the stored credential once (keychain prompt or passphrase) and hand it to a per-user agent\n" +
+			"listening on a 0600 Unix socket. Until the TTL elapses or wbcli auth lock runs, signed commands read\n" +
+			"the credential from the agent without prompting. The secret is held in agent memory only and never written to disk.",
+		Example: `  wbcli auth unlock --ttl 15m
+  wbcli collateral order place ...
+  wbcli auth lock`,
+		Args: cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				result, err := application.Auth.Unlock(command.Context(), authservice.UnlockRequest{TTL: ttl})
+				if err != nil {
+					return err
+				}
+
+				_, err = fmt.Fprintf(
+					command.OutOrStdout(),
+					"unlocked=true backend=%s api_key=%s expires_at=%s\n",
+					result.Backend,
+					result.APIKeyHint,
+					result.ExpiresAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+				)
+				return err
+			})
+		},
+	}
+
+	command.Flags().DurationVar(&ttl, "ttl", ttl, "how long the agent keeps the credential (max 12h)")
+
+	return command
+}
+
+func newLockCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	return &cobra.Command{
+		Use:     "lock",
+		Short:   "Stop the credential agent and wipe its credential",
+		Example: "wbcli auth lock",
+		Args:    cobra.NoArgs,
+		RunE: func(command *cobra.Command, args []string) error {
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				result, err := application.Auth.Lock(command.Context())
+				if err != nil {
+					return err
+				}
+
+				_, err = fmt.Fprintf(command.OutOrStdout(), "locked=true was_unlocked=%t\n", result.WasUnlocked)
+				return err
+			})
+		},
+	}
+}
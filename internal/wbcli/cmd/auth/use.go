@@ -0,0 +1,37 @@
+package authcmd
+
+import (
+	"fmt"
+
+	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	authservice "github.com/ChewX3D/crypto/internal/app/services/auth"
+	"github.com/spf13/cobra"
+)
+
+func newUseCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	return &cobra.Command{
+		Use:   "use <account>",
+		Short: "Select the account used by commands without --account",
+		Long: "Select the stored account that commands use when --account is not given.\n" +
+			"Add an account with wbcli --account <name> auth login; \"default\" returns to the single-account slot.",
+		Example: `  printf '%s\n%s\n' "$GRID_API_KEY" "$GRID_API_SECRET" | wbcli --account grid auth login
+  wbcli auth use grid
+  wbcli auth use default`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(command *cobra.Command, args []string) error {
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				result, err := application.Auth.Use(command.Context(), authservice.UseRequest{Account: args[0]})
+				if err != nil {
+					return err
+				}
+				if !result.LoggedIn {
+					_, err := fmt.Fprintf(command.OutOrStdout(), "account=%s logged_in=false\n", result.Account)
+					return err
+				}
+
+				_, err = fmt.Fprintf(command.OutOrStdout(), "account=%s logged_in=true api_key=%s\n", result.Account, result.APIKeyHint)
+				return err
+			})
+		},
+	}
+}
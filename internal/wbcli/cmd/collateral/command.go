@@ -11,13 +11,19 @@ func NewCommand(provider func() (*appcontainer.Application, error)) *cobra.Comma
 	command := &cobra.Command{
 		Use:   "collateral",
 		Short: "Collateral trading commands",
-		Long:  "Run collateral trading workflows such as single order placement and range planning.",
+		Long:  "Run collateral trading workflows such as single order placement, range planning, fill history, positions, account summary and account settings.",
 		RunE: func(command *cobra.Command, args []string) error {
 			return command.Help()
 		},
 	}
 
 	command.AddCommand(ordercmd.NewCommand(provider))
+	command.AddCommand(ordercmd.NewTradesCommand(provider))
+	command.AddCommand(ordercmd.NewPositionsCommand(provider))
+	command.AddCommand(ordercmd.NewPositionCommand(provider))
+	command.AddCommand(ordercmd.NewAccountCommand(provider))
+	command.AddCommand(ordercmd.NewLeverageCommand(provider))
+	command.AddCommand(ordercmd.NewHedgeModeCommand(provider))
 
 	return command
 }
@@ -0,0 +1,10 @@
+package cmd
+
+import (
+	marketcmd "github.com/ChewX3D/crypto/internal/wbcli/cmd/market"
+	"github.com/spf13/cobra"
+)
+
+func newMarketCmd(provider applicationProvider) *cobra.Command {
+	return marketcmd.NewCommand(provider)
+}
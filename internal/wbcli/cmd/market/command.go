@@ -0,0 +1,32 @@
+package marketcmd
+
+import (
+	"fmt"
+
+	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	"github.com/spf13/cobra"
+)
+
+// NewCommand constructs the public market data command group.
+func NewCommand(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "market",
+		Short: "Public market data commands",
+		Long:  "Read public WhiteBIT market data such as tickers, order book depth, recent trades and candle history, or stream them live. Only own fills in market watch need credentials.",
+		RunE: func(command *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				return fmt.Errorf("unknown command %q for %q", args[0], command.CommandPath())
+			}
+
+			return command.Help()
+		},
+	}
+
+	command.AddCommand(newTickerCmd(getApplication))
+	command.AddCommand(newDepthCmd(getApplication))
+	command.AddCommand(newTradesCmd(getApplication))
+	command.AddCommand(newKlinesCmd(getApplication))
+	command.AddCommand(newWatchCmd(getApplication))
+
+	return command
+}
@@ -0,0 +1,235 @@
+package marketcmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	marketservice "github.com/ChewX3D/crypto/internal/app/services/market"
+	"github.com/spf13/cobra"
+)
+
+type tickerOptions struct {
+	Markets []string
+	Output  string
+}
+
+type depthOptions struct {
+	Market string
+	Limit  int
+	Output string
+}
+
+type tradesOptions struct {
+	Market string
+	Side   string
+	Output string
+}
+
+func newTickerCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	options := &tickerOptions{}
+
+	command := &cobra.Command{
+		Use:   "ticker",
+		Short: "Show 24h tickers",
+		Long:  "Show last price, 24h change and volumes for selected markets, or for every market when --market is omitted.",
+		Example: `  # one market
+  wbcli market ticker --market BTC_PERP
+
+  # several markets, machine-readable
+  wbcli market ticker --market BTC_PERP --market ETH_PERP --output json`,
+		RunE: func(command *cobra.Command, args []string) error {
+			outputMode, ok := normalizeOutputMode(options.Output)
+			if !ok {
+				return errors.New("--output must be one of: table, json")
+			}
+
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				result, err := application.Market.Ticker(command.Context(), marketservice.TickerRequest{Markets: options.Markets})
+				if err != nil {
+					return err
+				}
+
+				return renderTickerOutput(command.OutOrStdout(), outputMode, result)
+			})
+		},
+	}
+
+	command.Flags().StringArrayVar(&options.Markets, "market", nil, "market pair to show; repeatable (default all markets)")
+	command.Flags().StringVar(&options.Output, "output", "table", "output format: table|json")
+
+	return command
+}
+
+func newDepthCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	options := &depthOptions{}
+
+	command := &cobra.Command{
+		Use:   "depth",
+		Short: "Show order book depth",
+		Long: "Show aggregated order book levels for one market with best bid, best ask and spread.\n" +
+			"Asks are listed from the best (lowest) price up, bids from the best (highest) price down.",
+		Example: `  # top 10 levels per side
+  wbcli market depth --market BTC_PERP --limit 10`,
+		RunE: func(command *cobra.Command, args []string) error {
+			if strings.TrimSpace(options.Market) == "" {
+				return errors.New("--market is required")
+			}
+			if options.Limit < 1 || options.Limit > marketservice.MaxDepthLimit {
+				return fmt.Errorf("--limit must be between 1 and %d", marketservice.MaxDepthLimit)
+			}
+
+			outputMode, ok := normalizeOutputMode(options.Output)
+			if !ok {
+				return errors.New("--output must be one of: table, json")
+			}
+
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				result, err := application.Market.Depth(command.Context(), marketservice.DepthRequest{
+					Market: options.Market,
+					Limit:  options.Limit,
+				})
+				if err != nil {
+					return err
+				}
+
+				return renderDepthOutput(command.OutOrStdout(), outputMode, result)
+			})
+		},
+	}
+
+	command.Flags().StringVar(&options.Market, "market", "", "whitebit market pair (for example BTC_PERP)")
+	command.Flags().IntVar(&options.Limit, "limit", 20, "levels per side (1-100)")
+	command.Flags().StringVar(&options.Output, "output", "table", "output format: table|json")
+
+	return command
+}
+
+func newTradesCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	options := &tradesOptions{}
+
+	command := &cobra.Command{
+		Use:   "trades",
+		Short: "Show recent public trades",
+		Long:  "Show the latest public trades for one market, newest first. Side is the taker side of each trade.",
+		Example: `  # latest trades
+  wbcli market trades --market BTC_PERP
+
+  # only taker sells
+  wbcli market trades --market BTC_PERP --side sell --output json`,
+		RunE: func(command *cobra.Command, args []string) error {
+			if strings.TrimSpace(options.Market) == "" {
+				return errors.New("--market is required")
+			}
+			side := strings.ToLower(strings.TrimSpace(options.Side))
+			if side != "" && side != "buy" && side != "sell" {
+				return errors.New("--side must be one of: buy, sell")
+			}
+
+			outputMode, ok := normalizeOutputMode(options.Output)
+			if !ok {
+				return errors.New("--output must be one of: table, json")
+			}
+
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				result, err := application.Market.Trades(command.Context(), marketservice.TradesRequest{
+					Market: options.Market,
+					Side:   side,
+				})
+				if err != nil {
+					return err
+				}
+
+				return renderTradesOutput(command.OutOrStdout(), outputMode, result)
+			})
+		},
+	}
+
+	command.Flags().StringVar(&options.Market, "market", "", "whitebit market pair (for example BTC_PERP)")
+	command.Flags().StringVar(&options.Side, "side", "", "taker side filter: buy|sell (default both)")
+	command.Flags().StringVar(&options.Output, "output", "table", "output format: table|json")
+
+	return command
+}
+
+func renderTickerOutput(writer io.Writer, outputMode string, result marketservice.TickerResult) error {
+	if outputMode == "json" {
+		return encodeJSON(writer, result)
+	}
+
+	for _, ticker := range result.Tickers {
+		if _, err := fmt.Fprintf(
+			writer,
+			"market=%s last_price=%s change_percent=%s base_volume=%s quote_volume=%s frozen=%t\n",
+			ticker.Market,
+			valueOrDash(ticker.LastPrice),
+			valueOrDash(ticker.Change),
+			valueOrDash(ticker.BaseVolume),
+			valueOrDash(ticker.QuoteVolume),
+			ticker.Frozen,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func renderDepthOutput(writer io.Writer, outputMode string, result marketservice.DepthResult) error {
+	if outputMode == "json" {
+		return encodeJSON(writer, result)
+	}
+
+	if _, err := fmt.Fprintf(
+		writer,
+		"market=%s timestamp=%s best_bid=%s best_ask=%s spread=%s spread_percent=%s asks=%d bids=%d\n",
+		result.Market,
+		formatTimestamp(result.Timestamp),
+		valueOrDash(result.BestBid),
+		valueOrDash(result.BestAsk),
+		valueOrDash(result.Spread),
+		valueOrDash(result.SpreadPercent),
+		len(result.Asks),
+		len(result.Bids),
+	); err != nil {
+		return err
+	}
+
+	for _, level := range result.Asks {
+		if _, err := fmt.Fprintf(writer, "side=ask price=%s amount=%s\n", level.Price, level.Amount); err != nil {
+			return err
+		}
+	}
+	for _, level := range result.Bids {
+		if _, err := fmt.Fprintf(writer, "side=bid price=%s amount=%s\n", level.Price, level.Amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func renderTradesOutput(writer io.Writer, outputMode string, result marketservice.TradesResult) error {
+	if outputMode == "json" {
+		return encodeJSON(writer, result)
+	}
+
+	for _, trade := range result.Trades {
+		if _, err := fmt.Fprintf(
+			writer,
+			"trade_id=%d executed_at=%s side=%s price=%s amount=%s total=%s\n",
+			trade.TradeID,
+			formatTimestamp(trade.ExecutedAt),
+			trade.Side,
+			trade.Price,
+			trade.Amount,
+			valueOrDash(trade.Total),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package marketcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	"github.com/ChewX3D/crypto/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+func runWithApplication(
+	command *cobra.Command,
+	getApplication func() (*appcontainer.Application, error),
+	run func(*appcontainer.Application) error,
+) error {
+	application, err := getApplication()
+	if err != nil {
+		return mapError(err)
+	}
+	if application.Market == nil {
+		return errors.New("market data service is not configured")
+	}
+
+	if err := run(application); err != nil {
+		return mapError(err)
+	}
+
+	return nil
+}
+
+func mapError(err error) error {
+	var apiErr *ports.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	switch {
+	case errors.Is(err, ports.ErrCredentialNotFound):
+		return errors.New("not logged in; run wbcli auth login first or pass --fills=false")
+	case errors.Is(err, ports.ErrSecretStoreUnavailable):
+		return errors.New("os-keychain backend is unavailable on this system; install/unlock keychain backend and retry")
+	case errors.Is(err, ports.ErrSecretStorePermissionDenied):
+		return errors.New("os-keychain access denied; keychain is locked or access is restricted")
+	}
+	if mapped := cli.MapPassphraseError(err); mapped != nil {
+		return mapped
+	}
+
+	return err
+}
+
+func normalizeOutputMode(mode string) (string, bool) {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "", "table":
+		return "table", true
+	case "json":
+		return "json", true
+	default:
+		return "", false
+	}
+}
+
+func encodeJSON(writer io.Writer, value any) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetEscapeHTML(false)
+
+	return encoder.Encode(value)
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+
+	return value
+}
+
+func formatTimestamp(value time.Time) string {
+	if value.IsZero() {
+		return "-"
+	}
+
+	return value.Format(time.RFC3339)
+}
+
+func parseTimeFlag(flagName string, value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed.UTC(), nil
+	}
+	if parsed, err := time.Parse(time.DateOnly, value); err == nil {
+		return parsed.UTC(), nil
+	}
+	if duration, err := time.ParseDuration(value); err == nil && duration > 0 {
+		return now.Add(-duration).UTC(), nil
+	}
+
+	return time.Time{}, fmt.Errorf("%s must be an RFC3339 timestamp, a YYYY-MM-DD date or a positive duration like 24h, got %q", flagName, value)
+}
@@ -0,0 +1,158 @@
+package marketcmd
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	marketservice "github.com/ChewX3D/crypto/internal/app/services/market"
+	"github.com/spf13/cobra"
+)
+
+type klinesExportOptions struct {
+	Market   string
+	Interval string
+	Since    string
+	Until    string
+	Format   string
+	File     string
+}
+
+func newKlinesCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "klines",
+		Short: "Candlestick history commands",
+		RunE: func(command *cobra.Command, args []string) error {
+			return command.Help()
+		},
+	}
+
+	command.AddCommand(newKlinesExportCmd(getApplication))
+
+	return command
+}
+
+func newKlinesExportCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	options := &klinesExportOptions{}
+
+	command := &cobra.Command{
+		Use:   "export",
+		Short: "Export closed candles as CSV or JSON",
+		Long: "Export closed candles for one market and interval.\n" +
+			"Candles are read from the local cache (~/.wbcli/klines) and missing pages are fetched backward from --until,\n" +
+			"with a pause between pages, and appended to the cache; the still-forming candle is never exported.\n" +
+			"--since is inclusive and --until exclusive on candle open time; both accept RFC3339, YYYY-MM-DD (UTC)\n" +
+			"or a duration like 24h meaning that long ago. A fetch summary is printed to stderr.",
+		Example: `  # last 30 days of 15m candles for EMA/ATR seeding
+  wbcli market klines export --market BTC_PERP --interval 15m --since 720h > btc-15m.csv
+
+  # one quarter of hourly candles as JSON
+  wbcli market klines export --market BTC_PERP --interval 1h --since 2026-01-01 --until 2026-04-01 --format json --file btc-1h.json`,
+		RunE: func(command *cobra.Command, args []string) error {
+			if strings.TrimSpace(options.Market) == "" {
+				return errors.New("--market is required")
+			}
+			if strings.TrimSpace(options.Interval) == "" {
+				return errors.New("--interval is required")
+			}
+			if strings.TrimSpace(options.Since) == "" {
+				return errors.New("--since is required")
+			}
+			format := strings.ToLower(strings.TrimSpace(options.Format))
+			if format != "csv" && format != "json" {
+				return errors.New("--format must be one of: csv, json")
+			}
+
+			now := time.Now()
+			since, err := parseTimeFlag("--since", options.Since, now)
+			if err != nil {
+				return err
+			}
+			until, err := parseTimeFlag("--until", options.Until, now)
+			if err != nil {
+				return err
+			}
+			if !until.IsZero() && !until.After(since) {
+				return errors.New("--until must be after --since")
+			}
+
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				result, err := application.Market.Klines(command.Context(), marketservice.KlinesRequest{
+					Market:   options.Market,
+					Interval: options.Interval,
+					Since:    since,
+					Until:    until,
+				})
+				if err != nil {
+					return err
+				}
+
+				writer := command.OutOrStdout()
+				if options.File != "" {
+					file, err := os.Create(options.File)
+					if err != nil {
+						return fmt.Errorf("create export file: %w", err)
+					}
+					defer file.Close()
+					writer = file
+				}
+
+				if err := renderKlinesOutput(writer, format, result); err != nil {
+					return err
+				}
+
+				_, err = fmt.Fprintf(
+					command.ErrOrStderr(),
+					"market=%s interval=%s candles=%d fetched=%d cached=%d\n",
+					result.Market,
+					result.Interval,
+					len(result.Klines),
+					result.Fetched,
+					result.Cached,
+				)
+				return err
+			})
+		},
+	}
+
+	command.Flags().StringVar(&options.Market, "market", "", "whitebit market pair (for example BTC_PERP)")
+	command.Flags().StringVar(&options.Interval, "interval", "15m", "candle interval: 1m|3m|5m|15m|30m|1h|2h|4h|6h|8h|12h|1d|1w")
+	command.Flags().StringVar(&options.Since, "since", "", "window start (inclusive): RFC3339, YYYY-MM-DD or duration ago")
+	command.Flags().StringVar(&options.Until, "until", "", "window end (exclusive): RFC3339, YYYY-MM-DD or duration ago; default now")
+	command.Flags().StringVar(&options.Format, "format", "csv", "export format: csv|json")
+	command.Flags().StringVar(&options.File, "file", "", "write export to file instead of stdout")
+
+	return command
+}
+
+func renderKlinesOutput(writer io.Writer, format string, result marketservice.KlinesResult) error {
+	if format == "json" {
+		return encodeJSON(writer, result)
+	}
+
+	csvWriter := csv.NewWriter(writer)
+	if err := csvWriter.Write([]string{"open_time", "open", "high", "low", "close", "volume", "quote_volume"}); err != nil {
+		return err
+	}
+	for _, kline := range result.Klines {
+		if err := csvWriter.Write([]string{
+			kline.OpenTime.Format(time.RFC3339),
+			kline.Open,
+			kline.High,
+			kline.Low,
+			kline.Close,
+			kline.Volume,
+			kline.QuoteVolume,
+		}); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+
+	return csvWriter.Error()
+}
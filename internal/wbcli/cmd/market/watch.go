@@ -0,0 +1,163 @@
+package marketcmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	marketservice "github.com/ChewX3D/crypto/internal/app/services/market"
+	"github.com/ChewX3D/crypto/internal/cli"
+	"github.com/spf13/cobra"
+)
+
+type watchOptions struct {
+	Market string
+	Fills  bool
+	Output string
+}
+
+func newWatchCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	options := &watchOptions{}
+
+	command := &cobra.Command{
+		Use:   "watch",
+		Short: "Stream last price, top of book and own fills",
+		Long: "Stream live last price, best bid/ask and own order fills for one market over the WhiteBIT websocket API until interrupted.\n" +
+			"Table mode prints one line per update and refreshes the quote line in place on a terminal; json mode prints one JSON event per line.\n" +
+			"Own fills need stored credentials; use --fills=false to watch public data only. Press Ctrl-C to stop.",
+		Example: `  # watch a live ladder
+  wbcli market watch --market BTC_PERP
+
+  # public data only, as NDJSON
+  wbcli market watch --market BTC_PERP --fills=false --output json`,
+		RunE: func(command *cobra.Command, args []string) error {
+			if strings.TrimSpace(options.Market) == "" {
+				return errors.New("--market is required")
+			}
+
+			outputMode, ok := normalizeOutputMode(options.Output)
+			if !ok {
+				return errors.New("--output must be one of: table, json")
+			}
+
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				ctx, stop := signal.NotifyContext(command.Context(), os.Interrupt, syscall.SIGTERM)
+				defer stop()
+
+				renderer := newWatchRenderer(command.OutOrStdout(), outputMode)
+				defer renderer.finish()
+
+				return application.Market.Watch(ctx, marketservice.WatchRequest{
+					Market: options.Market,
+					Fills:  options.Fills,
+				}, renderer.render)
+			})
+		},
+	}
+
+	command.Flags().StringVar(&options.Market, "market", "", "whitebit market pair (for example BTC_PERP)")
+	command.Flags().BoolVar(&options.Fills, "fills", true, "stream own order fills (requires auth login)")
+	command.Flags().StringVar(&options.Output, "output", "table", "output format: table|json")
+
+	return command
+}
+
+// watchRenderer writes watch events; on a terminal in table mode the latest quote line is redrawn in place.
+type watchRenderer struct {
+	writer     io.Writer
+	outputMode string
+	inPlace    bool
+	quoteOpen  bool
+}
+
+func newWatchRenderer(writer io.Writer, outputMode string) *watchRenderer {
+	file, isFile := writer.(*os.File)
+
+	return &watchRenderer{
+		writer:     writer,
+		outputMode: outputMode,
+		inPlace:    outputMode == "table" && isFile && cli.IsTerminalOutput(file),
+	}
+}
+
+func (renderer *watchRenderer) render(event marketservice.WatchEvent) error {
+	if renderer.outputMode == "json" {
+		return encodeJSON(renderer.writer, event)
+	}
+
+	line := formatWatchEvent(event)
+	if !renderer.inPlace {
+		_, err := fmt.Fprintln(renderer.writer, line)
+		return err
+	}
+
+	if event.Type == marketservice.WatchEventQuote {
+		renderer.quoteOpen = true
+		_, err := fmt.Fprint(renderer.writer, "\r\033[K"+line)
+		return err
+	}
+
+	// other events scroll above the quote line, which is redrawn by the next quote
+	prefix := ""
+	if renderer.quoteOpen {
+		prefix = "\r\033[K"
+		renderer.quoteOpen = false
+	}
+	_, err := fmt.Fprintln(renderer.writer, prefix+line)
+
+	return err
+}
+
+// finish terminates an open in-place quote line so the shell prompt starts on a new line.
+func (renderer *watchRenderer) finish() {
+	if renderer.quoteOpen {
+		_, _ = fmt.Fprintln(renderer.writer)
+		renderer.quoteOpen = false
+	}
+}
+
+func formatWatchEvent(event marketservice.WatchEvent) string {
+	prefix := fmt.Sprintf("time=%s market=%s", formatTimestamp(event.Time), event.Market)
+
+	switch {
+	case event.Status != nil && event.Status.Connected:
+		return prefix + " status=connected"
+	case event.Status != nil:
+		return fmt.Sprintf(
+			"%s status=disconnected retry_in=%s reason=%q",
+			prefix,
+			valueOrDash(event.Status.RetryIn),
+			event.Status.Reason,
+		)
+	case event.Quote != nil:
+		return fmt.Sprintf(
+			"%s last_price=%s best_bid=%s best_ask=%s spread=%s",
+			prefix,
+			valueOrDash(event.Quote.LastPrice),
+			valueOrDash(event.Quote.BestBid),
+			valueOrDash(event.Quote.BestAsk),
+			valueOrDash(event.Quote.Spread),
+		)
+	case event.Fill != nil:
+		return fmt.Sprintf(
+			"%s fill order_id=%d client_order_id=%s side=%s price=%s amount=%s filled=%s left=%s fee=%s finished=%t",
+			prefix,
+			event.Fill.OrderID,
+			valueOrDash(event.Fill.ClientOrderID),
+			event.Fill.Side,
+			event.Fill.Price,
+			event.Fill.Amount,
+			valueOrDash(event.Fill.Filled),
+			valueOrDash(event.Fill.Left),
+			valueOrDash(event.Fill.Fee),
+			event.Fill.Finished,
+		)
+	default:
+		return fmt.Sprintf("%s error=%q", prefix, event.Message)
+	}
+}
@@ -0,0 +1,152 @@
+package ordercmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	collateralservice "github.com/ChewX3D/crypto/internal/app/services/collateral"
+	"github.com/spf13/cobra"
+)
+
+type positionsOptions struct {
+	Market string
+	Output string
+}
+
+// NewPositionsCommand constructs the collateral open positions command.
+func NewPositionsCommand(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	options := &positionsOptions{}
+
+	command := &cobra.Command{
+		Use:   "positions",
+		Short: "Show open collateral positions",
+		Long: "Show open collateral positions with base price, liquidation price, unrealized PnL, margin and funding.\n" +
+			"In hedge mode long and short legs of one market are shown as separate rows; amounts are always positive and `side` tells the direction.",
+		Example: `  # all open positions
+  wbcli collateral positions
+
+  # one market, machine-readable
+  wbcli collateral positions --market BTC_PERP --output json`,
+		RunE: func(command *cobra.Command, args []string) error {
+			outputMode, ok := normalizeOutputMode(options.Output)
+			if !ok {
+				return errors.New("--output must be one of: table, json")
+			}
+
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				if application.Collateral == nil {
+					return errors.New("collateral account service is not configured")
+				}
+
+				result, err := application.Collateral.Positions(command.Context(), collateralservice.PositionsRequest{
+					Market: options.Market,
+				})
+				if err != nil {
+					return err
+				}
+
+				return renderPositionsOutput(command.OutOrStdout(), outputMode, result)
+			})
+		},
+	}
+
+	command.Flags().StringVar(&options.Market, "market", "", "whitebit market pair (for example BTC_PERP); empty means all markets")
+	command.Flags().StringVar(&options.Output, "output", "table", "output format: table|json")
+
+	return command
+}
+
+// NewAccountCommand constructs the collateral account summary command.
+func NewAccountCommand(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	var output string
+
+	command := &cobra.Command{
+		Use:   "account",
+		Short: "Show collateral account summary",
+		Long:  "Show collateral leverage, equity, margin usage, unrealized PnL, hedge mode and non-zero balances.",
+		Example: `  wbcli collateral account
+  wbcli collateral account --output json`,
+		RunE: func(command *cobra.Command, args []string) error {
+			outputMode, ok := normalizeOutputMode(output)
+			if !ok {
+				return errors.New("--output must be one of: table, json")
+			}
+
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				if application.Collateral == nil {
+					return errors.New("collateral account service is not configured")
+				}
+
+				result, err := application.Collateral.Account(command.Context())
+				if err != nil {
+					return err
+				}
+
+				return renderAccountOutput(command.OutOrStdout(), outputMode, result)
+			})
+		},
+	}
+
+	command.Flags().StringVar(&output, "output", "table", "output format: table|json")
+
+	return command
+}
+
+func renderPositionsOutput(writer io.Writer, outputMode string, result collateralservice.PositionsResult) error {
+	if outputMode == "json" {
+		return encodeJSON(writer, result)
+	}
+
+	for _, position := range result.Positions {
+		if _, err := fmt.Fprintf(
+			writer,
+			"position_id=%d market=%s side=%s amount=%s base_price=%s liquidation_price=%s unrealized_pnl=%s unrealized_pnl_percent=%s margin=%s funding=%s\n",
+			position.PositionID,
+			position.Market,
+			position.Side,
+			position.Amount,
+			position.BasePrice,
+			position.LiquidationPrice,
+			position.UnrealizedPnL,
+			position.UnrealizedPnLPct,
+			position.Margin,
+			position.Funding,
+		); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(writer, "hedge_mode=%t positions=%d\n", result.HedgeMode, len(result.Positions))
+	return err
+}
+
+func renderAccountOutput(writer io.Writer, outputMode string, result collateralservice.AccountResult) error {
+	if outputMode == "json" {
+		return encodeJSON(writer, result)
+	}
+
+	if _, err := fmt.Fprintf(
+		writer,
+		"hedge_mode=%t leverage=%s equity=%s margin=%s free_margin=%s margin_usage_percent=%s unrealized_pnl=%s unrealized_funding=%s\n",
+		result.HedgeMode,
+		result.Leverage,
+		result.Equity,
+		result.Margin,
+		result.FreeMargin,
+		result.MarginUsagePercent,
+		result.UnrealizedPnL,
+		result.UnrealizedFunding,
+	); err != nil {
+		return err
+	}
+
+	for _, balance := range result.Balances {
+		if _, err := fmt.Fprintf(writer, "asset=%s balance=%s\n", balance.Asset, balance.Amount); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package ordercmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	collateralservice "github.com/ChewX3D/crypto/internal/app/services/collateral"
+	"github.com/spf13/cobra"
+)
+
+type cancelOptions struct {
+	Market              string
+	OrderIDs            []int64
+	ClientOrderIDs      []string
+	ClientOrderIDPrefix string
+	All                 bool
+	File                string
+	Confirm             bool
+	Output              string
+}
+
+func newCancelCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	options := &cancelOptions{}
+
+	command := &cobra.Command{
+		Use:   "cancel",
+		Short: "Cancel collateral orders",
+		Long: "Cancel collateral orders on one market by order id, client order id, client order id prefix, batch file, or all at once.\n" +
+			"--client-order-id-prefix tears down one range run by cancelling every active order whose client order id starts with the prefix.\n" +
+			"--all and --client-order-id-prefix cancel many orders and require --confirm.\n" +
+			"Batch file lines are `order_id=<id>`, `client_order_id=<id>` or a bare numeric order id; blank lines and `#` comments are ignored.",
+		Example: `  # cancel by exchange order id
+  wbcli collateral order cancel --market BTC_PERP --order-id 4180284841
+
+  # cancel by client order id
+  wbcli collateral order cancel --market BTC_PERP --client-order-id run42-3
+
+  # tear down one range run
+  wbcli collateral order cancel --market BTC_PERP --client-order-id-prefix run42- --confirm
+
+  # cancel ids listed in a file
+  wbcli collateral order cancel --market BTC_PERP --file cancel.txt --output json
+
+  # cancel every collateral order on the market
+  wbcli collateral order cancel --market BTC_PERP --all --confirm`,
+		RunE: func(command *cobra.Command, args []string) error {
+			if err := validateRequiredStringFlag("--market", options.Market); err != nil {
+				return err
+			}
+
+			outputMode, ok := normalizeOutputMode(options.Output)
+			if !ok {
+				return errors.New("--output must be one of: table, json")
+			}
+
+			orderIDs := options.OrderIDs
+			clientOrderIDs := options.ClientOrderIDs
+			if strings.TrimSpace(options.File) != "" {
+				fileOrderIDs, fileClientOrderIDs, err := readCancelBatchFile(options.File)
+				if err != nil {
+					return err
+				}
+				orderIDs = append(orderIDs, fileOrderIDs...)
+				clientOrderIDs = append(clientOrderIDs, fileClientOrderIDs...)
+			}
+
+			if (options.All || strings.TrimSpace(options.ClientOrderIDPrefix) != "") && !options.Confirm {
+				return errors.New("--confirm is required with --all or --client-order-id-prefix")
+			}
+
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				if application.Collateral == nil {
+					return errors.New("collateral order service is not configured")
+				}
+
+				result, err := application.Collateral.CancelOrders(command.Context(), collateralservice.CancelOrderRequest{
+					Market:              options.Market,
+					OrderIDs:            orderIDs,
+					ClientOrderIDs:      clientOrderIDs,
+					ClientOrderIDPrefix: options.ClientOrderIDPrefix,
+					All:                 options.All,
+				})
+				if err != nil {
+					return err
+				}
+
+				if err := renderPlaceOutput(command.OutOrStdout(), outputMode, result); err != nil {
+					return err
+				}
+				if result.OrdersFailed > 0 {
+					return fmt.Errorf("%d of %d cancellations failed", result.OrdersFailed, result.OrdersPlanned)
+				}
+
+				return nil
+			})
+		},
+	}
+
+	command.Flags().StringVar(&options.Market, "market", "", "whitebit market pair (for example BTC_PERP)")
+	command.Flags().Int64SliceVar(&options.OrderIDs, "order-id", nil, "exchange order id to cancel (repeatable or comma-separated)")
+	command.Flags().StringSliceVar(&options.ClientOrderIDs, "client-order-id", nil, "client order id to cancel (repeatable or comma-separated)")
+	command.Flags().StringVar(&options.ClientOrderIDPrefix, "client-order-id-prefix", "", "cancel all active orders whose client order id starts with prefix")
+	command.Flags().BoolVar(&options.All, "all", false, "cancel all collateral orders on the market")
+	command.Flags().StringVar(&options.File, "file", "", "batch file with order ids or client order ids, one per line")
+	command.Flags().BoolVar(&options.Confirm, "confirm", false, "confirm mass cancellation")
+	command.Flags().StringVar(&options.Output, "output", "table", "output format: table|json")
+
+	return command
+}
+
+func readCancelBatchFile(path string) ([]int64, []string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open batch file: %w", err)
+	}
+	defer file.Close()
+
+	var (
+		orderIDs       []int64
+		clientOrderIDs []string
+	)
+
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, hasKey := strings.Cut(line, "=")
+		if !hasKey {
+			key, value = "order_id", line
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil, nil, fmt.Errorf("batch file line %d: empty value", lineNumber)
+		}
+
+		switch key {
+		case "order_id":
+			orderID, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || orderID <= 0 {
+				return nil, nil, fmt.Errorf("batch file line %d: invalid order id %q", lineNumber, value)
+			}
+			orderIDs = append(orderIDs, orderID)
+		case "client_order_id":
+			clientOrderIDs = append(clientOrderIDs, value)
+		default:
+			return nil, nil, fmt.Errorf("batch file line %d: unknown key %q", lineNumber, key)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, fmt.Errorf("read batch file: %w", err)
+	}
+
+	return orderIDs, clientOrderIDs, nil
+}
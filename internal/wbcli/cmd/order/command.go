@@ -10,14 +10,17 @@ func NewCommand(getApplication func() (*appcontainer.Application, error)) *cobra
 	orderCmd := &cobra.Command{
 		Use:   "order",
 		Short: "Place and manage orders",
-		Long:  "Place single collateral orders or build/submit range order plans.",
+		Long:  "Place single collateral orders, build/submit range order plans, list active or finished orders, or cancel orders.",
 		RunE: func(command *cobra.Command, args []string) error {
 			return command.Help()
 		},
 	}
 
 	orderCmd.AddCommand(newPlaceCmd(getApplication))
-	orderCmd.AddCommand(newRangeCmd())
+	orderCmd.AddCommand(newRangeCmd(getApplication))
+	orderCmd.AddCommand(newListCmd(getApplication))
+	orderCmd.AddCommand(newHistoryCmd(getApplication))
+	orderCmd.AddCommand(newCancelCmd(getApplication))
 
 	return orderCmd
 }
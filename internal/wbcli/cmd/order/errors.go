@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/ChewX3D/crypto/internal/app/ports"
+	clitools "github.com/ChewX3D/crypto/internal/cli"
 )
 
 func mapError(err error) error {
@@ -24,6 +25,9 @@ func mapError(err error) error {
 	case errors.Is(err, ports.ErrSecretStorePermissionDenied):
 		return errors.New("os-keychain access denied; keychain is locked or access is restricted")
 	}
+	if mapped := clitools.MapPassphraseError(err); mapped != nil {
+		return mapped
+	}
 
 	return err
 }
@@ -0,0 +1,235 @@
+package ordercmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	collateralservice "github.com/ChewX3D/crypto/internal/app/services/collateral"
+	"github.com/spf13/cobra"
+)
+
+const historyTruncatedWarning = "warning: history stopped at the exchange offset limit; narrow --since/--until to fetch older rows"
+
+type historyOptions struct {
+	Market string
+	Since  string
+	Until  string
+	Output string
+}
+
+func newHistoryCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	options := &historyOptions{}
+
+	command := &cobra.Command{
+		Use:   "history",
+		Short: "Show finished collateral orders",
+		Long: "Show executed and cancelled orders through WhiteBIT signed API, oldest first.\n" +
+			"--since is inclusive and --until exclusive; both accept RFC3339, YYYY-MM-DD (UTC) or a duration like 24h meaning that long ago.\n" +
+			"Pages are fetched automatically until the window start; --output jsonl prints one order per line.",
+		Example: `  # last day on one market as JSON lines
+  wbcli collateral order history --market BTC_PERP --since 24h --output jsonl
+
+  # fixed window
+  wbcli collateral order history --market BTC_PERP --since 2026-03-01 --until 2026-03-02`,
+		RunE: func(command *cobra.Command, args []string) error {
+			request, outputMode, err := buildHistoryRequest(options)
+			if err != nil {
+				return err
+			}
+
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				if application.Collateral == nil {
+					return errors.New("collateral order service is not configured")
+				}
+
+				result, err := application.Collateral.OrderHistory(command.Context(), request)
+				if err != nil {
+					return err
+				}
+				if result.Truncated {
+					if _, err := fmt.Fprintln(command.ErrOrStderr(), historyTruncatedWarning); err != nil {
+						return err
+					}
+				}
+
+				return renderHistoryOutput(command.OutOrStdout(), outputMode, result)
+			})
+		},
+	}
+
+	addHistoryFlags(command, options)
+
+	return command
+}
+
+// NewTradesCommand constructs the collateral trades (fills) command.
+func NewTradesCommand(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	options := &historyOptions{}
+
+	command := &cobra.Command{
+		Use:   "trades",
+		Short: "Show collateral trade fills",
+		Long: "Show individual fills through WhiteBIT signed API, oldest first.\n" +
+			"--since is inclusive and --until exclusive; both accept RFC3339, YYYY-MM-DD (UTC) or a duration like 24h meaning that long ago.\n" +
+			"Pages are fetched automatically until the window start; --output jsonl prints one fill per line.",
+		Example: `  # fills of the last week as JSON lines
+  wbcli collateral trades --market BTC_PERP --since 168h --output jsonl > fills.jsonl`,
+		RunE: func(command *cobra.Command, args []string) error {
+			request, outputMode, err := buildHistoryRequest(options)
+			if err != nil {
+				return err
+			}
+
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				if application.Collateral == nil {
+					return errors.New("collateral order service is not configured")
+				}
+
+				result, err := application.Collateral.Trades(command.Context(), request)
+				if err != nil {
+					return err
+				}
+				if result.Truncated {
+					if _, err := fmt.Fprintln(command.ErrOrStderr(), historyTruncatedWarning); err != nil {
+						return err
+					}
+				}
+
+				return renderTradesOutput(command.OutOrStdout(), outputMode, result)
+			})
+		},
+	}
+
+	addHistoryFlags(command, options)
+
+	return command
+}
+
+func addHistoryFlags(command *cobra.Command, options *historyOptions) {
+	command.Flags().StringVar(&options.Market, "market", "", "whitebit market pair (for example BTC_PERP); empty means all markets")
+	command.Flags().StringVar(&options.Since, "since", "", "window start (inclusive): RFC3339, YYYY-MM-DD or duration ago")
+	command.Flags().StringVar(&options.Until, "until", "", "window end (exclusive): RFC3339, YYYY-MM-DD or duration ago")
+	command.Flags().StringVar(&options.Output, "output", "table", "output format: table|json|jsonl")
+}
+
+func buildHistoryRequest(options *historyOptions) (collateralservice.HistoryRequest, string, error) {
+	outputMode, ok := normalizeStreamOutputMode(options.Output)
+	if !ok {
+		return collateralservice.HistoryRequest{}, "", errors.New("--output must be one of: table, json, jsonl")
+	}
+
+	now := time.Now()
+	since, err := parseTimeFlag("--since", options.Since, now)
+	if err != nil {
+		return collateralservice.HistoryRequest{}, "", err
+	}
+	until, err := parseTimeFlag("--until", options.Until, now)
+	if err != nil {
+		return collateralservice.HistoryRequest{}, "", err
+	}
+	if !since.IsZero() && !until.IsZero() && !until.After(since) {
+		return collateralservice.HistoryRequest{}, "", errors.New("--until must be after --since")
+	}
+
+	return collateralservice.HistoryRequest{
+		Market: options.Market,
+		Since:  since,
+		Until:  until,
+	}, outputMode, nil
+}
+
+func renderHistoryOutput(writer io.Writer, outputMode string, result collateralservice.OrderHistoryResult) error {
+	switch outputMode {
+	case "json":
+		return encodeJSON(writer, result)
+	case "jsonl":
+		for _, order := range result.Orders {
+			if err := encodeJSON(writer, order); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for _, order := range result.Orders {
+		if _, err := fmt.Fprintf(
+			writer,
+			"order_id=%d market=%s side=%s status=%s price=%s amount=%s deal_stock=%s deal_money=%s deal_fee=%s client_order_id=%s finished_at=%s\n",
+			order.OrderID,
+			order.Market,
+			order.Side,
+			order.Status,
+			order.Price,
+			order.Amount,
+			order.DealStock,
+			order.DealMoney,
+			order.DealFee,
+			order.ClientOrderID,
+			formatTimestamp(order.FinishedAt),
+		); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(writer, "orders=%d truncated=%t\n", len(result.Orders), result.Truncated)
+	return err
+}
+
+func renderTradesOutput(writer io.Writer, outputMode string, result collateralservice.TradesResult) error {
+	switch outputMode {
+	case "json":
+		return encodeJSON(writer, result)
+	case "jsonl":
+		for _, trade := range result.Trades {
+			if err := encodeJSON(writer, trade); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for _, trade := range result.Trades {
+		if _, err := fmt.Fprintf(
+			writer,
+			"trade_id=%d order_id=%d market=%s side=%s role=%s price=%s amount=%s deal=%s fee=%s fee_asset=%s client_order_id=%s executed_at=%s\n",
+			trade.TradeID,
+			trade.OrderID,
+			trade.Market,
+			trade.Side,
+			trade.Role,
+			trade.Price,
+			trade.Amount,
+			trade.Deal,
+			trade.Fee,
+			trade.FeeAsset,
+			trade.ClientOrderID,
+			formatTimestamp(trade.ExecutedAt),
+		); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(writer, "trades=%d truncated=%t\n", len(result.Trades), result.Truncated)
+	return err
+}
+
+func encodeJSON(writer io.Writer, value any) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetEscapeHTML(false)
+
+	return encoder.Encode(value)
+}
+
+func formatTimestamp(value time.Time) string {
+	if value.IsZero() {
+		return ""
+	}
+
+	return value.Format(time.RFC3339)
+}
@@ -0,0 +1,106 @@
+package ordercmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	collateralservice "github.com/ChewX3D/crypto/internal/app/services/collateral"
+	"github.com/spf13/cobra"
+)
+
+type listOptions struct {
+	baseOptions
+	ClientOrderIDPrefix string
+	Output              string
+}
+
+func newListCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	options := &listOptions{}
+
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List active collateral orders",
+		Long: "List active (unexecuted) orders through WhiteBIT signed API, oldest first.\n" +
+			"Filters are optional and combined: --market, --side (`buy`, `sell`, `long`, `short`) and --client-order-id-prefix.\n" +
+			"JSON output uses the same field names as range plan orders, so the live book can be diffed against a plan.",
+		Example: `  # all active orders on one market
+  wbcli collateral order list --market BTC_PERP
+
+  # orders of one range run
+  wbcli collateral order list --market BTC_PERP --client-order-id-prefix run42-
+
+  # buy side only, machine-readable
+  wbcli collateral order list --market BTC_PERP --side long --output json`,
+		RunE: func(command *cobra.Command, args []string) error {
+			side := ""
+			if options.Side != "" {
+				normalized, ok := normalizeSideAlias(options.Side)
+				if !ok {
+					return errors.New("--side must be one of: buy, long, sell, short")
+				}
+				side = normalized
+			}
+
+			outputMode, ok := normalizeOutputMode(options.Output)
+			if !ok {
+				return errors.New("--output must be one of: table, json")
+			}
+
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				if application.Collateral == nil {
+					return errors.New("collateral order service is not configured")
+				}
+
+				result, err := application.Collateral.ListOrders(command.Context(), collateralservice.ListOrdersRequest{
+					Market:              options.Market,
+					Side:                side,
+					ClientOrderIDPrefix: options.ClientOrderIDPrefix,
+				})
+				if err != nil {
+					return err
+				}
+
+				return renderListOutput(command.OutOrStdout(), outputMode, result)
+			})
+		},
+	}
+
+	addBaseFlags(command, &options.baseOptions)
+	command.Flags().StringVar(&options.ClientOrderIDPrefix, "client-order-id-prefix", "", "only orders whose client order id starts with prefix")
+	command.Flags().StringVar(&options.Output, "output", "table", "output format: table|json")
+
+	return command
+}
+
+func renderListOutput(writer io.Writer, outputMode string, result collateralservice.ListOrdersResult) error {
+	if outputMode == "json" {
+		encoder := json.NewEncoder(writer)
+		encoder.SetEscapeHTML(false)
+
+		return encoder.Encode(result)
+	}
+
+	for _, order := range result.Orders {
+		if _, err := fmt.Fprintf(
+			writer,
+			"order_id=%d market=%s side=%s position_side=%s price=%s amount=%s left=%s client_order_id=%s created_at=%s\n",
+			order.OrderID,
+			order.Market,
+			order.Side,
+			order.PositionSide,
+			order.Price,
+			order.Amount,
+			order.Left,
+			order.ClientOrderID,
+			formatTimestamp(order.CreatedAt),
+		); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintf(writer, "orders=%d\n", len(result.Orders))
+	return err
+}
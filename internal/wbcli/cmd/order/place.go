@@ -8,16 +8,23 @@ import (
 	"strings"
 
 	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	"github.com/ChewX3D/crypto/internal/app/ports"
 	collateralservice "github.com/ChewX3D/crypto/internal/app/services/collateral"
 	"github.com/spf13/cobra"
 )
 
 type placeOptions struct {
 	baseOptions
-	Amount        string
-	Price         string
-	ClientOrderID string
-	Output        string
+	Type            string
+	Amount          string
+	Price           string
+	ActivationPrice string
+	ClientOrderID   string
+	StopLoss        string
+	TakeProfit      string
+	Output          string
+	SnapToMarket    bool
+	AllowTaker      bool
 }
 
 func newPlaceCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
@@ -25,10 +32,16 @@ func newPlaceCmd(getApplication func() (*appcontainer.Application, error)) *cobr
 
 	command := &cobra.Command{
 		Use:   "place",
-		Short: "Place a single collateral limit order",
-		Long: "Place one collateral limit order through WhiteBIT signed API using current single-session credentials.\n" +
+		Short: "Place a single collateral order",
+		Long: "Place one collateral order through WhiteBIT signed API using current single-session credentials.\n" +
 			"Supported side values are `buy`, `sell`, `long`, `short`.\n" +
-			"Order submission always enforces `postOnly=true`.",
+			"Supported --type values are `limit` (default), `market`, `stop-market` and `stop-limit`.\n" +
+			"Limit orders enforce `postOnly=true` unless --allow-taker is set; market, stop-market and stop-limit\n" +
+			"orders may take liquidity and are rejected without --allow-taker.\n" +
+			"--price is required for limit and stop-limit; --activation-price is required for stop-market and stop-limit.\n" +
+			"Price and amount are checked against market precision, tick size, minimum amount and minimum total before signing;\n" +
+			"use --snap-to-market to truncate the amount and move the price to the nearest tick away from the book instead of failing.\n" +
+			"--stop-loss and --take-profit attach protective prices: below/above price for buy/long, above/below for sell/short.",
 		Example: `  # canonical side value
   wbcli collateral order place --market BTC_PERP --side buy --amount 0.01 --price 50000
 
@@ -38,18 +51,64 @@ func newPlaceCmd(getApplication func() (*appcontainer.Application, error)) *cobr
   # with client order id pass-through
   wbcli collateral order place --market BTC_PERP --side long --amount 0.01 --price 49950 --client-order-id bot-001
 
+  # with attached stop-loss and take-profit
+  wbcli collateral order place --market BTC_PERP --side long --amount 0.01 --price 50000 --stop-loss 48500 --take-profit 53000
+
+  # taker market order to lock a hedge
+  wbcli collateral order place --market BTC_PERP --side short --type market --amount 0.01 --allow-taker
+
+  # stop-limit: when price reaches 49000, place a sell limit at 48900
+  wbcli collateral order place --market BTC_PERP --side sell --type stop-limit --amount 0.01 --price 48900 --activation-price 49000 --allow-taker
+
   # machine-readable output
   wbcli collateral order place --market BTC_PERP --side sell --amount 0.03 --price 52000 --output json`,
 		RunE: func(command *cobra.Command, args []string) error {
 			if err := validateBase(options.baseOptions); err != nil {
 				return err
 			}
+			orderType, ok := normalizeOrderType(options.Type)
+			if !ok {
+				return errors.New("--type must be one of: limit, market, stop-market, stop-limit")
+			}
+			if orderType != collateralservice.OrderTypeLimit && !options.AllowTaker {
+				return fmt.Errorf("--type %s may take liquidity; pass --allow-taker to confirm", orderType)
+			}
 			if err := validateRequiredStringFlag("--amount", options.Amount); err != nil {
 				return err
 			}
-			if err := validateRequiredStringFlag("--price", options.Price); err != nil {
+			if _, err := parsePositiveDecimalFlag("--amount", options.Amount); err != nil {
 				return err
 			}
+			if orderType == collateralservice.OrderTypeLimit || orderType == ports.CollateralOrderTypeStopLimit {
+				if err := validateRequiredStringFlag("--price", options.Price); err != nil {
+					return err
+				}
+				if _, err := parsePositiveDecimalFlag("--price", options.Price); err != nil {
+					return err
+				}
+			} else if options.Price != "" {
+				return fmt.Errorf("--price is not used by --type %s", orderType)
+			}
+			if orderType == ports.CollateralOrderTypeStopMarket || orderType == ports.CollateralOrderTypeStopLimit {
+				if err := validateRequiredStringFlag("--activation-price", options.ActivationPrice); err != nil {
+					return err
+				}
+				if _, err := parsePositiveDecimalFlag("--activation-price", options.ActivationPrice); err != nil {
+					return err
+				}
+			} else if options.ActivationPrice != "" {
+				return fmt.Errorf("--activation-price is not used by --type %s", orderType)
+			}
+			if options.StopLoss != "" {
+				if _, err := parsePositiveDecimalFlag("--stop-loss", options.StopLoss); err != nil {
+					return err
+				}
+			}
+			if options.TakeProfit != "" {
+				if _, err := parsePositiveDecimalFlag("--take-profit", options.TakeProfit); err != nil {
+					return err
+				}
+			}
 
 			side, ok := normalizeSideAlias(options.Side)
 			if !ok {
@@ -67,11 +126,17 @@ func newPlaceCmd(getApplication func() (*appcontainer.Application, error)) *cobr
 				}
 
 				result, err := application.Collateral.PlaceOrder(command.Context(), collateralservice.PlaceOrderRequest{
-					Market:        options.Market,
-					Side:          side,
-					Amount:        options.Amount,
-					Price:         options.Price,
-					ClientOrderID: options.ClientOrderID,
+					Market:          options.Market,
+					Side:            side,
+					Type:            orderType,
+					Amount:          options.Amount,
+					Price:           options.Price,
+					ActivationPrice: options.ActivationPrice,
+					ClientOrderID:   options.ClientOrderID,
+					StopLoss:        options.StopLoss,
+					TakeProfit:      options.TakeProfit,
+					SnapToMarket:    options.SnapToMarket,
+					AllowTaker:      options.AllowTaker,
 				})
 				if err != nil {
 					return err
@@ -83,10 +148,16 @@ func newPlaceCmd(getApplication func() (*appcontainer.Application, error)) *cobr
 	}
 
 	addBaseFlags(command, &options.baseOptions)
+	command.Flags().StringVar(&options.Type, "type", "limit", "order type: limit|market|stop-market|stop-limit")
 	command.Flags().StringVar(&options.Amount, "amount", "", "order amount as string accepted by WhiteBIT")
 	command.Flags().StringVar(&options.Price, "price", "", "limit price as string accepted by WhiteBIT")
+	command.Flags().StringVar(&options.ActivationPrice, "activation-price", "", "stop order trigger price")
 	command.Flags().StringVar(&options.ClientOrderID, "client-order-id", "", "client order id (pass-through)")
+	command.Flags().StringVar(&options.StopLoss, "stop-loss", "", "attached stop-loss price")
+	command.Flags().StringVar(&options.TakeProfit, "take-profit", "", "attached take-profit price")
 	command.Flags().StringVar(&options.Output, "output", "table", "output format: table|json")
+	command.Flags().BoolVar(&options.SnapToMarket, "snap-to-market", false, "snap price and amount to market precision instead of rejecting")
+	command.Flags().BoolVar(&options.AllowTaker, "allow-taker", false, "allow orders that may take liquidity (market, stop orders, non-post-only limit)")
 
 	return command
 }
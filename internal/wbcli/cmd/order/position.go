@@ -0,0 +1,164 @@
+package ordercmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	collateralservice "github.com/ChewX3D/crypto/internal/app/services/collateral"
+	"github.com/spf13/cobra"
+)
+
+type closePositionOptions struct {
+	Market        string
+	PositionSide  string
+	Amount        string
+	Percent       string
+	Price         string
+	ClientOrderID string
+	Output        string
+	AllowTaker    bool
+	Confirm       bool
+	SnapToMarket  bool
+}
+
+// NewPositionCommand constructs the collateral position command group.
+func NewPositionCommand(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "position",
+		Short: "Manage one open collateral position",
+		RunE: func(command *cobra.Command, args []string) error {
+			return command.Help()
+		},
+	}
+
+	command.AddCommand(newClosePositionCmd(getApplication))
+
+	return command
+}
+
+func newClosePositionCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	options := &closePositionOptions{}
+
+	command := &cobra.Command{
+		Use:   "close",
+		Short: "Close all or part of an open position",
+		Long: "Close an open collateral position leg with one reducing order: sell for long, buy for short.\n" +
+			"In hedge mode the order keeps the leg's position side so only that leg is reduced.\n" +
+			"Without --amount or --percent the whole leg is closed; --percent is truncated to market amount precision.\n" +
+			"By default the close is a post-only limit at --price; --allow-taker --confirm closes at market instead.\n" +
+			"The result reports filled and remaining amount, exit price and realized PnL before fees.\n" +
+			"Maker closes realize nothing yet: they report the order id and expected PnL once the order fills at its limit price.",
+		Example: `  # maker close of half the long leg
+  wbcli collateral position close --market BTC_PERP --position-side long --percent 50 --price 60500
+
+  # flatten the short leg at market
+  wbcli collateral position close --market BTC_PERP --position-side short --allow-taker --confirm
+
+  # close a fixed amount, machine-readable
+  wbcli collateral position close --market BTC_PERP --position-side long --amount 0.01 --price 60500 --output json`,
+		RunE: func(command *cobra.Command, args []string) error {
+			if err := validateRequiredStringFlag("--market", options.Market); err != nil {
+				return err
+			}
+			positionSide := strings.ToLower(strings.TrimSpace(options.PositionSide))
+			if positionSide != "long" && positionSide != "short" {
+				return errors.New("--position-side must be one of: long, short")
+			}
+			if options.Amount != "" && options.Percent != "" {
+				return errors.New("--amount and --percent are mutually exclusive")
+			}
+			if options.Amount != "" {
+				if _, err := parsePositiveDecimalFlag("--amount", options.Amount); err != nil {
+					return err
+				}
+			}
+			if options.Percent != "" {
+				if _, err := parsePositiveDecimalFlag("--percent", options.Percent); err != nil {
+					return err
+				}
+			}
+			if options.AllowTaker && options.Price != "" {
+				return errors.New("--price is not used with --allow-taker; taker closes are market orders")
+			}
+			if options.AllowTaker && !options.Confirm {
+				return errors.New("--confirm is required with --allow-taker; a taker close sends a market order")
+			}
+			if !options.AllowTaker {
+				if err := validateRequiredStringFlag("--price", options.Price); err != nil {
+					return fmt.Errorf("%w (or pass --allow-taker to close at market)", err)
+				}
+				if _, err := parsePositiveDecimalFlag("--price", options.Price); err != nil {
+					return err
+				}
+			}
+
+			outputMode, ok := normalizeOutputMode(options.Output)
+			if !ok {
+				return errors.New("--output must be one of: table, json")
+			}
+
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				if application.Collateral == nil {
+					return errors.New("collateral order service is not configured")
+				}
+
+				result, err := application.Collateral.ClosePosition(command.Context(), collateralservice.ClosePositionRequest{
+					Market:        options.Market,
+					PositionSide:  positionSide,
+					Amount:        options.Amount,
+					Percent:       options.Percent,
+					Price:         options.Price,
+					ClientOrderID: options.ClientOrderID,
+					AllowTaker:    options.AllowTaker,
+					SnapToMarket:  options.SnapToMarket,
+				})
+				if err != nil {
+					return err
+				}
+
+				return renderClosePositionOutput(command.OutOrStdout(), outputMode, result)
+			})
+		},
+	}
+
+	command.Flags().StringVar(&options.Market, "market", "", "whitebit market pair (for example BTC_PERP)")
+	command.Flags().StringVar(&options.PositionSide, "position-side", "", "position leg to close: long|short")
+	command.Flags().StringVar(&options.Amount, "amount", "", "amount to close; default is the whole leg")
+	command.Flags().StringVar(&options.Percent, "percent", "", "share of the leg to close, in percent")
+	command.Flags().StringVar(&options.Price, "price", "", "limit price for maker close")
+	command.Flags().StringVar(&options.ClientOrderID, "client-order-id", "", "client order id (pass-through)")
+	command.Flags().StringVar(&options.Output, "output", "table", "output format: table|json")
+	command.Flags().BoolVar(&options.AllowTaker, "allow-taker", false, "close with a market order that takes liquidity (requires --confirm)")
+	command.Flags().BoolVar(&options.Confirm, "confirm", false, "confirm a taker close at market")
+	command.Flags().BoolVar(&options.SnapToMarket, "snap-to-market", false, "snap price and amount to market precision instead of rejecting")
+
+	return command
+}
+
+func renderClosePositionOutput(writer io.Writer, outputMode string, result collateralservice.ClosePositionResult) error {
+	if outputMode == "json" {
+		return encodeJSON(writer, result)
+	}
+
+	_, err := fmt.Fprintf(
+		writer,
+		"market=%s position_side=%s order_side=%s order_type=%s order_id=%d status=%s close_amount=%s filled_amount=%s remaining_amount=%s base_price=%s exit_price=%s realized_pnl=%s expected_pnl=%s\n",
+		result.Market,
+		result.PositionSide,
+		result.OrderSide,
+		result.OrderType,
+		result.OrderID,
+		result.Status,
+		result.CloseAmount,
+		result.FilledAmount,
+		result.RemainingAmount,
+		result.BasePrice,
+		valueOrDash(result.ExitPrice),
+		valueOrDash(result.RealizedPnL),
+		valueOrDash(result.ExpectedPnL),
+	)
+	return err
+}
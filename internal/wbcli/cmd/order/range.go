@@ -1,86 +1,275 @@
 package ordercmd
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 
+	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	collateralservice "github.com/ChewX3D/crypto/internal/app/services/collateral"
 	"github.com/spf13/cobra"
 )
 
 type rangeOptions struct {
 	baseOptions
-	StartPrice      float64
-	EndPrice        float64
-	Step            float64
-	AmountMode      string
-	BaseAmount      float64
-	StartMultiplier float64
-	StepMultiplier  float64
-	Ratio           float64
-	MaxMultiplier   float64
-	DryRun          bool
-	Confirm         bool
+	StartPrice          string
+	EndPrice            string
+	Step                string
+	AmountMode          string
+	BaseAmount          string
+	StartMultiplier     string
+	StepMultiplier      string
+	Ratio               string
+	MaxMultiplier       string
+	Multipliers         []string
+	MaxOrders           int
+	ClientOrderIDPrefix string
+	StopLossOffset      string
+	TakeProfitOffset    string
+	Output              string
+	StopOnFail          bool
+	SnapToMarket        bool
+	DryRun              bool
+	Confirm             bool
 }
 
-func newRangeCmd() *cobra.Command {
+func newRangeCmd(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
 	options := &rangeOptions{}
 
 	command := &cobra.Command{
 		Use:   "range",
 		Short: "Build or submit a range order plan",
+		Long: "Build a deterministic ladder of collateral post-only limit orders from start price to end price.\n" +
+			"Prices move by --step in the direction of --end-price; amounts follow --amount-mode.\n" +
+			"Use --dry-run to preview the plan with aggregate totals, or --confirm to submit it through the collateral bulk endpoint\n" +
+			"in chunks of up to 20 orders. Every order is reported as accepted, rejected, skipped or unknown;\n" +
+			"unknown orders were in a chunk that failed in transport and may have landed, so check them with order list.\n" +
+			"Every order is checked against market precision, tick size, minimum amount and minimum total before signing;\n" +
+			"use --snap-to-market to snap off-tick prices and over-precise amounts instead of failing.\n" +
+			"--stop-loss-offset and --take-profit-offset attach SL/TP to every level at that price distance from the level price.",
+		Example: `  # constant ladder preview
+  wbcli collateral order range --market BTC_PERP --side buy --start-price 49000 --end-price 50000 --step 50 --amount-mode constant --base-amount 0.005 --dry-run
+
+  # bounded geometric sizing
+  wbcli collateral order range --market BTC_PERP --side long --start-price 50000 --end-price 48000 --step 500 --amount-mode capped-geometric --ratio 2 --max-multiplier 4 --base-amount 0.002 --dry-run
+
+  # live submission, stop at the first rejected order
+  wbcli collateral order range --market BTC_PERP --side buy --start-price 49000 --end-price 50000 --step 50 --amount-mode constant --base-amount 0.005 --client-order-id-prefix run42 --confirm --stop-on-fail
+
+  # per-level stop-loss 500 below and take-profit 1000 above each buy level
+  wbcli collateral order range --market BTC_PERP --side buy --start-price 49000 --end-price 50000 --step 250 --amount-mode constant --base-amount 0.005 --stop-loss-offset 500 --take-profit-offset 1000 --dry-run
+
+  # explicit multipliers with client order id prefix
+  wbcli collateral order range --market BTC_PERP --side sell --start-price 52000 --end-price 52400 --step 100 --amount-mode custom-list --multipliers 1,1.5,2,2.5,3 --base-amount 0.001 --client-order-id-prefix run42 --dry-run --output json`,
 		RunE: func(command *cobra.Command, args []string) error {
 			if err := validateBase(options.baseOptions); err != nil {
 				return err
 			}
-			if err := validatePositiveFloatFlag("--start-price", options.StartPrice); err != nil {
+			startPrice, err := parsePositiveDecimalFlag("--start-price", options.StartPrice)
+			if err != nil {
+				return err
+			}
+			endPrice, err := parsePositiveDecimalFlag("--end-price", options.EndPrice)
+			if err != nil {
+				return err
+			}
+			step, err := parsePositiveDecimalFlag("--step", options.Step)
+			if err != nil {
+				return err
+			}
+			baseAmount, err := parsePositiveDecimalFlag("--base-amount", options.BaseAmount)
+			if err != nil {
+				return err
+			}
+			startMultiplier, err := parseDecimalFlag("--start-multiplier", options.StartMultiplier)
+			if err != nil {
+				return err
+			}
+			stepMultiplier, err := parseDecimalFlag("--step-multiplier", options.StepMultiplier)
+			if err != nil {
 				return err
 			}
-			if err := validatePositiveFloatFlag("--end-price", options.EndPrice); err != nil {
+			ratio, err := parseDecimalFlag("--ratio", options.Ratio)
+			if err != nil {
 				return err
 			}
-			if err := validatePositiveFloatFlag("--step", options.Step); err != nil {
+			maxMultiplier, err := parseDecimalFlag("--max-multiplier", options.MaxMultiplier)
+			if err != nil {
 				return err
 			}
-			if err := validatePositiveFloatFlag("--base-amount", options.BaseAmount); err != nil {
+			multipliers, err := parseDecimalListFlag("--multipliers", options.Multipliers)
+			if err != nil {
+				return err
+			}
+			stopLossOffset, err := parseOptionalOffsetFlag("--stop-loss-offset", options.StopLossOffset)
+			if err != nil {
+				return err
+			}
+			takeProfitOffset, err := parseOptionalOffsetFlag("--take-profit-offset", options.TakeProfitOffset)
+			if err != nil {
 				return err
 			}
 			if err := validateAmountMode(options.AmountMode); err != nil {
 				return err
 			}
+			if err := validatePositiveIntFlag("--max-orders", options.MaxOrders); err != nil {
+				return err
+			}
 
-			_, err := fmt.Fprintf(
-				command.OutOrStdout(),
-				"wbcli collateral order range is not implemented yet (market=%s side=%s start=%g end=%g step=%g amount-mode=%s base-amount=%g dry-run=%t confirm=%t)\n",
-				options.Market,
-				options.Side,
-				options.StartPrice,
-				options.EndPrice,
-				options.Step,
-				options.AmountMode,
-				options.BaseAmount,
-				options.DryRun,
-				options.Confirm,
-			)
-			return err
+			side, ok := normalizeSideAlias(options.Side)
+			if !ok {
+				return errors.New("--side must be one of: buy, long, sell, short")
+			}
+
+			outputMode, ok := normalizeOutputMode(options.Output)
+			if !ok {
+				return errors.New("--output must be one of: table, json")
+			}
+
+			if !options.DryRun && !options.Confirm {
+				return errors.New("--dry-run or --confirm is required")
+			}
+
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				if application.Collateral == nil {
+					return errors.New("collateral order service is not configured")
+				}
+
+				planRequest := collateralservice.RangePlanRequest{
+					Market:              options.Market,
+					Side:                side,
+					StartPrice:          startPrice,
+					EndPrice:            endPrice,
+					Step:                step,
+					AmountMode:          options.AmountMode,
+					BaseAmount:          baseAmount,
+					StartMultiplier:     startMultiplier,
+					StepMultiplier:      stepMultiplier,
+					Ratio:               ratio,
+					MaxMultiplier:       maxMultiplier,
+					Multipliers:         multipliers,
+					MaxOrders:           options.MaxOrders,
+					ClientOrderIDPrefix: options.ClientOrderIDPrefix,
+					SnapToMarket:        options.SnapToMarket,
+					StopLossOffset:      stopLossOffset,
+					TakeProfitOffset:    takeProfitOffset,
+				}
+
+				var (
+					result collateralservice.RangePlanResult
+					err    error
+				)
+				if options.DryRun {
+					result, err = application.Collateral.PlanRange(command.Context(), planRequest)
+				} else {
+					result, err = application.Collateral.SubmitRange(command.Context(), collateralservice.RangeSubmitRequest{
+						RangePlanRequest: planRequest,
+						StopOnFail:       options.StopOnFail,
+					})
+				}
+				if err != nil {
+					return err
+				}
+
+				return renderRangeOutput(command.OutOrStdout(), outputMode, result)
+			})
 		},
 	}
 
 	addBaseFlags(command, &options.baseOptions)
-	command.Flags().Float64Var(&options.StartPrice, "start-price", 0, "range start price")
-	command.Flags().Float64Var(&options.EndPrice, "end-price", 0, "range end price")
-	command.Flags().Float64Var(&options.Step, "step", 0, "price step")
+	command.Flags().StringVar(&options.StartPrice, "start-price", "", "range start price")
+	command.Flags().StringVar(&options.EndPrice, "end-price", "", "range end price")
+	command.Flags().StringVar(&options.Step, "step", "", "price step")
 	command.Flags().StringVar(
 		&options.AmountMode,
 		"amount-mode",
 		"constant",
 		"Amount sizing strategy per generated order (i is zero-based): constant=base-amount; arithmetic=base-amount*(start-multiplier+i*step-multiplier); geometric=base-amount*ratio^i; capped-geometric=min(base-amount*ratio^i, base-amount*max-multiplier); fibonacci=base-amount*fib(i+1); custom-list=explicit multipliers list.",
 	)
-	command.Flags().Float64Var(&options.BaseAmount, "base-amount", 0, "base amount per order")
-	command.Flags().Float64Var(&options.StartMultiplier, "start-multiplier", 1, "arithmetic start multiplier")
-	command.Flags().Float64Var(&options.StepMultiplier, "step-multiplier", 1, "arithmetic step multiplier")
-	command.Flags().Float64Var(&options.Ratio, "ratio", 2, "geometric ratio")
-	command.Flags().Float64Var(&options.MaxMultiplier, "max-multiplier", 0, "cap for capped-geometric mode")
+	command.Flags().StringVar(&options.BaseAmount, "base-amount", "", "base amount per order")
+	command.Flags().StringVar(&options.StartMultiplier, "start-multiplier", "1", "arithmetic start multiplier")
+	command.Flags().StringVar(&options.StepMultiplier, "step-multiplier", "1", "arithmetic step multiplier")
+	command.Flags().StringVar(&options.Ratio, "ratio", "2", "geometric ratio")
+	command.Flags().StringVar(&options.MaxMultiplier, "max-multiplier", "0", "cap for capped-geometric mode")
+	command.Flags().StringSliceVar(&options.Multipliers, "multipliers", nil, "comma-separated multipliers for custom-list mode (one per planned order)")
+	command.Flags().IntVar(&options.MaxOrders, "max-orders", collateralservice.DefaultRangeMaxOrders, "hard cap for number of generated orders")
+	command.Flags().StringVar(&options.ClientOrderIDPrefix, "client-order-id-prefix", "", "client order id prefix; orders get <prefix>-<index>")
+	command.Flags().StringVar(&options.StopLossOffset, "stop-loss-offset", "", "attach stop-loss at this price distance from every level (away from profit)")
+	command.Flags().StringVar(&options.TakeProfitOffset, "take-profit-offset", "", "attach take-profit at this price distance from every level (towards profit)")
+	command.Flags().StringVar(&options.Output, "output", "table", "output format: table|json")
+	command.Flags().BoolVar(&options.SnapToMarket, "snap-to-market", false, "snap prices and amounts to market precision instead of rejecting")
+	command.Flags().BoolVar(&options.StopOnFail, "stop-on-fail", false, "stop submitting remaining orders after the first rejection")
 	command.Flags().BoolVar(&options.DryRun, "dry-run", false, "preview plan without submitting orders")
 	command.Flags().BoolVar(&options.Confirm, "confirm", false, "confirm live batch placement")
 
 	return command
 }
+
+func renderRangeOutput(writer io.Writer, outputMode string, result collateralservice.RangePlanResult) error {
+	if outputMode == "json" {
+		encoder := json.NewEncoder(writer)
+		encoder.SetEscapeHTML(false)
+
+		return encoder.Encode(result)
+	}
+
+	if err := renderPlaceOutput(writer, outputMode, result.PlaceOrderResult); err != nil {
+		return err
+	}
+
+	for _, order := range result.Orders {
+		if _, err := fmt.Fprintf(
+			writer,
+			"index=%d side=%s position_side=%s price=%s amount=%s client_order_id=%s",
+			order.Index,
+			order.Side,
+			valueOrDash(order.PositionSide),
+			order.Price,
+			order.Amount,
+			valueOrDash(order.ClientOrderID),
+		); err != nil {
+			return err
+		}
+		if order.StopLoss != "" || order.TakeProfit != "" {
+			if _, err := fmt.Fprintf(writer, " stop_loss=%s take_profit=%s", valueOrDash(order.StopLoss), valueOrDash(order.TakeProfit)); err != nil {
+				return err
+			}
+		}
+		if order.Status != "" {
+			if _, err := fmt.Fprintf(writer, " status=%s order_id=%d error=%q", order.Status, order.OrderID, order.Error); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintln(writer); err != nil {
+			return err
+		}
+	}
+
+	if _, err := fmt.Fprintf(
+		writer,
+		"market=%s amount_mode=%s total_amount=%s total_notional=%s",
+		result.Market,
+		result.AmountMode,
+		result.TotalAmount,
+		result.TotalNotional,
+	); err != nil {
+		return err
+	}
+	if result.OrdersUnknown > 0 {
+		if _, err := fmt.Fprintf(writer, " orders_unknown=%d", result.OrdersUnknown); err != nil {
+			return err
+		}
+	}
+
+	_, err := fmt.Fprintln(writer)
+	return err
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+
+	return value
+}
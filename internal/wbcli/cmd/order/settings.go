@@ -0,0 +1,159 @@
+package ordercmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	appcontainer "github.com/ChewX3D/crypto/internal/app/application"
+	collateralservice "github.com/ChewX3D/crypto/internal/app/services/collateral"
+	"github.com/spf13/cobra"
+)
+
+// NewLeverageCommand constructs the collateral leverage command group.
+func NewLeverageCommand(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "leverage",
+		Short: "Show or change collateral account leverage",
+		Long:  "Show or change account-wide collateral leverage. Supported values are 1, 2, 3, 5, 10, 20, 50 and 100.",
+		RunE: func(command *cobra.Command, args []string) error {
+			return command.Help()
+		},
+	}
+
+	command.AddCommand(newSettingCmd(
+		"get",
+		"Show current leverage",
+		cobra.NoArgs,
+		func(command *cobra.Command, application *appcontainer.Application, _ []string) (any, error) {
+			return application.Collateral.GetLeverage(command.Context())
+		},
+		getApplication,
+	))
+	command.AddCommand(newSettingCmd(
+		"set <leverage>",
+		"Change leverage",
+		cobra.ExactArgs(1),
+		func(command *cobra.Command, application *appcontainer.Application, args []string) (any, error) {
+			leverage, err := strconv.Atoi(strings.TrimSpace(args[0]))
+			if err != nil || leverage <= 0 {
+				return nil, fmt.Errorf("leverage must be a positive integer, got %q", args[0])
+			}
+
+			return application.Collateral.SetLeverage(command.Context(), leverage)
+		},
+		getApplication,
+	))
+
+	return command
+}
+
+// NewHedgeModeCommand constructs the collateral hedge-mode command group.
+func NewHedgeModeCommand(getApplication func() (*appcontainer.Application, error)) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "hedge-mode",
+		Short: "Show or change collateral hedge mode",
+		Long: "Show or change collateral hedge mode. Both commands refresh the cached session hedge mode,\n" +
+			"so order placement does not need to discover a mismatch from the exchange.\n" +
+			"WhiteBIT rejects changes while positions or orders are open.",
+		RunE: func(command *cobra.Command, args []string) error {
+			return command.Help()
+		},
+	}
+
+	command.AddCommand(newSettingCmd(
+		"get",
+		"Read hedge mode from the exchange",
+		cobra.NoArgs,
+		func(command *cobra.Command, application *appcontainer.Application, _ []string) (any, error) {
+			return application.Collateral.GetHedgeMode(command.Context())
+		},
+		getApplication,
+	))
+	command.AddCommand(newSettingCmd(
+		"set <on|off>",
+		"Enable or disable hedge mode",
+		cobra.ExactArgs(1),
+		func(command *cobra.Command, application *appcontainer.Application, args []string) (any, error) {
+			enabled, ok := parseSwitchArg(args[0])
+			if !ok {
+				return nil, fmt.Errorf("hedge mode must be on or off, got %q", args[0])
+			}
+
+			return application.Collateral.SetHedgeMode(command.Context(), enabled)
+		},
+		getApplication,
+	))
+
+	return command
+}
+
+func newSettingCmd(
+	use string,
+	short string,
+	args cobra.PositionalArgs,
+	run func(command *cobra.Command, application *appcontainer.Application, args []string) (any, error),
+	getApplication func() (*appcontainer.Application, error),
+) *cobra.Command {
+	var output string
+
+	command := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  args,
+		RunE: func(command *cobra.Command, args []string) error {
+			outputMode, ok := normalizeOutputMode(output)
+			if !ok {
+				return errors.New("--output must be one of: table, json")
+			}
+
+			return runWithApplication(command, getApplication, func(application *appcontainer.Application) error {
+				if application.Collateral == nil {
+					return errors.New("collateral account service is not configured")
+				}
+
+				result, err := run(command, application, args)
+				if err != nil {
+					return err
+				}
+
+				return renderSettingOutput(command.OutOrStdout(), outputMode, result)
+			})
+		},
+	}
+
+	command.Flags().StringVar(&output, "output", "table", "output format: table|json")
+
+	return command
+}
+
+func parseSwitchArg(value string) (bool, bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "on", "true", "enable", "enabled":
+		return true, true
+	case "off", "false", "disable", "disabled":
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+func renderSettingOutput(writer io.Writer, outputMode string, result any) error {
+	if outputMode == "json" {
+		return encodeJSON(writer, result)
+	}
+
+	var err error
+	switch value := result.(type) {
+	case collateralservice.LeverageResult:
+		_, err = fmt.Fprintf(writer, "leverage=%s\n", value.Leverage)
+	case collateralservice.HedgeModeResult:
+		_, err = fmt.Fprintf(writer, "hedge_mode=%t\n", value.HedgeMode)
+	default:
+		err = encodeJSON(writer, result)
+	}
+
+	return err
+}
@@ -4,6 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
+
+	"github.com/ChewX3D/crypto/internal/app/ports"
+	collateralservice "github.com/ChewX3D/crypto/internal/app/services/collateral"
+	"github.com/ChewX3D/crypto/internal/domain/decimal"
 )
 
 const amountModeErrorMessage = "--amount-mode must be one of: constant, arithmetic, geometric, capped-geometric, fibonacci, custom-list"
@@ -17,7 +22,79 @@ var supportedAmountModes = map[string]struct{}{
 	"custom-list":      {},
 }
 
-func validatePositiveFloatFlag(flagName string, value float64) error {
+func parseDecimalFlag(flagName string, value string) (decimal.Decimal, error) {
+	parsed, err := decimal.Parse(value)
+	if err != nil {
+		return decimal.Decimal{}, fmt.Errorf("%s must be a plain decimal number, got %q", flagName, value)
+	}
+
+	return parsed, nil
+}
+
+func parsePositiveDecimalFlag(flagName string, value string) (decimal.Decimal, error) {
+	parsed, err := parseDecimalFlag(flagName, value)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if parsed.Sign() <= 0 {
+		return decimal.Decimal{}, fmt.Errorf("%s must be greater than 0", flagName)
+	}
+
+	return parsed, nil
+}
+
+// parseOptionalOffsetFlag parses an optional non-negative price distance; empty value means zero.
+func parseOptionalOffsetFlag(flagName string, value string) (decimal.Decimal, error) {
+	if strings.TrimSpace(value) == "" {
+		return decimal.Decimal{}, nil
+	}
+
+	parsed, err := parseDecimalFlag(flagName, value)
+	if err != nil {
+		return decimal.Decimal{}, err
+	}
+	if parsed.Sign() < 0 {
+		return decimal.Decimal{}, fmt.Errorf("%s must be greater than or equal to 0", flagName)
+	}
+
+	return parsed, nil
+}
+
+func parseDecimalListFlag(flagName string, values []string) ([]decimal.Decimal, error) {
+	parsed := make([]decimal.Decimal, 0, len(values))
+	for _, value := range values {
+		item, err := parseDecimalFlag(flagName, value)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, item)
+	}
+
+	return parsed, nil
+}
+
+// parseTimeFlag accepts RFC3339 timestamps, UTC dates (2006-01-02) or a duration
+// (for example 24h) meaning that long before now. Empty value returns zero time.
+func parseTimeFlag(flagName string, value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed.UTC(), nil
+	}
+	if parsed, err := time.Parse(time.DateOnly, value); err == nil {
+		return parsed.UTC(), nil
+	}
+	if duration, err := time.ParseDuration(value); err == nil && duration > 0 {
+		return now.Add(-duration).UTC(), nil
+	}
+
+	return time.Time{}, fmt.Errorf("%s must be an RFC3339 timestamp, a YYYY-MM-DD date or a positive duration like 24h, got %q", flagName, value)
+}
+
+func validatePositiveIntFlag(flagName string, value int) error {
 	if value <= 0 {
 		return fmt.Errorf("%s must be greater than 0", flagName)
 	}
@@ -61,6 +138,17 @@ func normalizeSideAlias(side string) (string, bool) {
 	}
 }
 
+func normalizeOrderType(orderType string) (string, bool) {
+	switch normalized := strings.ToLower(strings.TrimSpace(orderType)); normalized {
+	case "", collateralservice.OrderTypeLimit:
+		return collateralservice.OrderTypeLimit, true
+	case ports.CollateralOrderTypeMarket, ports.CollateralOrderTypeStopMarket, ports.CollateralOrderTypeStopLimit:
+		return normalized, true
+	default:
+		return "", false
+	}
+}
+
 func validateRequiredStringFlag(flagName string, value string) error {
 	if strings.TrimSpace(value) == "" {
 		return fmt.Errorf("%s is required", flagName)
@@ -80,6 +168,19 @@ func normalizeOutputMode(mode string) (string, bool) {
 	}
 }
 
+func normalizeStreamOutputMode(mode string) (string, bool) {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "", "table":
+		return "table", true
+	case "json":
+		return "json", true
+	case "jsonl", "ndjson":
+		return "jsonl", true
+	default:
+		return "", false
+	}
+}
+
 func validateAmountMode(mode string) error {
 	if _, ok := supportedAmountModes[mode]; ok {
 		return nil
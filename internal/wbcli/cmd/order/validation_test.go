@@ -1,26 +1,35 @@
 package ordercmd
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
-func TestValidatePositiveFloatFlag(t *testing.T) {
+func TestParsePositiveDecimalFlag(t *testing.T) {
 	tests := []struct {
 		name      string
 		flag      string
-		value     float64
+		value     string
+		want      string
 		wantError string
 	}{
-		{name: "valid", flag: "--amount", value: 1},
-		{name: "zero", flag: "--amount", value: 0, wantError: "--amount must be greater than 0"},
-		{name: "negative", flag: "--price", value: -1, wantError: "--price must be greater than 0"},
+		{name: "valid", flag: "--amount", value: "0.0050", want: "0.0050"},
+		{name: "zero", flag: "--amount", value: "0", wantError: "--amount must be greater than 0"},
+		{name: "negative", flag: "--price", value: "-1", wantError: "--price must be greater than 0"},
+		{name: "exponent", flag: "--price", value: "5e4", wantError: `--price must be a plain decimal number, got "5e4"`},
+		{name: "empty", flag: "--step", value: "", wantError: `--step must be a plain decimal number, got ""`},
 	}
 
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
-			err := validatePositiveFloatFlag(testCase.flag, testCase.value)
+			got, err := parsePositiveDecimalFlag(testCase.flag, testCase.value)
 			if testCase.wantError == "" {
 				if err != nil {
 					t.Fatalf("expected no error, got %v", err)
 				}
+				if got.String() != testCase.want {
+					t.Fatalf("unexpected value. got %s want %s", got, testCase.want)
+				}
 				return
 			}
 
@@ -34,6 +43,35 @@ func TestValidatePositiveFloatFlag(t *testing.T) {
 	}
 }
 
+func TestValidatePositiveIntFlag(t *testing.T) {
+	tests := []struct {
+		name      string
+		flag      string
+		value     int
+		wantError string
+	}{
+		{name: "valid", flag: "--max-orders", value: 1},
+		{name: "zero", flag: "--max-orders", value: 0, wantError: "--max-orders must be greater than 0"},
+		{name: "negative", flag: "--max-orders", value: -5, wantError: "--max-orders must be greater than 0"},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validatePositiveIntFlag(testCase.flag, testCase.value)
+			if testCase.wantError == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+
+			if err == nil || err.Error() != testCase.wantError {
+				t.Fatalf("unexpected error. got %v want %q", err, testCase.wantError)
+			}
+		})
+	}
+}
+
 func TestValidateNonNegativeInt64Flag(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -234,3 +272,38 @@ func TestValidateAmountMode(t *testing.T) {
 		})
 	}
 }
+
+func TestParseTimeFlag(t *testing.T) {
+	now := time.Date(2026, 3, 3, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		value     string
+		want      time.Time
+		wantError bool
+	}{
+		{name: "empty", value: "", want: time.Time{}},
+		{name: "rfc3339", value: "2026-03-01T10:00:00+02:00", want: time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)},
+		{name: "date", value: "2026-03-01", want: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "duration", value: "36h", want: time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)},
+		{name: "negative duration", value: "-1h", wantError: true},
+		{name: "garbage", value: "yesterday", wantError: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			got, err := parseTimeFlag("--since", testCase.value, now)
+			if testCase.wantError {
+				if err == nil {
+					t.Fatalf("expected error, got %s", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !got.Equal(testCase.want) {
+				t.Fatalf("unexpected time. got %s want %s", got, testCase.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"errors"
 	"log/slog"
 	"os"
 
@@ -8,10 +9,26 @@ import (
 	"github.com/spf13/cobra"
 )
 
-const flagKeyVerbose = "verbose"
+const (
+	flagKeyVerbose         = "verbose"
+	flagKeyCredentialsFrom = "credentials-from"
+	flagKeyAccount         = "account"
+)
+
+// storedCredentialCommands write or delete stored credentials and cannot run on a read-only credential source.
+var storedCredentialCommands = map[string]bool{
+	"wbcli auth login":  true,
+	"wbcli auth logout": true,
+	"wbcli auth rotate": true,
+	"wbcli auth unlock": true,
+	"wbcli auth use":    true,
+}
 
-func newRootCmd(factory func() (*appcontainer.Application, error)) *cobra.Command {
-	applicationProvider := newApplicationProvider(factory)
+func newRootCmd(factory func(appcontainer.Options) (*appcontainer.Application, error)) *cobra.Command {
+	var options appcontainer.Options
+	applicationProvider := newApplicationProvider(func() (*appcontainer.Application, error) {
+		return factory(options)
+	})
 
 	root := &cobra.Command{
 		Use:   "wbcli",
@@ -28,26 +45,63 @@ It provides safe command groups for auth credential management and collateral or
 				slog.SetLogLoggerLevel(slog.LevelDebug)
 			}
 
+			credentialsFrom, err := cmd.Flags().GetString(flagKeyCredentialsFrom)
+			if err != nil {
+				return err
+			}
+			options.Credentials, err = appcontainer.ParseCredentialSource(credentialsFrom)
+			if err != nil {
+				return err
+			}
+			if options.Credentials.Kind != appcontainer.CredentialSourceStore && storedCredentialCommands[cmd.CommandPath()] {
+				return errors.New("--credentials-from is read-only; run this command without it to manage stored credentials")
+			}
+
+			account, err := cmd.Flags().GetString(flagKeyAccount)
+			if err != nil {
+				return err
+			}
+			options.Account, err = appcontainer.ParseAccount(account)
+			if err != nil {
+				return err
+			}
+			if options.Account != "" && options.Credentials.Kind != appcontainer.CredentialSourceStore {
+				return errors.New("--account selects a stored account and cannot be combined with --credentials-from")
+			}
+
 			return nil
 		},
 	}
 
 	root.PersistentFlags().BoolP(flagKeyVerbose, "v", false, "verbose logging")
+	root.PersistentFlags().String(
+		flagKeyCredentialsFrom,
+		"",
+		"read credentials for this run from env (WBCLI_API_KEY/WBCLI_API_SECRET) or fd:N instead of the stored login",
+	)
+	root.PersistentFlags().String(
+		flagKeyAccount,
+		"",
+		"use this stored account for the command instead of the one selected with wbcli auth use",
+	)
 	root.AddCommand(newVersionCmd())
 	root.AddCommand(newAuthCmd(applicationProvider))
 	root.AddCommand(newCollateralCmd(applicationProvider))
+	root.AddCommand(newMarketCmd(applicationProvider))
 
 	return root
 }
 
 // Execute creates the root command with production defaults and runs it.
 func Execute() {
-	if err := newRootCmd(appcontainer.NewDefault).Execute(); err != nil {
+	if err := newRootCmd(appcontainer.NewDefaultWithOptions).Execute(); err != nil {
 		os.Exit(1)
 	}
 }
 
 // NewRootCmdForTest creates a root command with the given factory for tests.
 func NewRootCmdForTest(factory func() (*appcontainer.Application, error)) *cobra.Command {
-	return newRootCmd(factory)
+	return newRootCmd(func(appcontainer.Options) (*appcontainer.Application, error) {
+		return factory()
+	})
 }
@@ -4,6 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -12,6 +16,7 @@ import (
 	"github.com/ChewX3D/crypto/internal/app/ports"
 	authservice "github.com/ChewX3D/crypto/internal/app/services/auth"
 	collateralservice "github.com/ChewX3D/crypto/internal/app/services/collateral"
+	marketservice "github.com/ChewX3D/crypto/internal/app/services/market"
 	domainauth "github.com/ChewX3D/crypto/internal/domain/auth"
 )
 
@@ -65,8 +70,8 @@ func TestRootHelpShowsMainGroups(t *testing.T) {
 		t.Fatalf("expected no error, got %v", err)
 	}
 
-	if !strings.Contains(stdout, "auth") || !strings.Contains(stdout, "collateral") {
-		t.Fatalf("expected auth and collateral in help output, got: %q", stdout)
+	if !strings.Contains(stdout, "auth") || !strings.Contains(stdout, "collateral") || !strings.Contains(stdout, "market") {
+		t.Fatalf("expected auth, collateral and market in help output, got: %q", stdout)
 	}
 
 	if stderr != "" {
@@ -85,10 +90,6 @@ func TestLegacyAuthSetCommandRemoved(t *testing.T) {
 	assertUnknownAuthSubcommand(t, "set")
 }
 
-func TestLegacyAuthUseCommandRemoved(t *testing.T) {
-	assertUnknownAuthSubcommand(t, "use")
-}
-
 func TestLegacyAuthListCommandRemoved(t *testing.T) {
 	assertUnknownAuthSubcommand(t, "list")
 }
@@ -214,6 +215,59 @@ func TestAuthStatusWorksWhenLoggedIn(t *testing.T) {
 	}
 }
 
+func TestAuthStatusCheckReportsEndpointAccess(t *testing.T) {
+	updatedAt := time.Date(2026, 2, 28, 15, 4, 5, 0, time.UTC)
+	credentialStore := &testCredentialStore{
+		backendName: "os-keychain",
+		credential:  &domainauth.Credential{APIKey: "api-key-1", APISecret: []byte("secret-1")},
+	}
+	sessionStore := &testSessionStore{
+		session: &ports.SessionMetadata{Backend: "os-keychain", APIKeyHint: "ab***yz", CreatedAt: updatedAt, UpdatedAt: updatedAt},
+	}
+	credentialVerifier := &testCredentialVerifier{permissions: []ports.EndpointPermission{
+		{Endpoint: "/api/v4/collateral-account/balance", Access: ports.EndpointAccessAllowed},
+		{Endpoint: "/api/v4/order/collateral/market", Access: ports.EndpointAccessRejected, Reason: "Amount must be greater than 0."},
+		{
+			Endpoint: "/api/v4/order/collateral/limit",
+			Access:   ports.EndpointAccessForbidden,
+			Reason:   "enable access to endpoint /api/v4/order/collateral/limit in your WhiteBIT API key settings",
+		},
+	}}
+
+	app := testApplication(credentialStore, sessionStore, credentialVerifier)
+	factory := func() (*appcontainer.Application, error) { return app, nil }
+
+	stdout, _, err := executeCommandWithFactory(factory, "", "auth", "status", "--check", "--market", "ETH_PERP")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expected := "logged_in=true backend=os-keychain api_key=ab***yz updated_at=2026-02-28T15:04:05Z\n" +
+		"endpoint=/api/v4/collateral-account/balance access=allowed\n" +
+		"endpoint=/api/v4/order/collateral/market access=rejected reason=\"Amount must be greater than 0.\"\n" +
+		"endpoint=/api/v4/order/collateral/limit access=forbidden " +
+		"reason=\"enable access to endpoint /api/v4/order/collateral/limit in your WhiteBIT API key settings\"\n" +
+		"endpoints=3 allowed=1 rejected=1 forbidden=1 unknown=0\n"
+	if stdout != expected {
+		t.Fatalf("unexpected output:\n%s", stdout)
+	}
+	if credentialVerifier.market != "ETH_PERP" {
+		t.Fatalf("expected probes on --market, got %q", credentialVerifier.market)
+	}
+}
+
+func TestAuthStatusCheckRequiresLogin(t *testing.T) {
+	app := testApplication(&testCredentialStore{backendName: "os-keychain"}, &testSessionStore{}, nil)
+	factory := func() (*appcontainer.Application, error) { return app, nil }
+
+	stdout, _, err := executeCommandWithFactory(factory, "", "auth", "status", "--check")
+	if err == nil || !strings.Contains(err.Error(), "not logged in; run wbcli auth login first") {
+		t.Fatalf("expected not logged in error, got %v", err)
+	}
+	if !strings.HasPrefix(stdout, "logged_in=false\n") {
+		t.Fatalf("expected status line before the check, got %q", stdout)
+	}
+}
+
 func TestAuthLoginUnavailableStoreReturnsActionableError(t *testing.T) {
 	factory := func() (*appcontainer.Application, error) {
 		return nil, ports.ErrSecretStoreUnavailable
@@ -373,6 +427,77 @@ func TestCollateralOrderPlaceSuccessTableOutput(t *testing.T) {
 	}
 }
 
+func TestCollateralOrderPlacePassesStopLevels(t *testing.T) {
+	placeUseCase := &testCollateralUseCases{result: collateralservice.PlaceOrderResult{RequestID: "order-124", Mode: "single"}}
+	application := testApplication(&testCredentialStore{backendName: "os-keychain"}, &testSessionStore{}, nil)
+	application.Collateral = placeUseCase
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	_, _, err := executeCommandWithFactory(factory, "",
+		"collateral", "order", "place",
+		"--market", "BTC_PERP",
+		"--side", "buy",
+		"--amount", "0.01",
+		"--price", "50000",
+		"--stop-loss", "49000",
+		"--take-profit", "52000",
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if placeUseCase.lastRequest.StopLoss != "49000" || placeUseCase.lastRequest.TakeProfit != "52000" {
+		t.Fatalf("expected stop levels pass-through, got %+v", placeUseCase.lastRequest)
+	}
+
+	_, _, err = executeCommandWithFactory(factory, "",
+		"collateral", "order", "place",
+		"--market", "BTC_PERP",
+		"--side", "buy",
+		"--amount", "0.01",
+		"--price", "50000",
+		"--stop-loss", "-5",
+	)
+	if err == nil {
+		t.Fatalf("expected invalid stop-loss error")
+	}
+}
+
+func TestCollateralOrderPlaceTakerTypesRequireAllowTaker(t *testing.T) {
+	placeUseCase := &testCollateralUseCases{result: collateralservice.PlaceOrderResult{RequestID: "order-125", Mode: "single"}}
+	application := testApplication(&testCredentialStore{backendName: "os-keychain"}, &testSessionStore{}, nil)
+	application.Collateral = placeUseCase
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	_, _, err := executeCommandWithFactory(factory, "",
+		"collateral", "order", "place",
+		"--market", "BTC_PERP",
+		"--side", "sell",
+		"--type", "market",
+		"--amount", "0.01",
+	)
+	if err == nil || !strings.Contains(err.Error(), "--allow-taker") {
+		t.Fatalf("expected allow-taker error, got %v", err)
+	}
+
+	_, _, err = executeCommandWithFactory(factory, "",
+		"collateral", "order", "place",
+		"--market", "BTC_PERP",
+		"--side", "sell",
+		"--type", "stop-limit",
+		"--amount", "0.01",
+		"--price", "48900",
+		"--activation-price", "49000",
+		"--allow-taker",
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	request := placeUseCase.lastRequest
+	if request.Type != "stop-limit" || request.ActivationPrice != "49000" || request.Price != "48900" || !request.AllowTaker {
+		t.Fatalf("unexpected stop-limit request: %+v", request)
+	}
+}
+
 func TestCollateralOrderPlaceSuccessJSONOutput(t *testing.T) {
 	credentialStore := &testCredentialStore{backendName: "os-keychain"}
 	sessionStore := &testSessionStore{}
@@ -488,13 +613,38 @@ func TestCollateralOrderPlaceInsufficientPermissionErrorMapping(t *testing.T) {
 	}
 }
 
-func TestCollateralOrderRangeStub(t *testing.T) {
-	stdout, _, err := executeCommand(
+func TestCollateralOrderRangeDryRunTableOutput(t *testing.T) {
+	credentialStore := &testCredentialStore{backendName: "os-keychain"}
+	sessionStore := &testSessionStore{}
+	rangeUseCase := &testCollateralUseCases{
+		rangeResult: collateralservice.RangePlanResult{
+			PlaceOrderResult: collateralservice.PlaceOrderResult{
+				RequestID:     "range-123",
+				Mode:          "range",
+				OrdersPlanned: 2,
+				Errors:        []string{},
+			},
+			Market:        "BTC_PERP",
+			AmountMode:    "constant",
+			TotalAmount:   "0.01",
+			TotalNotional: "490.25",
+			Orders: []collateralservice.RangePlanOrder{
+				{Index: 0, Side: "buy", Price: "49000", Amount: "0.005"},
+				{Index: 1, Side: "buy", Price: "49050", Amount: "0.005"},
+			},
+		},
+	}
+
+	application := testApplication(credentialStore, sessionStore, nil)
+	application.Collateral = rangeUseCase
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	stdout, _, err := executeCommandWithFactory(factory, "",
 		"collateral", "order", "range",
 		"--market", "BTC_PERP",
 		"--side", "buy",
 		"--start-price", "49000",
-		"--end-price", "50000",
+		"--end-price", "49050",
 		"--step", "50",
 		"--amount-mode", "constant",
 		"--base-amount", "0.005",
@@ -503,9 +653,319 @@ func TestCollateralOrderRangeStub(t *testing.T) {
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
+	if !strings.Contains(stdout, "request_id=range-123 mode=range orders_planned=2") {
+		t.Fatalf("expected range summary in table output, got: %q", stdout)
+	}
+	if !strings.Contains(stdout, "index=1 side=buy position_side=- price=49050 amount=0.005") {
+		t.Fatalf("expected per-order preview in table output, got: %q", stdout)
+	}
+	if !strings.Contains(stdout, "total_notional=490.25") {
+		t.Fatalf("expected totals in table output, got: %q", stdout)
+	}
+	if rangeUseCase.lastRangeRequest.StartPrice.String() != "49000" || rangeUseCase.lastRangeRequest.Step.String() != "50" {
+		t.Fatalf("unexpected range request passthrough: %#v", rangeUseCase.lastRangeRequest)
+	}
+}
+
+func TestCollateralOrderRangeConfirmSubmitsAndReportsStatuses(t *testing.T) {
+	credentialStore := &testCredentialStore{backendName: "os-keychain"}
+	sessionStore := &testSessionStore{}
+	rangeUseCase := &testCollateralUseCases{
+		rangeResult: collateralservice.RangePlanResult{
+			PlaceOrderResult: collateralservice.PlaceOrderResult{
+				RequestID:       "range-456",
+				Mode:            "range",
+				OrdersPlanned:   2,
+				OrdersSubmitted: 1,
+				OrdersFailed:    1,
+				Errors:          []string{"order 1: amount too small"},
+			},
+			Market:     "BTC_PERP",
+			AmountMode: "constant",
+			Orders: []collateralservice.RangePlanOrder{
+				{Index: 0, Side: "buy", Price: "49000", Amount: "0.005", Status: "accepted", OrderID: 77},
+				{Index: 1, Side: "buy", Price: "49050", Amount: "0.005", Status: "rejected", Error: "amount too small"},
+			},
+		},
+	}
+
+	application := testApplication(credentialStore, sessionStore, nil)
+	application.Collateral = rangeUseCase
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	stdout, _, err := executeCommandWithFactory(factory, "",
+		"collateral", "order", "range",
+		"--market", "BTC_PERP",
+		"--side", "buy",
+		"--start-price", "49000",
+		"--end-price", "49050",
+		"--step", "50",
+		"--base-amount", "0.005",
+		"--confirm",
+		"--stop-on-fail",
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if rangeUseCase.lastSubmit == nil || !rangeUseCase.lastSubmit.StopOnFail {
+		t.Fatalf("expected submit request with stop-on-fail, got %#v", rangeUseCase.lastSubmit)
+	}
+	if !strings.Contains(stdout, "index=0 side=buy position_side=- price=49000 amount=0.005 client_order_id=- status=accepted order_id=77") {
+		t.Fatalf("expected accepted order status in table output, got: %q", stdout)
+	}
+	if !strings.Contains(stdout, `status=rejected order_id=0 error="amount too small"`) {
+		t.Fatalf("expected rejected order status in table output, got: %q", stdout)
+	}
+}
+
+func TestCollateralOrderRangeRequiresDryRunOrConfirm(t *testing.T) {
+	_, _, err := executeCommand(
+		"collateral", "order", "range",
+		"--market", "BTC_PERP",
+		"--side", "buy",
+		"--start-price", "49000",
+		"--end-price", "50000",
+		"--step", "50",
+		"--base-amount", "0.005",
+	)
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+	if !strings.Contains(err.Error(), "--dry-run or --confirm is required") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCollateralOrderCancelBatchFileReportsFailures(t *testing.T) {
+	batchPath := filepath.Join(t.TempDir(), "cancel.txt")
+	if err := os.WriteFile(batchPath, []byte("# run42 leftovers\n4180284841\nclient_order_id=run42-3\n\norder_id=77\n"), 0o600); err != nil {
+		t.Fatalf("write batch file: %v", err)
+	}
+
+	cancelUseCase := &testCollateralUseCases{
+		result: collateralservice.PlaceOrderResult{
+			RequestID:       "cancel-1",
+			Mode:            "cancel",
+			OrdersPlanned:   3,
+			OrdersSubmitted: 2,
+			OrdersFailed:    1,
+			Errors:          []string{"order_id 77: order not found"},
+		},
+	}
+	application := testApplication(&testCredentialStore{backendName: "os-keychain"}, &testSessionStore{}, nil)
+	application.Collateral = cancelUseCase
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	stdout, _, err := executeCommandWithFactory(factory, "",
+		"collateral", "order", "cancel",
+		"--market", "BTC_PERP",
+		"--order-id", "5",
+		"--file", batchPath,
+	)
+	if err == nil || !strings.Contains(err.Error(), "1 of 3 cancellations failed") {
+		t.Fatalf("expected partial failure error, got %v", err)
+	}
+	if !strings.Contains(stdout, "mode=cancel orders_planned=3 orders_submitted=2 orders_failed=1 errors=[order_id 77: order not found]") {
+		t.Fatalf("expected cancel summary in table output, got: %q", stdout)
+	}
+
+	request := cancelUseCase.lastCancel
+	if request == nil {
+		t.Fatalf("expected cancel request")
+	}
+	if len(request.OrderIDs) != 3 || request.OrderIDs[0] != 5 || request.OrderIDs[1] != 4180284841 || request.OrderIDs[2] != 77 {
+		t.Fatalf("unexpected order ids: %v", request.OrderIDs)
+	}
+	if len(request.ClientOrderIDs) != 1 || request.ClientOrderIDs[0] != "run42-3" {
+		t.Fatalf("unexpected client order ids: %v", request.ClientOrderIDs)
+	}
+}
+
+func TestCollateralOrderCancelPrefixRequiresConfirm(t *testing.T) {
+	_, _, err := executeCommand(
+		"collateral", "order", "cancel",
+		"--market", "BTC_PERP",
+		"--client-order-id-prefix", "run42-",
+	)
+	if err == nil || !strings.Contains(err.Error(), "--confirm is required") {
+		t.Fatalf("expected confirm error, got %v", err)
+	}
+}
+
+func TestCollateralOrderListPassesFiltersAndRendersJSON(t *testing.T) {
+	listUseCase := &testCollateralUseCases{
+		listResult: collateralservice.ListOrdersResult{
+			Orders: []collateralservice.ListedOrder{
+				{OrderID: 10, ClientOrderID: "run42-0", Market: "BTC_PERP", Side: "buy", Price: "50000", Amount: "0.01", Left: "0.01"},
+			},
+		},
+	}
+	application := testApplication(&testCredentialStore{backendName: "os-keychain"}, &testSessionStore{}, nil)
+	application.Collateral = listUseCase
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	stdout, _, err := executeCommandWithFactory(factory, "",
+		"collateral", "order", "list",
+		"--market", "BTC_PERP",
+		"--side", "long",
+		"--client-order-id-prefix", "run42-",
+		"--output", "json",
+	)
+	if err != nil {
+		t.Fatalf("list failed: %v", err)
+	}
+
+	request := listUseCase.lastList
+	if request == nil || request.Market != "BTC_PERP" || request.Side != "long" || request.ClientOrderIDPrefix != "run42-" {
+		t.Fatalf("unexpected list request: %+v", request)
+	}
+	if !strings.Contains(stdout, `"order_id":10`) || !strings.Contains(stdout, `"client_order_id":"run42-0"`) {
+		t.Fatalf("expected json order output, got: %q", stdout)
+	}
+}
+
+func TestCollateralOrderListRejectsUnknownSide(t *testing.T) {
+	_, _, err := executeCommand("collateral", "order", "list", "--side", "up")
+	if err == nil || !strings.Contains(err.Error(), "--side must be one of") {
+		t.Fatalf("expected side error, got %v", err)
+	}
+}
+
+func TestCollateralTradesPrintsJSONLinesAndTruncationWarning(t *testing.T) {
+	tradesUseCase := &testCollateralUseCases{
+		tradesResult: collateralservice.TradesResult{
+			Trades: []collateralservice.Trade{
+				{TradeID: 1, OrderID: 10, Market: "BTC_PERP", Side: "buy", Role: "maker", Price: "50000", Amount: "0.01"},
+				{TradeID: 2, OrderID: 11, Market: "BTC_PERP", Side: "sell", Role: "maker", Price: "51000", Amount: "0.01"},
+			},
+			Truncated: true,
+		},
+	}
+	application := testApplication(&testCredentialStore{backendName: "os-keychain"}, &testSessionStore{}, nil)
+	application.Collateral = tradesUseCase
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	stdout, stderr, err := executeCommandWithFactory(factory, "",
+		"collateral", "trades",
+		"--market", "BTC_PERP",
+		"--since", "2026-03-01",
+		"--until", "2026-03-02T00:00:00Z",
+		"--output", "jsonl",
+	)
+	if err != nil {
+		t.Fatalf("trades failed: %v", err)
+	}
+
+	request := tradesUseCase.lastHistory
+	if request == nil || request.Market != "BTC_PERP" ||
+		!request.Since.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) ||
+		!request.Until.Equal(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected history request: %+v", request)
+	}
+
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], `{"trade_id":1,`) || !strings.HasPrefix(lines[1], `{"trade_id":2,`) {
+		t.Fatalf("expected one JSON object per line, got: %q", stdout)
+	}
+	if !strings.Contains(stderr, "offset limit") {
+		t.Fatalf("expected truncation warning, got: %q", stderr)
+	}
+}
+
+func TestCollateralOrderHistoryRejectsInvertedWindow(t *testing.T) {
+	_, _, err := executeCommand(
+		"collateral", "order", "history",
+		"--since", "2026-03-02",
+		"--until", "2026-03-01",
+	)
+	if err == nil || !strings.Contains(err.Error(), "--until must be after --since") {
+		t.Fatalf("expected window error, got %v", err)
+	}
+}
+
+func TestCollateralPositionsRendersHedgeLegs(t *testing.T) {
+	positionsUseCase := &testCollateralUseCases{
+		positionsResult: collateralservice.PositionsResult{
+			HedgeMode: true,
+			Positions: []collateralservice.Position{
+				{PositionID: 1, Market: "BTC_PERP", Side: "long", Amount: "0.05", BasePrice: "50000", LiquidationPrice: "41000", UnrealizedPnL: "12.3"},
+				{PositionID: 2, Market: "BTC_PERP", Side: "short", Amount: "0.02", BasePrice: "52000", LiquidationPrice: "61000", UnrealizedPnL: "-1.5"},
+			},
+		},
+	}
+	application := testApplication(&testCredentialStore{backendName: "os-keychain"}, &testSessionStore{}, nil)
+	application.Collateral = positionsUseCase
+	factory := func() (*appcontainer.Application, error) { return application, nil }
 
-	if !strings.Contains(stdout, "not implemented yet") {
-		t.Fatalf("expected stub output, got: %q", stdout)
+	stdout, _, err := executeCommandWithFactory(factory, "", "collateral", "positions")
+	if err != nil {
+		t.Fatalf("positions failed: %v", err)
+	}
+	if !strings.Contains(stdout, "position_id=1 market=BTC_PERP side=long amount=0.05 base_price=50000 liquidation_price=41000 unrealized_pnl=12.3") {
+		t.Fatalf("expected long leg row, got: %q", stdout)
+	}
+	if !strings.Contains(stdout, "position_id=2 market=BTC_PERP side=short amount=0.02") {
+		t.Fatalf("expected short leg row, got: %q", stdout)
+	}
+	if !strings.Contains(stdout, "hedge_mode=true positions=2") {
+		t.Fatalf("expected summary line, got: %q", stdout)
+	}
+}
+
+func TestCollateralAccountRendersJSON(t *testing.T) {
+	accountUseCase := &testCollateralUseCases{
+		accountResult: collateralservice.AccountResult{
+			HedgeMode:          true,
+			Equity:             "1500",
+			Margin:             "250",
+			MarginUsagePercent: "16.67",
+			Balances:           []collateralservice.Balance{{Asset: "USDT", Amount: "1487.5"}},
+		},
+	}
+	application := testApplication(&testCredentialStore{backendName: "os-keychain"}, &testSessionStore{}, nil)
+	application.Collateral = accountUseCase
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	stdout, _, err := executeCommandWithFactory(factory, "", "collateral", "account", "--output", "json")
+	if err != nil {
+		t.Fatalf("account failed: %v", err)
+	}
+	if !strings.Contains(stdout, `"margin_usage_percent":"16.67"`) || !strings.Contains(stdout, `"asset":"USDT"`) {
+		t.Fatalf("expected account json, got: %q", stdout)
+	}
+}
+
+func TestCollateralLeverageAndHedgeModeSet(t *testing.T) {
+	settingsUseCase := &testCollateralUseCases{}
+	application := testApplication(&testCredentialStore{backendName: "os-keychain"}, &testSessionStore{}, nil)
+	application.Collateral = settingsUseCase
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	stdout, _, err := executeCommandWithFactory(factory, "", "collateral", "leverage", "set", "20")
+	if err != nil {
+		t.Fatalf("leverage set failed: %v", err)
+	}
+	if settingsUseCase.lastLeverage != 20 || stdout != "leverage=20\n" {
+		t.Fatalf("unexpected leverage set: value=%d output=%q", settingsUseCase.lastLeverage, stdout)
+	}
+
+	stdout, _, err = executeCommandWithFactory(factory, "", "collateral", "hedge-mode", "set", "off", "--output", "json")
+	if err != nil {
+		t.Fatalf("hedge-mode set failed: %v", err)
+	}
+	if settingsUseCase.lastHedgeMode == nil || *settingsUseCase.lastHedgeMode || stdout != "{\"hedge_mode\":false}\n" {
+		t.Fatalf("unexpected hedge-mode set: value=%v output=%q", settingsUseCase.lastHedgeMode, stdout)
+	}
+}
+
+func TestCollateralHedgeModeSetRejectsUnknownValue(t *testing.T) {
+	application := testApplication(&testCredentialStore{backendName: "os-keychain"}, &testSessionStore{}, nil)
+	application.Collateral = &testCollateralUseCases{}
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	_, _, err := executeCommandWithFactory(factory, "", "collateral", "hedge-mode", "set", "maybe")
+	if err == nil || !strings.Contains(err.Error(), "hedge mode must be on or off") {
+		t.Fatalf("expected value error, got %v", err)
 	}
 }
 
@@ -517,23 +977,321 @@ func testApplication(
 	if credentialVerifier == nil {
 		credentialVerifier = &testCredentialVerifier{}
 	}
+	permissionProber, ok := credentialVerifier.(ports.EndpointPermissionProber)
+	if !ok {
+		permissionProber = &testCredentialVerifier{}
+	}
 
 	return appcontainer.NewWithAuthServices(
 		authservice.NewLoginService(
 			credentialStore,
+			nil,
 			sessionStore,
 			testClock{now: time.Date(2026, 2, 28, 12, 0, 0, 0, time.UTC)},
 			credentialVerifier,
 		),
 		authservice.NewLogoutService(credentialStore, sessionStore),
-		authservice.NewStatusService(sessionStore),
+		authservice.NewStatusService(sessionStore, ""),
+		authservice.NewRotateService(
+			credentialStore,
+			sessionStore,
+			testClock{now: time.Date(2026, 2, 28, 12, 0, 0, 0, time.UTC)},
+			credentialVerifier,
+			nil,
+		),
+		authservice.NewUnlockService(credentialStore, nil),
+		authservice.NewLockService(nil),
+		authservice.NewUseService(nil),
+		authservice.NewCheckPermissionsService(credentialStore, permissionProber),
 	)
 }
 
+func TestCollateralPositionCloseCommand(t *testing.T) {
+	closeUseCase := &testCollateralUseCases{
+		closeResult: collateralservice.ClosePositionResult{
+			Market:          "BTC_PERP",
+			PositionSide:    "long",
+			OrderSide:       "sell",
+			OrderType:       "limit",
+			Status:          "open",
+			CloseAmount:     "0.025",
+			FilledAmount:    "0",
+			RemainingAmount: "0.05",
+			BasePrice:       "60000",
+			ExitPrice:       "60500",
+			ExpectedPnL:     "12.5",
+		},
+	}
+	application := testApplication(&testCredentialStore{backendName: "os-keychain"}, &testSessionStore{}, nil)
+	application.Collateral = closeUseCase
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	_, _, err := executeCommandWithFactory(factory, "",
+		"collateral", "position", "close",
+		"--market", "BTC_PERP",
+		"--position-side", "long",
+		"--percent", "50",
+	)
+	if err == nil || !strings.Contains(err.Error(), "--allow-taker") {
+		t.Fatalf("expected missing price hint, got %v", err)
+	}
+
+	stdout, _, err := executeCommandWithFactory(factory, "",
+		"collateral", "position", "close",
+		"--market", "BTC_PERP",
+		"--position-side", "LONG",
+		"--percent", "50",
+		"--price", "60500",
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if closeUseCase.lastClose == nil || closeUseCase.lastClose.PositionSide != "long" || closeUseCase.lastClose.Percent != "50" || closeUseCase.lastClose.AllowTaker {
+		t.Fatalf("unexpected close request: %+v", closeUseCase.lastClose)
+	}
+	if !strings.Contains(stdout, "order_side=sell") || !strings.Contains(stdout, "realized_pnl=- expected_pnl=12.5") {
+		t.Fatalf("unexpected close output: %q", stdout)
+	}
+
+	closeUseCase.lastClose = nil
+	_, _, err = executeCommandWithFactory(factory, "",
+		"collateral", "position", "close",
+		"--market", "BTC_PERP",
+		"--position-side", "short",
+		"--allow-taker",
+	)
+	if err == nil || !strings.Contains(err.Error(), "--confirm is required") || closeUseCase.lastClose != nil {
+		t.Fatalf("expected taker close without --confirm to be rejected, got %v", err)
+	}
+
+	_, _, err = executeCommandWithFactory(factory, "",
+		"collateral", "position", "close",
+		"--market", "BTC_PERP",
+		"--position-side", "short",
+		"--allow-taker",
+		"--confirm",
+	)
+	if err != nil || closeUseCase.lastClose == nil || !closeUseCase.lastClose.AllowTaker {
+		t.Fatalf("expected confirmed taker close, got %v and %+v", err, closeUseCase.lastClose)
+	}
+}
+
+func TestMarketDepthAndTradesCommands(t *testing.T) {
+	marketUseCases := &testMarketUseCases{
+		depthResult: marketservice.DepthResult{
+			Market:        "BTC_PERP",
+			BestBid:       "59990",
+			BestAsk:       "60010",
+			Spread:        "20",
+			SpreadPercent: "0.0333",
+			Asks:          []marketservice.DepthLevel{{Price: "60010", Amount: "0.5"}},
+			Bids:          []marketservice.DepthLevel{{Price: "59990", Amount: "1.2"}},
+		},
+		tradesResult: marketservice.TradesResult{
+			Market: "BTC_PERP",
+			Trades: []marketservice.PublicTrade{{TradeID: 9, Side: "sell", Price: "60000", Amount: "0.01", Total: "600"}},
+		},
+	}
+	application := testApplication(&testCredentialStore{backendName: "os-keychain"}, &testSessionStore{}, nil)
+	application.Market = marketUseCases
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	_, _, err := executeCommandWithFactory(factory, "", "market", "depth", "--market", "BTC_PERP", "--limit", "101")
+	if err == nil || !strings.Contains(err.Error(), "--limit") {
+		t.Fatalf("expected limit validation error, got %v", err)
+	}
+
+	stdout, _, err := executeCommandWithFactory(factory, "", "market", "depth", "--market", "BTC_PERP", "--limit", "5")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if marketUseCases.lastDepth == nil || marketUseCases.lastDepth.Limit != 5 {
+		t.Fatalf("unexpected depth request: %+v", marketUseCases.lastDepth)
+	}
+	if !strings.Contains(stdout, "spread=20") || !strings.Contains(stdout, "side=ask price=60010 amount=0.5") {
+		t.Fatalf("unexpected depth output: %q", stdout)
+	}
+
+	stdout, _, err = executeCommandWithFactory(factory, "", "market", "trades", "--market", "BTC_PERP", "--side", "SELL", "--output", "json")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if marketUseCases.lastTrades == nil || marketUseCases.lastTrades.Side != "sell" {
+		t.Fatalf("unexpected trades request: %+v", marketUseCases.lastTrades)
+	}
+	var payload marketservice.TradesResult
+	if err := json.Unmarshal([]byte(stdout), &payload); err != nil || len(payload.Trades) != 1 || payload.Trades[0].TradeID != 9 {
+		t.Fatalf("unexpected trades json %q: %v", stdout, err)
+	}
+}
+
+func TestMarketKlinesExportWritesCSV(t *testing.T) {
+	openTime := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	marketUseCases := &testMarketUseCases{klinesResult: marketservice.KlinesResult{
+		Market:   "BTC_PERP",
+		Interval: "15m",
+		Fetched:  1,
+		Klines: []marketservice.Kline{
+			{OpenTime: openTime, Open: "60000", High: "60100", Low: "59900", Close: "60050", Volume: "12.5", QuoteVolume: "750000"},
+		},
+	}}
+	application := testApplication(&testCredentialStore{backendName: "os-keychain"}, &testSessionStore{}, nil)
+	application.Market = marketUseCases
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	_, _, err := executeCommandWithFactory(factory, "", "market", "klines", "export", "--market", "BTC_PERP")
+	if err == nil || !strings.Contains(err.Error(), "--since") {
+		t.Fatalf("expected missing --since error, got %v", err)
+	}
+
+	stdout, stderr, err := executeCommandWithFactory(factory, "",
+		"market", "klines", "export",
+		"--market", "BTC_PERP",
+		"--interval", "15m",
+		"--since", "2026-03-01",
+		"--until", "2026-03-02",
+	)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	request := marketUseCases.lastKlines
+	if request == nil || request.Interval != "15m" || !request.Since.Equal(openTime) || !request.Until.Equal(openTime.Add(24*time.Hour)) {
+		t.Fatalf("unexpected klines request: %+v", request)
+	}
+	expected := "open_time,open,high,low,close,volume,quote_volume\n2026-03-01T00:00:00Z,60000,60100,59900,60050,12.5,750000\n"
+	if stdout != expected {
+		t.Fatalf("unexpected csv output: %q", stdout)
+	}
+	if !strings.Contains(stderr, "candles=1 fetched=1 cached=0") {
+		t.Fatalf("unexpected summary: %q", stderr)
+	}
+}
+
+func TestMarketWatchStreamsTableAndJSONLines(t *testing.T) {
+	eventTime := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	marketUseCases := &testMarketUseCases{watchEvents: []marketservice.WatchEvent{
+		{Type: marketservice.WatchEventStatus, Time: eventTime, Market: "BTC_PERP", Status: &marketservice.WatchStatus{Connected: true}},
+		{Type: marketservice.WatchEventQuote, Time: eventTime, Market: "BTC_PERP", Quote: &marketservice.WatchQuote{
+			LastPrice: "60000", BestBid: "59990", BestAsk: "60010", Spread: "20",
+		}},
+		{Type: marketservice.WatchEventFill, Time: eventTime, Market: "BTC_PERP", Fill: &marketservice.WatchFill{
+			OrderID: 7, Side: "buy", Price: "59990", Amount: "0.01", Filled: "0.01", Left: "0.02",
+		}},
+	}}
+	application := testApplication(&testCredentialStore{backendName: "os-keychain"}, &testSessionStore{}, nil)
+	application.Market = marketUseCases
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	stdout, _, err := executeCommandWithFactory(factory, "", "market", "watch", "--market", "BTC_PERP")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if marketUseCases.lastWatch == nil || marketUseCases.lastWatch.Market != "BTC_PERP" || !marketUseCases.lastWatch.Fills {
+		t.Fatalf("unexpected watch request: %+v", marketUseCases.lastWatch)
+	}
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 3 ||
+		!strings.Contains(lines[0], "status=connected") ||
+		!strings.Contains(lines[1], "best_bid=59990 best_ask=60010 spread=20") ||
+		!strings.Contains(lines[2], "fill order_id=7 client_order_id=- side=buy price=59990 amount=0.01") {
+		t.Fatalf("unexpected watch output: %q", stdout)
+	}
+
+	stdout, _, err = executeCommandWithFactory(factory, "", "market", "watch", "--market", "BTC_PERP", "--fills=false", "--output", "json")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if marketUseCases.lastWatch.Fills {
+		t.Fatalf("expected fills disabled, got %+v", marketUseCases.lastWatch)
+	}
+	lines = strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected one json event per line, got %q", stdout)
+	}
+	var event marketservice.WatchEvent
+	if err := json.Unmarshal([]byte(lines[2]), &event); err != nil || event.Type != "fill" || event.Fill == nil || event.Fill.OrderID != 7 {
+		t.Fatalf("unexpected json event %q: %v", lines[2], err)
+	}
+}
+
+func TestMarketWatchNotLoggedInSuggestsPublicMode(t *testing.T) {
+	marketUseCases := &testMarketUseCases{err: ports.ErrCredentialNotFound}
+	application := testApplication(&testCredentialStore{backendName: "os-keychain"}, &testSessionStore{}, nil)
+	application.Market = marketUseCases
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	_, _, err := executeCommandWithFactory(factory, "", "market", "watch", "--market", "BTC_PERP")
+	if err == nil || !strings.Contains(err.Error(), "--fills=false") {
+		t.Fatalf("expected not logged in hint, got %v", err)
+	}
+}
+
+type testMarketUseCases struct {
+	tickerResult marketservice.TickerResult
+	depthResult  marketservice.DepthResult
+	tradesResult marketservice.TradesResult
+	klinesResult marketservice.KlinesResult
+	watchEvents  []marketservice.WatchEvent
+	lastDepth    *marketservice.DepthRequest
+	lastTrades   *marketservice.TradesRequest
+	lastKlines   *marketservice.KlinesRequest
+	lastWatch    *marketservice.WatchRequest
+	err          error
+}
+
+func (useCases *testMarketUseCases) Ticker(context.Context, marketservice.TickerRequest) (marketservice.TickerResult, error) {
+	return useCases.tickerResult, useCases.err
+}
+
+func (useCases *testMarketUseCases) Depth(_ context.Context, request marketservice.DepthRequest) (marketservice.DepthResult, error) {
+	useCases.lastDepth = &request
+	return useCases.depthResult, useCases.err
+}
+
+func (useCases *testMarketUseCases) Trades(_ context.Context, request marketservice.TradesRequest) (marketservice.TradesResult, error) {
+	useCases.lastTrades = &request
+	return useCases.tradesResult, useCases.err
+}
+
+func (useCases *testMarketUseCases) Klines(_ context.Context, request marketservice.KlinesRequest) (marketservice.KlinesResult, error) {
+	useCases.lastKlines = &request
+	return useCases.klinesResult, useCases.err
+}
+
+func (useCases *testMarketUseCases) Watch(
+	_ context.Context,
+	request marketservice.WatchRequest,
+	emit func(marketservice.WatchEvent) error,
+) error {
+	useCases.lastWatch = &request
+	for _, event := range useCases.watchEvents {
+		if err := emit(event); err != nil {
+			return err
+		}
+	}
+
+	return useCases.err
+}
+
 type testCollateralUseCases struct {
-	result      collateralservice.PlaceOrderResult
-	err         error
-	lastRequest collateralservice.PlaceOrderRequest
+	result           collateralservice.PlaceOrderResult
+	rangeResult      collateralservice.RangePlanResult
+	err              error
+	lastRequest      collateralservice.PlaceOrderRequest
+	lastRangeRequest collateralservice.RangePlanRequest
+	lastSubmit       *collateralservice.RangeSubmitRequest
+	lastCancel       *collateralservice.CancelOrderRequest
+	listResult       collateralservice.ListOrdersResult
+	lastList         *collateralservice.ListOrdersRequest
+	historyResult    collateralservice.OrderHistoryResult
+	tradesResult     collateralservice.TradesResult
+	lastHistory      *collateralservice.HistoryRequest
+	positionsResult  collateralservice.PositionsResult
+	accountResult    collateralservice.AccountResult
+	lastLeverage     int
+	lastHedgeMode    *bool
+	closeResult      collateralservice.ClosePositionResult
+	lastClose        *collateralservice.ClosePositionRequest
 }
 
 func (useCases *testCollateralUseCases) PlaceOrder(
@@ -548,6 +1306,123 @@ func (useCases *testCollateralUseCases) PlaceOrder(
 	return useCases.result, nil
 }
 
+func (useCases *testCollateralUseCases) PlanRange(
+	_ context.Context,
+	request collateralservice.RangePlanRequest,
+) (collateralservice.RangePlanResult, error) {
+	useCases.lastRangeRequest = request
+	if useCases.err != nil {
+		return collateralservice.RangePlanResult{}, useCases.err
+	}
+
+	return useCases.rangeResult, nil
+}
+
+func (useCases *testCollateralUseCases) SubmitRange(
+	_ context.Context,
+	request collateralservice.RangeSubmitRequest,
+) (collateralservice.RangePlanResult, error) {
+	useCases.lastSubmit = &request
+	if useCases.err != nil {
+		return collateralservice.RangePlanResult{}, useCases.err
+	}
+
+	return useCases.rangeResult, nil
+}
+
+func (useCases *testCollateralUseCases) CancelOrders(
+	_ context.Context,
+	request collateralservice.CancelOrderRequest,
+) (collateralservice.PlaceOrderResult, error) {
+	useCases.lastCancel = &request
+	if useCases.err != nil {
+		return collateralservice.PlaceOrderResult{}, useCases.err
+	}
+
+	return useCases.result, nil
+}
+
+func (useCases *testCollateralUseCases) ListOrders(
+	_ context.Context,
+	request collateralservice.ListOrdersRequest,
+) (collateralservice.ListOrdersResult, error) {
+	useCases.lastList = &request
+	if useCases.err != nil {
+		return collateralservice.ListOrdersResult{}, useCases.err
+	}
+
+	return useCases.listResult, nil
+}
+
+func (useCases *testCollateralUseCases) OrderHistory(
+	_ context.Context,
+	request collateralservice.HistoryRequest,
+) (collateralservice.OrderHistoryResult, error) {
+	useCases.lastHistory = &request
+	if useCases.err != nil {
+		return collateralservice.OrderHistoryResult{}, useCases.err
+	}
+
+	return useCases.historyResult, nil
+}
+
+func (useCases *testCollateralUseCases) Trades(
+	_ context.Context,
+	request collateralservice.HistoryRequest,
+) (collateralservice.TradesResult, error) {
+	useCases.lastHistory = &request
+	if useCases.err != nil {
+		return collateralservice.TradesResult{}, useCases.err
+	}
+
+	return useCases.tradesResult, nil
+}
+
+func (useCases *testCollateralUseCases) Positions(
+	_ context.Context,
+	_ collateralservice.PositionsRequest,
+) (collateralservice.PositionsResult, error) {
+	if useCases.err != nil {
+		return collateralservice.PositionsResult{}, useCases.err
+	}
+
+	return useCases.positionsResult, nil
+}
+
+func (useCases *testCollateralUseCases) Account(context.Context) (collateralservice.AccountResult, error) {
+	if useCases.err != nil {
+		return collateralservice.AccountResult{}, useCases.err
+	}
+
+	return useCases.accountResult, nil
+}
+
+func (useCases *testCollateralUseCases) GetLeverage(context.Context) (collateralservice.LeverageResult, error) {
+	return collateralservice.LeverageResult{Leverage: "10"}, useCases.err
+}
+
+func (useCases *testCollateralUseCases) SetLeverage(_ context.Context, leverage int) (collateralservice.LeverageResult, error) {
+	useCases.lastLeverage = leverage
+	return collateralservice.LeverageResult{Leverage: strconv.Itoa(leverage)}, useCases.err
+}
+
+func (useCases *testCollateralUseCases) GetHedgeMode(context.Context) (collateralservice.HedgeModeResult, error) {
+	return collateralservice.HedgeModeResult{HedgeMode: true}, useCases.err
+}
+
+func (useCases *testCollateralUseCases) SetHedgeMode(_ context.Context, enabled bool) (collateralservice.HedgeModeResult, error) {
+	useCases.lastHedgeMode = &enabled
+	return collateralservice.HedgeModeResult{HedgeMode: enabled}, useCases.err
+}
+
+func (useCases *testCollateralUseCases) ClosePosition(
+	_ context.Context,
+	request collateralservice.ClosePositionRequest,
+) (collateralservice.ClosePositionResult, error) {
+	useCases.lastClose = &request
+	return useCases.closeResult, useCases.err
+}
+
 type testCredentialStore struct {
 	backendName string
 	credential  *domainauth.Credential
@@ -654,7 +1529,9 @@ func (clock testClock) Now() time.Time {
 }
 
 type testCredentialVerifier struct {
-	err error
+	err         error
+	permissions []ports.EndpointPermission
+	market      string
 }
 
 func (verifier *testCredentialVerifier) Verify(_ context.Context, _ domainauth.Credential) (ports.CredentialVerificationResult, error) {
@@ -664,3 +1541,220 @@ func (verifier *testCredentialVerifier) Verify(_ context.Context, _ domainauth.C
 
 	return ports.CredentialVerificationResult{Endpoint: "/api/v4/collateral-account/hedge-mode"}, nil
 }
+
+func (verifier *testCredentialVerifier) ProbePermissions(
+	_ context.Context,
+	_ domainauth.Credential,
+	market string,
+) ([]ports.EndpointPermission, error) {
+	if verifier.err != nil {
+		return nil, verifier.err
+	}
+	verifier.market = market
+
+	return verifier.permissions, nil
+}
+
+func TestAuthLoginRejectsPassphraseWithoutEncryptedFileBackend(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	_, _, err := executeCommandWithInput("api-key-1\nsecret-1\n", "auth", "login", "--passphrase-stdin")
+	if err == nil {
+		t.Fatal("expected flag validation error")
+	}
+	if !strings.Contains(err.Error(), "passphrase flags require --backend encrypted-file") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestAuthLoginRejectsEmptyPassphraseFile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	passphraseFile := filepath.Join(t.TempDir(), "passphrase")
+	if err := os.WriteFile(passphraseFile, []byte("\n"), 0o600); err != nil {
+		t.Fatalf("write passphrase file: %v", err)
+	}
+
+	_, _, err := executeCommandWithInput("api-key-1\nsecret-1\n", "auth", "login", "--backend", "encrypted-file", "--passphrase-file", passphraseFile)
+	if err == nil || !strings.Contains(err.Error(), "encrypted-file backend needs a passphrase") {
+		t.Fatalf("expected passphrase required error, got %v", err)
+	}
+}
+
+func TestCredentialsFromFlagReachesFactory(t *testing.T) {
+	var captured appcontainer.Options
+	app := testApplication(&testCredentialStore{backendName: "fd"}, &testSessionStore{}, nil)
+	command := newRootCmd(func(options appcontainer.Options) (*appcontainer.Application, error) {
+		captured = options
+		return app, nil
+	})
+	command.SetOut(&bytes.Buffer{})
+	command.SetErr(&bytes.Buffer{})
+	command.SetArgs([]string{"--credentials-from", "fd:3", "auth", "status"})
+
+	if err := command.Execute(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	expected := appcontainer.CredentialSource{Kind: appcontainer.CredentialSourceFD, FD: 3}
+	if captured.Credentials != expected {
+		t.Fatalf("unexpected credential source: %+v", captured.Credentials)
+	}
+}
+
+func TestCredentialsFromFlagRejectsInvalidValue(t *testing.T) {
+	_, _, err := executeCommand("--credentials-from", "file", "auth", "status")
+	if err == nil || !strings.Contains(err.Error(), "use env or fd:N") {
+		t.Fatalf("expected invalid credential source error, got %v", err)
+	}
+}
+
+func TestCredentialsFromFlagRejectsStoredCredentialCommands(t *testing.T) {
+	_, _, err := executeCommandWithInput("api-key-1\nsecret-1\n", "--credentials-from", "env", "auth", "login")
+	if err == nil || !strings.Contains(err.Error(), "--credentials-from is read-only") {
+		t.Fatalf("expected read-only error, got %v", err)
+	}
+}
+
+type testRotateAuthUseCases struct {
+	appcontainer.AuthUseCases
+	request authservice.RotateRequest
+}
+
+func (useCases *testRotateAuthUseCases) Rotate(
+	_ context.Context,
+	request authservice.RotateRequest,
+) (authservice.RotateResult, error) {
+	useCases.request = request
+	return authservice.RotateResult{
+		Backend:            "os-keychain",
+		APIKeyHint:         "new-***5678",
+		PreviousAPIKeyHint: "old-***1234",
+		HedgeMode:          true,
+		SavedAt:            "2026-03-01T09:30:00Z",
+	}, nil
+}
+
+func TestAuthRotateReadsStdinAndPrintsResult(t *testing.T) {
+	useCases := &testRotateAuthUseCases{}
+	factory := func() (*appcontainer.Application, error) { return appcontainer.New(useCases), nil }
+
+	stdout, _, err := executeCommandWithFactory(factory, "new-key-5678\nnew-secret\n", "auth", "rotate", "--allow-empty-account")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if useCases.request.APIKey != "new-key-5678" || !useCases.request.AllowEmptyAccount {
+		t.Fatalf("unexpected rotate request: %+v", useCases.request)
+	}
+	expected := "rotated=true backend=os-keychain api_key=new-***5678 previous_api_key=old-***1234 hedge_mode=true saved_at=2026-03-01T09:30:00Z\n"
+	if stdout != expected {
+		t.Fatalf("unexpected output: %q", stdout)
+	}
+}
+
+type testUnlockAuthUseCases struct {
+	appcontainer.AuthUseCases
+	request authservice.UnlockRequest
+}
+
+func (useCases *testUnlockAuthUseCases) Unlock(
+	_ context.Context,
+	request authservice.UnlockRequest,
+) (authservice.UnlockResult, error) {
+	useCases.request = request
+	return authservice.UnlockResult{
+		Backend:    "os-keychain",
+		APIKeyHint: "abc***1234",
+		ExpiresAt:  time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+	}, nil
+}
+
+func TestAuthUnlockPassesTTLAndPrintsExpiry(t *testing.T) {
+	useCases := &testUnlockAuthUseCases{}
+	factory := func() (*appcontainer.Application, error) { return appcontainer.New(useCases), nil }
+
+	stdout, _, err := executeCommandWithFactory(factory, "", "auth", "unlock", "--ttl", "30m")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if useCases.request.TTL != 30*time.Minute {
+		t.Fatalf("unexpected unlock ttl: %s", useCases.request.TTL)
+	}
+	expected := "unlocked=true backend=os-keychain api_key=abc***1234 expires_at=2026-03-01T10:00:00Z\n"
+	if stdout != expected {
+		t.Fatalf("unexpected output: %q", stdout)
+	}
+}
+
+func TestAuthUnlockRejectsTTLAboveLimit(t *testing.T) {
+	application := testApplication(&testCredentialStore{}, &testSessionStore{}, nil)
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	_, _, err := executeCommandWithFactory(factory, "", "auth", "unlock", "--ttl", "13h")
+	if err == nil || err.Error() != "--ttl must be positive and at most 12h" {
+		t.Fatalf("expected ttl error, got %v", err)
+	}
+}
+
+func TestAuthLockWithoutAgentReportsNothingUnlocked(t *testing.T) {
+	application := testApplication(&testCredentialStore{}, &testSessionStore{}, nil)
+	factory := func() (*appcontainer.Application, error) { return application, nil }
+
+	stdout, _, err := executeCommandWithFactory(factory, "", "auth", "lock")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if stdout != "locked=true was_unlocked=false\n" {
+		t.Fatalf("unexpected output: %q", stdout)
+	}
+}
+
+type testUseAuthUseCases struct {
+	appcontainer.AuthUseCases
+	request authservice.UseRequest
+}
+
+func (useCases *testUseAuthUseCases) Use(_ context.Context, request authservice.UseRequest) (authservice.UseResult, error) {
+	useCases.request = request
+	if request.Account == "missing" {
+		return authservice.UseResult{}, fmt.Errorf("%w: %s", authservice.ErrAccountNotFound, request.Account)
+	}
+
+	return authservice.UseResult{Account: request.Account, LoggedIn: true, APIKeyHint: "gr***78"}, nil
+}
+
+func TestAuthUseSelectsAccount(t *testing.T) {
+	useCases := &testUseAuthUseCases{}
+	factory := func() (*appcontainer.Application, error) { return appcontainer.New(useCases), nil }
+
+	stdout, _, err := executeCommandWithFactory(factory, "", "auth", "use", "grid")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if useCases.request.Account != "grid" {
+		t.Fatalf("unexpected use request: %+v", useCases.request)
+	}
+	if stdout != "account=grid logged_in=true api_key=gr***78\n" {
+		t.Fatalf("unexpected output: %q", stdout)
+	}
+
+	_, _, err = executeCommandWithFactory(factory, "", "auth", "use", "missing")
+	if err == nil || err.Error() != "account has no stored login; run wbcli --account <name> auth login first" {
+		t.Fatalf("expected missing account error, got %v", err)
+	}
+}
+
+func TestAccountFlagValidation(t *testing.T) {
+	factory := func() (*appcontainer.Application, error) {
+		t.Fatal("factory must not be called")
+		return nil, nil
+	}
+
+	_, _, err := executeCommandWithFactory(factory, "", "--account", "Main/../x", "auth", "status")
+	if err == nil || !strings.Contains(err.Error(), "invalid account") {
+		t.Fatalf("expected invalid account error, got %v", err)
+	}
+
+	_, _, err = executeCommandWithFactory(factory, "", "--account", "grid", "--credentials-from", "env", "auth", "status")
+	if err == nil || !strings.Contains(err.Error(), "cannot be combined with --credentials-from") {
+		t.Fatalf("expected combination error, got %v", err)
+	}
+}
@@ -38,6 +38,132 @@ func (_m *MockAuthUseCases) EXPECT() *MockAuthUseCases_Expecter {
 	return &MockAuthUseCases_Expecter{mock: &_m.Mock}
 }
 
+// CheckPermissions provides a mock function for the type MockAuthUseCases
+func (_mock *MockAuthUseCases) CheckPermissions(ctx context.Context, request auth.CheckPermissionsRequest) (auth.CheckPermissionsResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckPermissions")
+	}
+
+	var r0 auth.CheckPermissionsResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.CheckPermissionsRequest) (auth.CheckPermissionsResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.CheckPermissionsRequest) auth.CheckPermissionsResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(auth.CheckPermissionsResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, auth.CheckPermissionsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUseCases_CheckPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckPermissions'
+type MockAuthUseCases_CheckPermissions_Call struct {
+	*mock.Call
+}
+
+// CheckPermissions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request auth.CheckPermissionsRequest
+func (_e *MockAuthUseCases_Expecter) CheckPermissions(ctx interface{}, request interface{}) *MockAuthUseCases_CheckPermissions_Call {
+	return &MockAuthUseCases_CheckPermissions_Call{Call: _e.mock.On("CheckPermissions", ctx, request)}
+}
+
+func (_c *MockAuthUseCases_CheckPermissions_Call) Run(run func(ctx context.Context, request auth.CheckPermissionsRequest)) *MockAuthUseCases_CheckPermissions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 auth.CheckPermissionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(auth.CheckPermissionsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUseCases_CheckPermissions_Call) Return(checkPermissionsResult auth.CheckPermissionsResult, err error) *MockAuthUseCases_CheckPermissions_Call {
+	_c.Call.Return(checkPermissionsResult, err)
+	return _c
+}
+
+func (_c *MockAuthUseCases_CheckPermissions_Call) RunAndReturn(run func(ctx context.Context, request auth.CheckPermissionsRequest) (auth.CheckPermissionsResult, error)) *MockAuthUseCases_CheckPermissions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Lock provides a mock function for the type MockAuthUseCases
+func (_mock *MockAuthUseCases) Lock(ctx context.Context) (auth.LockResult, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Lock")
+	}
+
+	var r0 auth.LockResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (auth.LockResult, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) auth.LockResult); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(auth.LockResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUseCases_Lock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Lock'
+type MockAuthUseCases_Lock_Call struct {
+	*mock.Call
+}
+
+// Lock is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockAuthUseCases_Expecter) Lock(ctx interface{}) *MockAuthUseCases_Lock_Call {
+	return &MockAuthUseCases_Lock_Call{Call: _e.mock.On("Lock", ctx)}
+}
+
+func (_c *MockAuthUseCases_Lock_Call) Run(run func(ctx context.Context)) *MockAuthUseCases_Lock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUseCases_Lock_Call) Return(lockResult auth.LockResult, err error) *MockAuthUseCases_Lock_Call {
+	_c.Call.Return(lockResult, err)
+	return _c
+}
+
+func (_c *MockAuthUseCases_Lock_Call) RunAndReturn(run func(ctx context.Context) (auth.LockResult, error)) *MockAuthUseCases_Lock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Login provides a mock function for the type MockAuthUseCases
 func (_mock *MockAuthUseCases) Login(ctx context.Context, request auth.LoginRequest) (auth.LoginResult, error) {
 	ret := _mock.Called(ctx, request)
@@ -164,6 +290,72 @@ func (_c *MockAuthUseCases_Logout_Call) RunAndReturn(run func(ctx context.Contex
 	return _c
 }
 
+// Rotate provides a mock function for the type MockAuthUseCases
+func (_mock *MockAuthUseCases) Rotate(ctx context.Context, request auth.RotateRequest) (auth.RotateResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rotate")
+	}
+
+	var r0 auth.RotateResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.RotateRequest) (auth.RotateResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.RotateRequest) auth.RotateResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(auth.RotateResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, auth.RotateRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUseCases_Rotate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rotate'
+type MockAuthUseCases_Rotate_Call struct {
+	*mock.Call
+}
+
+// Rotate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request auth.RotateRequest
+func (_e *MockAuthUseCases_Expecter) Rotate(ctx interface{}, request interface{}) *MockAuthUseCases_Rotate_Call {
+	return &MockAuthUseCases_Rotate_Call{Call: _e.mock.On("Rotate", ctx, request)}
+}
+
+func (_c *MockAuthUseCases_Rotate_Call) Run(run func(ctx context.Context, request auth.RotateRequest)) *MockAuthUseCases_Rotate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 auth.RotateRequest
+		if args[1] != nil {
+			arg1 = args[1].(auth.RotateRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUseCases_Rotate_Call) Return(rotateResult auth.RotateResult, err error) *MockAuthUseCases_Rotate_Call {
+	_c.Call.Return(rotateResult, err)
+	return _c
+}
+
+func (_c *MockAuthUseCases_Rotate_Call) RunAndReturn(run func(ctx context.Context, request auth.RotateRequest) (auth.RotateResult, error)) *MockAuthUseCases_Rotate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Status provides a mock function for the type MockAuthUseCases
 func (_mock *MockAuthUseCases) Status(ctx context.Context) (auth.StatusResult, error) {
 	ret := _mock.Called(ctx)
@@ -223,3 +415,135 @@ func (_c *MockAuthUseCases_Status_Call) RunAndReturn(run func(ctx context.Contex
 	_c.Call.Return(run)
 	return _c
 }
+
+// Unlock provides a mock function for the type MockAuthUseCases
+func (_mock *MockAuthUseCases) Unlock(ctx context.Context, request auth.UnlockRequest) (auth.UnlockResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unlock")
+	}
+
+	var r0 auth.UnlockResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.UnlockRequest) (auth.UnlockResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.UnlockRequest) auth.UnlockResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(auth.UnlockResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, auth.UnlockRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUseCases_Unlock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Unlock'
+type MockAuthUseCases_Unlock_Call struct {
+	*mock.Call
+}
+
+// Unlock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request auth.UnlockRequest
+func (_e *MockAuthUseCases_Expecter) Unlock(ctx interface{}, request interface{}) *MockAuthUseCases_Unlock_Call {
+	return &MockAuthUseCases_Unlock_Call{Call: _e.mock.On("Unlock", ctx, request)}
+}
+
+func (_c *MockAuthUseCases_Unlock_Call) Run(run func(ctx context.Context, request auth.UnlockRequest)) *MockAuthUseCases_Unlock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 auth.UnlockRequest
+		if args[1] != nil {
+			arg1 = args[1].(auth.UnlockRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUseCases_Unlock_Call) Return(unlockResult auth.UnlockResult, err error) *MockAuthUseCases_Unlock_Call {
+	_c.Call.Return(unlockResult, err)
+	return _c
+}
+
+func (_c *MockAuthUseCases_Unlock_Call) RunAndReturn(run func(ctx context.Context, request auth.UnlockRequest) (auth.UnlockResult, error)) *MockAuthUseCases_Unlock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Use provides a mock function for the type MockAuthUseCases
+func (_mock *MockAuthUseCases) Use(ctx context.Context, request auth.UseRequest) (auth.UseResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Use")
+	}
+
+	var r0 auth.UseResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.UseRequest) (auth.UseResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.UseRequest) auth.UseResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(auth.UseResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, auth.UseRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAuthUseCases_Use_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Use'
+type MockAuthUseCases_Use_Call struct {
+	*mock.Call
+}
+
+// Use is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request auth.UseRequest
+func (_e *MockAuthUseCases_Expecter) Use(ctx interface{}, request interface{}) *MockAuthUseCases_Use_Call {
+	return &MockAuthUseCases_Use_Call{Call: _e.mock.On("Use", ctx, request)}
+}
+
+func (_c *MockAuthUseCases_Use_Call) Run(run func(ctx context.Context, request auth.UseRequest)) *MockAuthUseCases_Use_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 auth.UseRequest
+		if args[1] != nil {
+			arg1 = args[1].(auth.UseRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAuthUseCases_Use_Call) Return(useResult auth.UseResult, err error) *MockAuthUseCases_Use_Call {
+	_c.Call.Return(useResult, err)
+	return _c
+}
+
+func (_c *MockAuthUseCases_Use_Call) RunAndReturn(run func(ctx context.Context, request auth.UseRequest) (auth.UseResult, error)) *MockAuthUseCases_Use_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -106,6 +106,86 @@ func (_c *MockCollateralOrderExecutor_GetCollateralAccountHedgeMode_Call) RunAnd
 	return _c
 }
 
+// PlaceCollateralBulkLimitOrder provides a mock function for the type MockCollateralOrderExecutor
+func (_mock *MockCollateralOrderExecutor) PlaceCollateralBulkLimitOrder(ctx context.Context, credential auth.Credential, orders []ports.CollateralLimitOrderRequest, stopOnFail bool) ([]ports.CollateralBulkOrderResult, error) {
+	ret := _mock.Called(ctx, credential, orders, stopOnFail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceCollateralBulkLimitOrder")
+	}
+
+	var r0 []ports.CollateralBulkOrderResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.Credential, []ports.CollateralLimitOrderRequest, bool) ([]ports.CollateralBulkOrderResult, error)); ok {
+		return returnFunc(ctx, credential, orders, stopOnFail)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.Credential, []ports.CollateralLimitOrderRequest, bool) []ports.CollateralBulkOrderResult); ok {
+		r0 = returnFunc(ctx, credential, orders, stopOnFail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]ports.CollateralBulkOrderResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, auth.Credential, []ports.CollateralLimitOrderRequest, bool) error); ok {
+		r1 = returnFunc(ctx, credential, orders, stopOnFail)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollateralOrderExecutor_PlaceCollateralBulkLimitOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceCollateralBulkLimitOrder'
+type MockCollateralOrderExecutor_PlaceCollateralBulkLimitOrder_Call struct {
+	*mock.Call
+}
+
+// PlaceCollateralBulkLimitOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - credential auth.Credential
+//   - orders []ports.CollateralLimitOrderRequest
+//   - stopOnFail bool
+func (_e *MockCollateralOrderExecutor_Expecter) PlaceCollateralBulkLimitOrder(ctx interface{}, credential interface{}, orders interface{}, stopOnFail interface{}) *MockCollateralOrderExecutor_PlaceCollateralBulkLimitOrder_Call {
+	return &MockCollateralOrderExecutor_PlaceCollateralBulkLimitOrder_Call{Call: _e.mock.On("PlaceCollateralBulkLimitOrder", ctx, credential, orders, stopOnFail)}
+}
+
+func (_c *MockCollateralOrderExecutor_PlaceCollateralBulkLimitOrder_Call) Run(run func(ctx context.Context, credential auth.Credential, orders []ports.CollateralLimitOrderRequest, stopOnFail bool)) *MockCollateralOrderExecutor_PlaceCollateralBulkLimitOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 auth.Credential
+		if args[1] != nil {
+			arg1 = args[1].(auth.Credential)
+		}
+		var arg2 []ports.CollateralLimitOrderRequest
+		if args[2] != nil {
+			arg2 = args[2].([]ports.CollateralLimitOrderRequest)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollateralOrderExecutor_PlaceCollateralBulkLimitOrder_Call) Return(collateralBulkOrderResults []ports.CollateralBulkOrderResult, err error) *MockCollateralOrderExecutor_PlaceCollateralBulkLimitOrder_Call {
+	_c.Call.Return(collateralBulkOrderResults, err)
+	return _c
+}
+
+func (_c *MockCollateralOrderExecutor_PlaceCollateralBulkLimitOrder_Call) RunAndReturn(run func(ctx context.Context, credential auth.Credential, orders []ports.CollateralLimitOrderRequest, stopOnFail bool) ([]ports.CollateralBulkOrderResult, error)) *MockCollateralOrderExecutor_PlaceCollateralBulkLimitOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PlaceCollateralLimitOrder provides a mock function for the type MockCollateralOrderExecutor
 func (_mock *MockCollateralOrderExecutor) PlaceCollateralLimitOrder(ctx context.Context, credential auth.Credential, request ports.CollateralLimitOrderRequest) (json.RawMessage, error) {
 	ret := _mock.Called(ctx, credential, request)
@@ -179,3 +259,75 @@ func (_c *MockCollateralOrderExecutor_PlaceCollateralLimitOrder_Call) RunAndRetu
 	_c.Call.Return(run)
 	return _c
 }
+
+// PlaceCollateralTakerOrder provides a mock function for the type MockCollateralOrderExecutor
+func (_mock *MockCollateralOrderExecutor) PlaceCollateralTakerOrder(ctx context.Context, credential auth.Credential, request ports.CollateralTakerOrderRequest) (ports.CollateralPlacedOrder, error) {
+	ret := _mock.Called(ctx, credential, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceCollateralTakerOrder")
+	}
+
+	var r0 ports.CollateralPlacedOrder
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.Credential, ports.CollateralTakerOrderRequest) (ports.CollateralPlacedOrder, error)); ok {
+		return returnFunc(ctx, credential, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, auth.Credential, ports.CollateralTakerOrderRequest) ports.CollateralPlacedOrder); ok {
+		r0 = returnFunc(ctx, credential, request)
+	} else {
+		r0 = ret.Get(0).(ports.CollateralPlacedOrder)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, auth.Credential, ports.CollateralTakerOrderRequest) error); ok {
+		r1 = returnFunc(ctx, credential, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollateralOrderExecutor_PlaceCollateralTakerOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceCollateralTakerOrder'
+type MockCollateralOrderExecutor_PlaceCollateralTakerOrder_Call struct {
+	*mock.Call
+}
+
+// PlaceCollateralTakerOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - credential auth.Credential
+//   - request ports.CollateralTakerOrderRequest
+func (_e *MockCollateralOrderExecutor_Expecter) PlaceCollateralTakerOrder(ctx interface{}, credential interface{}, request interface{}) *MockCollateralOrderExecutor_PlaceCollateralTakerOrder_Call {
+	return &MockCollateralOrderExecutor_PlaceCollateralTakerOrder_Call{Call: _e.mock.On("PlaceCollateralTakerOrder", ctx, credential, request)}
+}
+
+func (_c *MockCollateralOrderExecutor_PlaceCollateralTakerOrder_Call) Run(run func(ctx context.Context, credential auth.Credential, request ports.CollateralTakerOrderRequest)) *MockCollateralOrderExecutor_PlaceCollateralTakerOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 auth.Credential
+		if args[1] != nil {
+			arg1 = args[1].(auth.Credential)
+		}
+		var arg2 ports.CollateralTakerOrderRequest
+		if args[2] != nil {
+			arg2 = args[2].(ports.CollateralTakerOrderRequest)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollateralOrderExecutor_PlaceCollateralTakerOrder_Call) Return(collateralPlacedOrder ports.CollateralPlacedOrder, err error) *MockCollateralOrderExecutor_PlaceCollateralTakerOrder_Call {
+	_c.Call.Return(collateralPlacedOrder, err)
+	return _c
+}
+
+func (_c *MockCollateralOrderExecutor_PlaceCollateralTakerOrder_Call) RunAndReturn(run func(ctx context.Context, credential auth.Credential, request ports.CollateralTakerOrderRequest) (ports.CollateralPlacedOrder, error)) *MockCollateralOrderExecutor_PlaceCollateralTakerOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -38,25 +38,85 @@ func (_m *MockCollateralUseCases) EXPECT() *MockCollateralUseCases_Expecter {
 	return &MockCollateralUseCases_Expecter{mock: &_m.Mock}
 }
 
-// PlaceOrder provides a mock function for the type MockCollateralUseCases
-func (_mock *MockCollateralUseCases) PlaceOrder(ctx context.Context, request collateral.PlaceOrderRequest) (collateral.PlaceOrderResult, error) {
+// Account provides a mock function for the type MockCollateralUseCases
+func (_mock *MockCollateralUseCases) Account(ctx context.Context) (collateral.AccountResult, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Account")
+	}
+
+	var r0 collateral.AccountResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (collateral.AccountResult, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) collateral.AccountResult); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(collateral.AccountResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollateralUseCases_Account_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Account'
+type MockCollateralUseCases_Account_Call struct {
+	*mock.Call
+}
+
+// Account is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCollateralUseCases_Expecter) Account(ctx interface{}) *MockCollateralUseCases_Account_Call {
+	return &MockCollateralUseCases_Account_Call{Call: _e.mock.On("Account", ctx)}
+}
+
+func (_c *MockCollateralUseCases_Account_Call) Run(run func(ctx context.Context)) *MockCollateralUseCases_Account_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollateralUseCases_Account_Call) Return(accountResult collateral.AccountResult, err error) *MockCollateralUseCases_Account_Call {
+	_c.Call.Return(accountResult, err)
+	return _c
+}
+
+func (_c *MockCollateralUseCases_Account_Call) RunAndReturn(run func(ctx context.Context) (collateral.AccountResult, error)) *MockCollateralUseCases_Account_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CancelOrders provides a mock function for the type MockCollateralUseCases
+func (_mock *MockCollateralUseCases) CancelOrders(ctx context.Context, request collateral.CancelOrderRequest) (collateral.PlaceOrderResult, error) {
 	ret := _mock.Called(ctx, request)
 
 	if len(ret) == 0 {
-		panic("no return value specified for PlaceOrder")
+		panic("no return value specified for CancelOrders")
 	}
 
 	var r0 collateral.PlaceOrderResult
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.PlaceOrderRequest) (collateral.PlaceOrderResult, error)); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.CancelOrderRequest) (collateral.PlaceOrderResult, error)); ok {
 		return returnFunc(ctx, request)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.PlaceOrderRequest) collateral.PlaceOrderResult); ok {
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.CancelOrderRequest) collateral.PlaceOrderResult); ok {
 		r0 = returnFunc(ctx, request)
 	} else {
 		r0 = ret.Get(0).(collateral.PlaceOrderResult)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, collateral.PlaceOrderRequest) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, collateral.CancelOrderRequest) error); ok {
 		r1 = returnFunc(ctx, request)
 	} else {
 		r1 = ret.Error(1)
@@ -64,27 +124,27 @@ func (_mock *MockCollateralUseCases) PlaceOrder(ctx context.Context, request col
 	return r0, r1
 }
 
-// MockCollateralUseCases_PlaceOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceOrder'
-type MockCollateralUseCases_PlaceOrder_Call struct {
+// MockCollateralUseCases_CancelOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelOrders'
+type MockCollateralUseCases_CancelOrders_Call struct {
 	*mock.Call
 }
 
-// PlaceOrder is a helper method to define mock.On call
+// CancelOrders is a helper method to define mock.On call
 //   - ctx context.Context
-//   - request collateral.PlaceOrderRequest
-func (_e *MockCollateralUseCases_Expecter) PlaceOrder(ctx interface{}, request interface{}) *MockCollateralUseCases_PlaceOrder_Call {
-	return &MockCollateralUseCases_PlaceOrder_Call{Call: _e.mock.On("PlaceOrder", ctx, request)}
+//   - request collateral.CancelOrderRequest
+func (_e *MockCollateralUseCases_Expecter) CancelOrders(ctx interface{}, request interface{}) *MockCollateralUseCases_CancelOrders_Call {
+	return &MockCollateralUseCases_CancelOrders_Call{Call: _e.mock.On("CancelOrders", ctx, request)}
 }
 
-func (_c *MockCollateralUseCases_PlaceOrder_Call) Run(run func(ctx context.Context, request collateral.PlaceOrderRequest)) *MockCollateralUseCases_PlaceOrder_Call {
+func (_c *MockCollateralUseCases_CancelOrders_Call) Run(run func(ctx context.Context, request collateral.CancelOrderRequest)) *MockCollateralUseCases_CancelOrders_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 collateral.PlaceOrderRequest
+		var arg1 collateral.CancelOrderRequest
 		if args[1] != nil {
-			arg1 = args[1].(collateral.PlaceOrderRequest)
+			arg1 = args[1].(collateral.CancelOrderRequest)
 		}
 		run(
 			arg0,
@@ -94,12 +154,792 @@ func (_c *MockCollateralUseCases_PlaceOrder_Call) Run(run func(ctx context.Conte
 	return _c
 }
 
-func (_c *MockCollateralUseCases_PlaceOrder_Call) Return(placeOrderResult collateral.PlaceOrderResult, err error) *MockCollateralUseCases_PlaceOrder_Call {
+func (_c *MockCollateralUseCases_CancelOrders_Call) Return(placeOrderResult collateral.PlaceOrderResult, err error) *MockCollateralUseCases_CancelOrders_Call {
 	_c.Call.Return(placeOrderResult, err)
 	return _c
 }
 
-func (_c *MockCollateralUseCases_PlaceOrder_Call) RunAndReturn(run func(ctx context.Context, request collateral.PlaceOrderRequest) (collateral.PlaceOrderResult, error)) *MockCollateralUseCases_PlaceOrder_Call {
+func (_c *MockCollateralUseCases_CancelOrders_Call) RunAndReturn(run func(ctx context.Context, request collateral.CancelOrderRequest) (collateral.PlaceOrderResult, error)) *MockCollateralUseCases_CancelOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClosePosition provides a mock function for the type MockCollateralUseCases
+func (_mock *MockCollateralUseCases) ClosePosition(ctx context.Context, request collateral.ClosePositionRequest) (collateral.ClosePositionResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClosePosition")
+	}
+
+	var r0 collateral.ClosePositionResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.ClosePositionRequest) (collateral.ClosePositionResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.ClosePositionRequest) collateral.ClosePositionResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(collateral.ClosePositionResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, collateral.ClosePositionRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollateralUseCases_ClosePosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClosePosition'
+type MockCollateralUseCases_ClosePosition_Call struct {
+	*mock.Call
+}
+
+// ClosePosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request collateral.ClosePositionRequest
+func (_e *MockCollateralUseCases_Expecter) ClosePosition(ctx interface{}, request interface{}) *MockCollateralUseCases_ClosePosition_Call {
+	return &MockCollateralUseCases_ClosePosition_Call{Call: _e.mock.On("ClosePosition", ctx, request)}
+}
+
+func (_c *MockCollateralUseCases_ClosePosition_Call) Run(run func(ctx context.Context, request collateral.ClosePositionRequest)) *MockCollateralUseCases_ClosePosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 collateral.ClosePositionRequest
+		if args[1] != nil {
+			arg1 = args[1].(collateral.ClosePositionRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollateralUseCases_ClosePosition_Call) Return(closePositionResult collateral.ClosePositionResult, err error) *MockCollateralUseCases_ClosePosition_Call {
+	_c.Call.Return(closePositionResult, err)
+	return _c
+}
+
+func (_c *MockCollateralUseCases_ClosePosition_Call) RunAndReturn(run func(ctx context.Context, request collateral.ClosePositionRequest) (collateral.ClosePositionResult, error)) *MockCollateralUseCases_ClosePosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHedgeMode provides a mock function for the type MockCollateralUseCases
+func (_mock *MockCollateralUseCases) GetHedgeMode(ctx context.Context) (collateral.HedgeModeResult, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHedgeMode")
+	}
+
+	var r0 collateral.HedgeModeResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (collateral.HedgeModeResult, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) collateral.HedgeModeResult); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(collateral.HedgeModeResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollateralUseCases_GetHedgeMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHedgeMode'
+type MockCollateralUseCases_GetHedgeMode_Call struct {
+	*mock.Call
+}
+
+// GetHedgeMode is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCollateralUseCases_Expecter) GetHedgeMode(ctx interface{}) *MockCollateralUseCases_GetHedgeMode_Call {
+	return &MockCollateralUseCases_GetHedgeMode_Call{Call: _e.mock.On("GetHedgeMode", ctx)}
+}
+
+func (_c *MockCollateralUseCases_GetHedgeMode_Call) Run(run func(ctx context.Context)) *MockCollateralUseCases_GetHedgeMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollateralUseCases_GetHedgeMode_Call) Return(hedgeModeResult collateral.HedgeModeResult, err error) *MockCollateralUseCases_GetHedgeMode_Call {
+	_c.Call.Return(hedgeModeResult, err)
+	return _c
+}
+
+func (_c *MockCollateralUseCases_GetHedgeMode_Call) RunAndReturn(run func(ctx context.Context) (collateral.HedgeModeResult, error)) *MockCollateralUseCases_GetHedgeMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeverage provides a mock function for the type MockCollateralUseCases
+func (_mock *MockCollateralUseCases) GetLeverage(ctx context.Context) (collateral.LeverageResult, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeverage")
+	}
+
+	var r0 collateral.LeverageResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (collateral.LeverageResult, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) collateral.LeverageResult); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(collateral.LeverageResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollateralUseCases_GetLeverage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeverage'
+type MockCollateralUseCases_GetLeverage_Call struct {
+	*mock.Call
+}
+
+// GetLeverage is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockCollateralUseCases_Expecter) GetLeverage(ctx interface{}) *MockCollateralUseCases_GetLeverage_Call {
+	return &MockCollateralUseCases_GetLeverage_Call{Call: _e.mock.On("GetLeverage", ctx)}
+}
+
+func (_c *MockCollateralUseCases_GetLeverage_Call) Run(run func(ctx context.Context)) *MockCollateralUseCases_GetLeverage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollateralUseCases_GetLeverage_Call) Return(leverageResult collateral.LeverageResult, err error) *MockCollateralUseCases_GetLeverage_Call {
+	_c.Call.Return(leverageResult, err)
+	return _c
+}
+
+func (_c *MockCollateralUseCases_GetLeverage_Call) RunAndReturn(run func(ctx context.Context) (collateral.LeverageResult, error)) *MockCollateralUseCases_GetLeverage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOrders provides a mock function for the type MockCollateralUseCases
+func (_mock *MockCollateralUseCases) ListOrders(ctx context.Context, request collateral.ListOrdersRequest) (collateral.ListOrdersResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOrders")
+	}
+
+	var r0 collateral.ListOrdersResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.ListOrdersRequest) (collateral.ListOrdersResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.ListOrdersRequest) collateral.ListOrdersResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(collateral.ListOrdersResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, collateral.ListOrdersRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollateralUseCases_ListOrders_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOrders'
+type MockCollateralUseCases_ListOrders_Call struct {
+	*mock.Call
+}
+
+// ListOrders is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request collateral.ListOrdersRequest
+func (_e *MockCollateralUseCases_Expecter) ListOrders(ctx interface{}, request interface{}) *MockCollateralUseCases_ListOrders_Call {
+	return &MockCollateralUseCases_ListOrders_Call{Call: _e.mock.On("ListOrders", ctx, request)}
+}
+
+func (_c *MockCollateralUseCases_ListOrders_Call) Run(run func(ctx context.Context, request collateral.ListOrdersRequest)) *MockCollateralUseCases_ListOrders_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 collateral.ListOrdersRequest
+		if args[1] != nil {
+			arg1 = args[1].(collateral.ListOrdersRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollateralUseCases_ListOrders_Call) Return(listOrdersResult collateral.ListOrdersResult, err error) *MockCollateralUseCases_ListOrders_Call {
+	_c.Call.Return(listOrdersResult, err)
+	return _c
+}
+
+func (_c *MockCollateralUseCases_ListOrders_Call) RunAndReturn(run func(ctx context.Context, request collateral.ListOrdersRequest) (collateral.ListOrdersResult, error)) *MockCollateralUseCases_ListOrders_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OrderHistory provides a mock function for the type MockCollateralUseCases
+func (_mock *MockCollateralUseCases) OrderHistory(ctx context.Context, request collateral.HistoryRequest) (collateral.OrderHistoryResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OrderHistory")
+	}
+
+	var r0 collateral.OrderHistoryResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.HistoryRequest) (collateral.OrderHistoryResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.HistoryRequest) collateral.OrderHistoryResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(collateral.OrderHistoryResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, collateral.HistoryRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollateralUseCases_OrderHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OrderHistory'
+type MockCollateralUseCases_OrderHistory_Call struct {
+	*mock.Call
+}
+
+// OrderHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request collateral.HistoryRequest
+func (_e *MockCollateralUseCases_Expecter) OrderHistory(ctx interface{}, request interface{}) *MockCollateralUseCases_OrderHistory_Call {
+	return &MockCollateralUseCases_OrderHistory_Call{Call: _e.mock.On("OrderHistory", ctx, request)}
+}
+
+func (_c *MockCollateralUseCases_OrderHistory_Call) Run(run func(ctx context.Context, request collateral.HistoryRequest)) *MockCollateralUseCases_OrderHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 collateral.HistoryRequest
+		if args[1] != nil {
+			arg1 = args[1].(collateral.HistoryRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollateralUseCases_OrderHistory_Call) Return(orderHistoryResult collateral.OrderHistoryResult, err error) *MockCollateralUseCases_OrderHistory_Call {
+	_c.Call.Return(orderHistoryResult, err)
+	return _c
+}
+
+func (_c *MockCollateralUseCases_OrderHistory_Call) RunAndReturn(run func(ctx context.Context, request collateral.HistoryRequest) (collateral.OrderHistoryResult, error)) *MockCollateralUseCases_OrderHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlaceOrder provides a mock function for the type MockCollateralUseCases
+func (_mock *MockCollateralUseCases) PlaceOrder(ctx context.Context, request collateral.PlaceOrderRequest) (collateral.PlaceOrderResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlaceOrder")
+	}
+
+	var r0 collateral.PlaceOrderResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.PlaceOrderRequest) (collateral.PlaceOrderResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.PlaceOrderRequest) collateral.PlaceOrderResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(collateral.PlaceOrderResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, collateral.PlaceOrderRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollateralUseCases_PlaceOrder_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlaceOrder'
+type MockCollateralUseCases_PlaceOrder_Call struct {
+	*mock.Call
+}
+
+// PlaceOrder is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request collateral.PlaceOrderRequest
+func (_e *MockCollateralUseCases_Expecter) PlaceOrder(ctx interface{}, request interface{}) *MockCollateralUseCases_PlaceOrder_Call {
+	return &MockCollateralUseCases_PlaceOrder_Call{Call: _e.mock.On("PlaceOrder", ctx, request)}
+}
+
+func (_c *MockCollateralUseCases_PlaceOrder_Call) Run(run func(ctx context.Context, request collateral.PlaceOrderRequest)) *MockCollateralUseCases_PlaceOrder_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 collateral.PlaceOrderRequest
+		if args[1] != nil {
+			arg1 = args[1].(collateral.PlaceOrderRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollateralUseCases_PlaceOrder_Call) Return(placeOrderResult collateral.PlaceOrderResult, err error) *MockCollateralUseCases_PlaceOrder_Call {
+	_c.Call.Return(placeOrderResult, err)
+	return _c
+}
+
+func (_c *MockCollateralUseCases_PlaceOrder_Call) RunAndReturn(run func(ctx context.Context, request collateral.PlaceOrderRequest) (collateral.PlaceOrderResult, error)) *MockCollateralUseCases_PlaceOrder_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PlanRange provides a mock function for the type MockCollateralUseCases
+func (_mock *MockCollateralUseCases) PlanRange(ctx context.Context, request collateral.RangePlanRequest) (collateral.RangePlanResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PlanRange")
+	}
+
+	var r0 collateral.RangePlanResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.RangePlanRequest) (collateral.RangePlanResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.RangePlanRequest) collateral.RangePlanResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(collateral.RangePlanResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, collateral.RangePlanRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollateralUseCases_PlanRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PlanRange'
+type MockCollateralUseCases_PlanRange_Call struct {
+	*mock.Call
+}
+
+// PlanRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request collateral.RangePlanRequest
+func (_e *MockCollateralUseCases_Expecter) PlanRange(ctx interface{}, request interface{}) *MockCollateralUseCases_PlanRange_Call {
+	return &MockCollateralUseCases_PlanRange_Call{Call: _e.mock.On("PlanRange", ctx, request)}
+}
+
+func (_c *MockCollateralUseCases_PlanRange_Call) Run(run func(ctx context.Context, request collateral.RangePlanRequest)) *MockCollateralUseCases_PlanRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 collateral.RangePlanRequest
+		if args[1] != nil {
+			arg1 = args[1].(collateral.RangePlanRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollateralUseCases_PlanRange_Call) Return(rangePlanResult collateral.RangePlanResult, err error) *MockCollateralUseCases_PlanRange_Call {
+	_c.Call.Return(rangePlanResult, err)
+	return _c
+}
+
+func (_c *MockCollateralUseCases_PlanRange_Call) RunAndReturn(run func(ctx context.Context, request collateral.RangePlanRequest) (collateral.RangePlanResult, error)) *MockCollateralUseCases_PlanRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Positions provides a mock function for the type MockCollateralUseCases
+func (_mock *MockCollateralUseCases) Positions(ctx context.Context, request collateral.PositionsRequest) (collateral.PositionsResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Positions")
+	}
+
+	var r0 collateral.PositionsResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.PositionsRequest) (collateral.PositionsResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.PositionsRequest) collateral.PositionsResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(collateral.PositionsResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, collateral.PositionsRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollateralUseCases_Positions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Positions'
+type MockCollateralUseCases_Positions_Call struct {
+	*mock.Call
+}
+
+// Positions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request collateral.PositionsRequest
+func (_e *MockCollateralUseCases_Expecter) Positions(ctx interface{}, request interface{}) *MockCollateralUseCases_Positions_Call {
+	return &MockCollateralUseCases_Positions_Call{Call: _e.mock.On("Positions", ctx, request)}
+}
+
+func (_c *MockCollateralUseCases_Positions_Call) Run(run func(ctx context.Context, request collateral.PositionsRequest)) *MockCollateralUseCases_Positions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 collateral.PositionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(collateral.PositionsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollateralUseCases_Positions_Call) Return(positionsResult collateral.PositionsResult, err error) *MockCollateralUseCases_Positions_Call {
+	_c.Call.Return(positionsResult, err)
+	return _c
+}
+
+func (_c *MockCollateralUseCases_Positions_Call) RunAndReturn(run func(ctx context.Context, request collateral.PositionsRequest) (collateral.PositionsResult, error)) *MockCollateralUseCases_Positions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetHedgeMode provides a mock function for the type MockCollateralUseCases
+func (_mock *MockCollateralUseCases) SetHedgeMode(ctx context.Context, enabled bool) (collateral.HedgeModeResult, error) {
+	ret := _mock.Called(ctx, enabled)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetHedgeMode")
+	}
+
+	var r0 collateral.HedgeModeResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) (collateral.HedgeModeResult, error)); ok {
+		return returnFunc(ctx, enabled)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, bool) collateral.HedgeModeResult); ok {
+		r0 = returnFunc(ctx, enabled)
+	} else {
+		r0 = ret.Get(0).(collateral.HedgeModeResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = returnFunc(ctx, enabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollateralUseCases_SetHedgeMode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetHedgeMode'
+type MockCollateralUseCases_SetHedgeMode_Call struct {
+	*mock.Call
+}
+
+// SetHedgeMode is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enabled bool
+func (_e *MockCollateralUseCases_Expecter) SetHedgeMode(ctx interface{}, enabled interface{}) *MockCollateralUseCases_SetHedgeMode_Call {
+	return &MockCollateralUseCases_SetHedgeMode_Call{Call: _e.mock.On("SetHedgeMode", ctx, enabled)}
+}
+
+func (_c *MockCollateralUseCases_SetHedgeMode_Call) Run(run func(ctx context.Context, enabled bool)) *MockCollateralUseCases_SetHedgeMode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 bool
+		if args[1] != nil {
+			arg1 = args[1].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollateralUseCases_SetHedgeMode_Call) Return(hedgeModeResult collateral.HedgeModeResult, err error) *MockCollateralUseCases_SetHedgeMode_Call {
+	_c.Call.Return(hedgeModeResult, err)
+	return _c
+}
+
+func (_c *MockCollateralUseCases_SetHedgeMode_Call) RunAndReturn(run func(ctx context.Context, enabled bool) (collateral.HedgeModeResult, error)) *MockCollateralUseCases_SetHedgeMode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetLeverage provides a mock function for the type MockCollateralUseCases
+func (_mock *MockCollateralUseCases) SetLeverage(ctx context.Context, leverage int) (collateral.LeverageResult, error) {
+	ret := _mock.Called(ctx, leverage)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetLeverage")
+	}
+
+	var r0 collateral.LeverageResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (collateral.LeverageResult, error)); ok {
+		return returnFunc(ctx, leverage)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) collateral.LeverageResult); ok {
+		r0 = returnFunc(ctx, leverage)
+	} else {
+		r0 = ret.Get(0).(collateral.LeverageResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, leverage)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollateralUseCases_SetLeverage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetLeverage'
+type MockCollateralUseCases_SetLeverage_Call struct {
+	*mock.Call
+}
+
+// SetLeverage is a helper method to define mock.On call
+//   - ctx context.Context
+//   - leverage int
+func (_e *MockCollateralUseCases_Expecter) SetLeverage(ctx interface{}, leverage interface{}) *MockCollateralUseCases_SetLeverage_Call {
+	return &MockCollateralUseCases_SetLeverage_Call{Call: _e.mock.On("SetLeverage", ctx, leverage)}
+}
+
+func (_c *MockCollateralUseCases_SetLeverage_Call) Run(run func(ctx context.Context, leverage int)) *MockCollateralUseCases_SetLeverage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollateralUseCases_SetLeverage_Call) Return(leverageResult collateral.LeverageResult, err error) *MockCollateralUseCases_SetLeverage_Call {
+	_c.Call.Return(leverageResult, err)
+	return _c
+}
+
+func (_c *MockCollateralUseCases_SetLeverage_Call) RunAndReturn(run func(ctx context.Context, leverage int) (collateral.LeverageResult, error)) *MockCollateralUseCases_SetLeverage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SubmitRange provides a mock function for the type MockCollateralUseCases
+func (_mock *MockCollateralUseCases) SubmitRange(ctx context.Context, request collateral.RangeSubmitRequest) (collateral.RangePlanResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubmitRange")
+	}
+
+	var r0 collateral.RangePlanResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.RangeSubmitRequest) (collateral.RangePlanResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.RangeSubmitRequest) collateral.RangePlanResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(collateral.RangePlanResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, collateral.RangeSubmitRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollateralUseCases_SubmitRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SubmitRange'
+type MockCollateralUseCases_SubmitRange_Call struct {
+	*mock.Call
+}
+
+// SubmitRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request collateral.RangeSubmitRequest
+func (_e *MockCollateralUseCases_Expecter) SubmitRange(ctx interface{}, request interface{}) *MockCollateralUseCases_SubmitRange_Call {
+	return &MockCollateralUseCases_SubmitRange_Call{Call: _e.mock.On("SubmitRange", ctx, request)}
+}
+
+func (_c *MockCollateralUseCases_SubmitRange_Call) Run(run func(ctx context.Context, request collateral.RangeSubmitRequest)) *MockCollateralUseCases_SubmitRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 collateral.RangeSubmitRequest
+		if args[1] != nil {
+			arg1 = args[1].(collateral.RangeSubmitRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollateralUseCases_SubmitRange_Call) Return(rangePlanResult collateral.RangePlanResult, err error) *MockCollateralUseCases_SubmitRange_Call {
+	_c.Call.Return(rangePlanResult, err)
+	return _c
+}
+
+func (_c *MockCollateralUseCases_SubmitRange_Call) RunAndReturn(run func(ctx context.Context, request collateral.RangeSubmitRequest) (collateral.RangePlanResult, error)) *MockCollateralUseCases_SubmitRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Trades provides a mock function for the type MockCollateralUseCases
+func (_mock *MockCollateralUseCases) Trades(ctx context.Context, request collateral.HistoryRequest) (collateral.TradesResult, error) {
+	ret := _mock.Called(ctx, request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Trades")
+	}
+
+	var r0 collateral.TradesResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.HistoryRequest) (collateral.TradesResult, error)); ok {
+		return returnFunc(ctx, request)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, collateral.HistoryRequest) collateral.TradesResult); ok {
+		r0 = returnFunc(ctx, request)
+	} else {
+		r0 = ret.Get(0).(collateral.TradesResult)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, collateral.HistoryRequest) error); ok {
+		r1 = returnFunc(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockCollateralUseCases_Trades_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Trades'
+type MockCollateralUseCases_Trades_Call struct {
+	*mock.Call
+}
+
+// Trades is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request collateral.HistoryRequest
+func (_e *MockCollateralUseCases_Expecter) Trades(ctx interface{}, request interface{}) *MockCollateralUseCases_Trades_Call {
+	return &MockCollateralUseCases_Trades_Call{Call: _e.mock.On("Trades", ctx, request)}
+}
+
+func (_c *MockCollateralUseCases_Trades_Call) Run(run func(ctx context.Context, request collateral.HistoryRequest)) *MockCollateralUseCases_Trades_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 collateral.HistoryRequest
+		if args[1] != nil {
+			arg1 = args[1].(collateral.HistoryRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockCollateralUseCases_Trades_Call) Return(tradesResult collateral.TradesResult, err error) *MockCollateralUseCases_Trades_Call {
+	_c.Call.Return(tradesResult, err)
+	return _c
+}
+
+func (_c *MockCollateralUseCases_Trades_Call) RunAndReturn(run func(ctx context.Context, request collateral.HistoryRequest) (collateral.TradesResult, error)) *MockCollateralUseCases_Trades_Call {
 	_c.Call.Return(run)
 	return _c
 }